@@ -21,20 +21,31 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/component-base/logs"
 
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/http/solver"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
 
+const (
+	challengeTypeHTTP01    = "http-01"
+	challengeTypeTLSALPN01 = "tls-alpn-01"
+)
+
 func NewACMESolverCommand(_ context.Context) *cobra.Command {
 	s := new(solver.HTTP01Solver)
+	var challengeType string
+	var shared bool
+	var namespace, kubeconfig string
 	logOptions := logs.NewOptions()
 
 	cmd := &cobra.Command{
 		Use:   "acmesolver",
-		Short: "HTTP server used to solve ACME challenges.",
+		Short: "HTTP(S) server used to solve ACME challenges.",
 
 		SilenceErrors: true, // Errors are already logged when calling cmd.Execute()
 		SilenceUsage:  true, // Don't print usage on every error
@@ -44,6 +55,19 @@ func NewACMESolverCommand(_ context.Context) *cobra.Command {
 				return fmt.Errorf("error validating options: %s", err)
 			}
 
+			if challengeType != challengeTypeHTTP01 && challengeType != challengeTypeTLSALPN01 {
+				return fmt.Errorf("unsupported challenge type %q", challengeType)
+			}
+
+			if shared {
+				if challengeType != challengeTypeHTTP01 {
+					return fmt.Errorf("--shared is only supported for the %s challenge type", challengeTypeHTTP01)
+				}
+				if s.Domain != "" || s.Token != "" || s.Key != "" {
+					return fmt.Errorf("--domain, --token and --key cannot be set together with --shared")
+				}
+			}
+
 			return nil
 		},
 		// nolint:contextcheck // False positive
@@ -51,6 +75,33 @@ func NewACMESolverCommand(_ context.Context) *cobra.Command {
 			runCtx := cmd.Context()
 			log := logf.FromContext(runCtx)
 
+			if shared {
+				restConfig, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+				if err != nil {
+					return fmt.Errorf("error creating rest config: %w", err)
+				}
+				cl, err := cmclient.NewForConfig(restConfig)
+				if err != nil {
+					return fmt.Errorf("error creating clientset: %w", err)
+				}
+				s.Keys, err = solver.NewChallengeKeyLookup(runCtx, cl, namespace)
+				if err != nil {
+					return fmt.Errorf("error watching challenges: %w", err)
+				}
+			}
+
+			var server interface {
+				Listen(log logr.Logger) error
+				Shutdown(ctx context.Context) error
+			} = s
+			if challengeType == challengeTypeTLSALPN01 {
+				server = &solver.TLSALPN01Solver{
+					ListenPort: s.ListenPort,
+					Domain:     s.Domain,
+					Key:        s.Key,
+				}
+			}
+
 			completedCh := make(chan struct{})
 			go func() {
 				defer close(completedCh)
@@ -61,12 +112,12 @@ func NewACMESolverCommand(_ context.Context) *cobra.Command {
 				defer cancel()
 
 				// nolint: contextcheck
-				if err := s.Shutdown(shutdownCtx); err != nil {
+				if err := server.Shutdown(shutdownCtx); err != nil {
 					log.Error(err, "error shutting down acmesolver server")
 				}
 			}()
 
-			if err := s.Listen(log); err != nil {
+			if err := server.Listen(log); err != nil {
 				return err
 			}
 
@@ -76,10 +127,14 @@ func NewACMESolverCommand(_ context.Context) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringVar(&challengeType, "challenge-type", challengeTypeHTTP01, "the type of ACME challenge to solve, either http-01 or tls-alpn-01")
 	cmd.Flags().IntVar(&s.ListenPort, "listen-port", 8089, "the port number to listen on for connections")
 	cmd.Flags().StringVar(&s.Domain, "domain", "", "the domain name to verify")
 	cmd.Flags().StringVar(&s.Token, "token", "", "the challenge token to verify against")
 	cmd.Flags().StringVar(&s.Key, "key", "", "the challenge key to respond with")
+	cmd.Flags().BoolVar(&shared, "shared", false, "serve all pending http-01 challenges, as read from the Kubernetes API, instead of a single challenge")
+	cmd.Flags().StringVar(&namespace, "namespace", "", "if --shared is set, only serve challenges in this namespace (defaults to all namespaces)")
+	cmd.Flags().StringVar(&kubeconfig, "kubeconfig", "", "if --shared is set, path to a kubeconfig file (defaults to the in-cluster config)")
 
 	// TODO(@inteon): use flags to configure the log configuration (https://github.com/cert-manager/cert-manager/issues/6021)
 
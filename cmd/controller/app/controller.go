@@ -23,11 +23,13 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	"golang.org/x/sync/errgroup"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
@@ -42,9 +44,11 @@ import (
 	"github.com/cert-manager/cert-manager/internal/apis/config/shared"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	"github.com/cert-manager/cert-manager/pkg/acme/client/middleware"
 	"github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/controller/clusterissuers"
 	"github.com/cert-manager/cert-manager/pkg/healthz"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/embedded"
 	dnsutil "github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	"github.com/cert-manager/cert-manager/pkg/metrics"
@@ -172,6 +176,27 @@ func Run(rootCtx context.Context, opts *config.ControllerConfiguration) error {
 		return healthzServer.Start(rootCtx, healthzListener)
 	})
 
+	// Start the embedded DNS server if it is enabled. It serves records on
+	// every replica, not only on the leader, so the Challenge informer it
+	// reads the records from is started straight away.
+	if addr := opts.ACMEDNS01Config.EmbeddedServerAddress; addr != "" {
+		dnsPacketConn, err := net.ListenPacket("udp", addr)
+		if err != nil {
+			return fmt.Errorf("failed to listen on embedded DNS server address %s: %v", addr, err)
+		}
+		dnsListener, err := net.Listen("tcp", addr)
+		if err != nil {
+			return fmt.Errorf("failed to listen on embedded DNS server address %s: %v", addr, err)
+		}
+		dnsServer := embedded.NewServer(rootCtx, ctx.SharedInformerFactory.Acme().V1().Challenges().Lister(),
+			opts.ACMEDNS01Config.EmbeddedServerNameserver, opts.ACMEDNS01Config.EmbeddedServerZones)
+		ctx.SharedInformerFactory.Start(rootCtx.Done())
+		g.Go(func() error {
+			log.V(logf.InfoLevel).Info("starting embedded DNS server", "address", addr)
+			return dnsServer.Serve(rootCtx, dnsPacketConn, dnsListener)
+		})
+	}
+
 	elected := make(chan struct{})
 	if opts.LeaderElectionConfig.Enabled {
 		g.Go(func() error {
@@ -304,7 +329,15 @@ func buildControllerContextFactory(ctx context.Context, opts *config.ControllerC
 	}
 
 	ACMEHTTP01SolverRunAsNonRoot := opts.ACMEHTTP01Config.SolverRunAsNonRoot
-	acmeAccountRegistry := accounts.NewDefaultRegistry()
+
+	var http01SharedSolverService types.NamespacedName
+	if svc := opts.ACMEHTTP01Config.SharedSolverService; svc != "" {
+		namespace, name, _ := strings.Cut(svc, "/")
+		http01SharedSolverService = types.NamespacedName{Namespace: namespace, Name: name}
+	}
+
+	controllerMetrics := metrics.New(log, clock.RealClock{})
+	acmeAccountRegistry := accounts.NewRegistry(middleware.NewRateLimits(clock.RealClock{}, controllerMetrics))
 
 	ctxFactory, err := controller.NewContextFactory(ctx, controller.ContextOptions{
 		Kubeconfig:         opts.KubeConfig,
@@ -315,7 +348,7 @@ func buildControllerContextFactory(ctx context.Context, opts *config.ControllerC
 		Namespace: opts.Namespace,
 
 		Clock:   clock.RealClock{},
-		Metrics: metrics.New(log, clock.RealClock{}),
+		Metrics: controllerMetrics,
 
 		ACMEOptions: controller.ACMEOptions{
 			HTTP01SolverResourceRequestCPU:    http01SolverResourceRequestCPU,
@@ -326,16 +359,22 @@ func buildControllerContextFactory(ctx context.Context, opts *config.ControllerC
 			HTTP01SolverImage:                 opts.ACMEHTTP01Config.SolverImage,
 			// Allows specifying a list of custom nameservers to perform HTTP01 checks on.
 			HTTP01SolverNameservers: opts.ACMEHTTP01Config.SolverNameservers,
+			// Allows routing HTTP01 challenges to a shared acmesolver Deployment.
+			HTTP01SharedSolverService: http01SharedSolverService,
 
 			DNS01Nameservers:        nameservers,
 			DNS01CheckRetryPeriod:   opts.ACMEDNS01Config.CheckRetryPeriod,
 			DNS01CheckAuthoritative: !opts.ACMEDNS01Config.RecursiveNameserversOnly,
+			// Challenges solved by the embedded DNS server must be within its zones.
+			DNS01EmbeddedServerZones: opts.ACMEDNS01Config.EmbeddedServerZones,
 
 			AccountRegistry: acmeAccountRegistry,
 		},
 
 		SchedulerOptions: controller.SchedulerOptions{
-			MaxConcurrentChallenges: opts.MaxConcurrentChallenges,
+			MaxConcurrentChallenges:             opts.MaxConcurrentChallenges,
+			MaxConcurrentChallengesPerNamespace: opts.MaxConcurrentChallengesPerNamespace,
+			ChallengeNamespaceWeights:           opts.ChallengeNamespaceWeights,
 		},
 
 		IssuerOptions: controller.IssuerOptions{
@@ -354,6 +393,10 @@ func buildControllerContextFactory(ctx context.Context, opts *config.ControllerC
 		CertificateOptions: controller.CertificateOptions{
 			EnableOwnerRef:           opts.EnableCertificateOwnerRef,
 			CopiedAnnotationPrefixes: opts.CopiedAnnotationPrefixes,
+			RenewalJitter:            opts.CertificateRenewalJitter,
+			RenewalQPS:               opts.CertificateRenewalQPS,
+			RenewalBurst:             opts.CertificateRenewalBurst,
+			HistoryLimit:             opts.CertificateHistoryLimit,
 		},
 
 		ConfigOptions: controller.ConfigOptions{
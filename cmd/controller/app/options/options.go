@@ -31,6 +31,7 @@ import (
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	configv1alpha1 "github.com/cert-manager/cert-manager/pkg/apis/config/controller/v1alpha1"
 	shimgatewaycontroller "github.com/cert-manager/cert-manager/pkg/controller/certificate-shim/gateways"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/renewalinfo"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 )
@@ -132,6 +133,13 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.ControllerConfiguration) {
 			"ACME HTTP01 check requests. This should be a list containing host and "+
 			"port, for example 8.8.8.8:53,8.8.4.4:53")
 
+	fs.StringVar(&c.ACMEHTTP01Config.SharedSolverService, "acme-http01-solver-shared-service", c.ACMEHTTP01Config.SharedSolverService, ""+
+		"The Service, in the form <namespace>/<name>, of a shared acmesolver Deployment started with --shared. "+
+		"When set, ACME HTTP01 challenges solved using an Ingress are routed to this Service through a single Ingress "+
+		"per ingress class and ingress template, instead of creating a solver pod, Service and Ingress for each challenge. "+
+		"Challenges solved using a Gateway API HTTPRoute, or by editing an existing Ingress, always use a dedicated "+
+		"solver pod and Service, and a SharedSolverNotUsed event is recorded on them.")
+
 	fs.BoolVar(&c.ClusterIssuerAmbientCredentials, "cluster-issuer-ambient-credentials", c.ClusterIssuerAmbientCredentials, ""+
 		"Whether a cluster-issuer may make use of ambient credentials for issuers. 'Ambient Credentials' are credentials drawn from the environment, metadata services, or local files which are not explicitly configured in the ClusterIssuer API object. "+
 		"When this flag is enabled, the following sources for credentials are also used: "+
@@ -165,6 +173,26 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.ControllerConfiguration) {
 	fs.DurationVar(&c.ACMEDNS01Config.CheckRetryPeriod, "dns01-check-retry-period", c.ACMEDNS01Config.CheckRetryPeriod, ""+
 		"The duration the controller should wait between a propagation check. Despite the name, this flag is used to configure the wait period for both DNS01 and HTTP01 challenge propagation checks. For DNS01 challenges the propagation check verifies that a TXT record with the challenge token has been created. For HTTP01 challenges the propagation check verifies that the challenge token is served at the challenge URL."+
 		"This should be a valid duration string, for example 180s or 1h")
+	fs.StringVar(&c.ACMEDNS01Config.EmbeddedServerAddress, "dns01-embedded-server-address", c.ACMEDNS01Config.EmbeddedServerAddress, ""+
+		"The address, for example :5353, on which the authoritative DNS server embedded in the controller listens for DNS requests over UDP and TCP. "+
+		"The embedded DNS server serves the TXT records of DNS01 challenges solved by the embedded DNS01 provider. It is disabled if not set.")
+	fs.StringVar(&c.ACMEDNS01Config.EmbeddedServerNameserver, "dns01-embedded-server-nameserver", c.ACMEDNS01Config.EmbeddedServerNameserver, ""+
+		"The fully qualified domain name under which the embedded DNS server is reachable, used in the SOA and NS records it serves.")
+	fs.StringSliceVar(&c.ACMEDNS01Config.EmbeddedServerZones, "dns01-embedded-server-zones", c.ACMEDNS01Config.EmbeddedServerZones, ""+
+		"A list of comma separated DNS zones delegated to the embedded DNS server, e.g. a zone that the _acme-challenge records of several domains are CNAMEs to, "+
+		"or an _acme-challenge record delegated to it individually. Only the records of challenges of ClusterIssuers within these zones are served.")
+
+	fs.DurationVar(&c.CertificateRenewalJitter, "certificate-renewal-jitter", c.CertificateRenewalJitter, ""+
+		"The default window within which the renewals of Certificates that do not set spec.renewalJitter are spread. "+
+		"The renewal time of each certificate is moved earlier by a stable offset between zero and the jitter. A value of 0 disables the jitter.")
+	fs.Float32Var(&c.CertificateRenewalQPS, "certificate-renewal-qps", c.CertificateRenewalQPS, ""+
+		"The maximum average number of Certificate renewals per second that the controller triggers. "+
+		"Renewals beyond this rate are delayed. A value of 0 means that renewals are not rate limited.")
+	fs.IntVar(&c.CertificateRenewalBurst, "certificate-renewal-burst", c.CertificateRenewalBurst, ""+
+		"The maximum number of Certificate renewals that the controller triggers at once when renewals are rate limited.")
+	fs.IntVar(&c.CertificateHistoryLimit, "certificate-history-limit", c.CertificateHistoryLimit, ""+
+		"The maximum number of issued certificates recorded in the status.history of each Certificate. "+
+		"The oldest entries are removed first. A value of 0 disables the issuance history.")
 
 	fs.BoolVar(&c.EnableCertificateOwnerRef, "enable-certificate-owner-ref", c.EnableCertificateOwnerRef, ""+
 		"Whether to set the certificate resource as an owner of secret where the tls certificate is stored. "+
@@ -183,6 +211,13 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.ControllerConfiguration) {
 		"The number of concurrent workers for each controller.")
 	fs.IntVar(&c.MaxConcurrentChallenges, "max-concurrent-challenges", c.MaxConcurrentChallenges, ""+
 		"The maximum number of challenges that can be scheduled as 'processing' at once.")
+	fs.IntVar(&c.MaxConcurrentChallengesPerNamespace, "max-concurrent-challenges-per-namespace", c.MaxConcurrentChallengesPerNamespace, ""+
+		"The maximum number of challenges in a single namespace that can be scheduled as 'processing' at once. "+
+		"If set to 0, the number of challenges per namespace is only limited by --max-concurrent-challenges.")
+	fs.StringToInt64Var(&c.ChallengeNamespaceWeights, "challenge-namespace-weights", c.ChallengeNamespaceWeights, ""+
+		"The scheduling weight of each namespace, as a comma separated list of namespace=weight pairs, e.g. 'prod=10,dev=1'. "+
+		"Challenges are scheduled in proportion to the weight of their namespace when challenges of multiple namespaces are waiting. "+
+		"Weights must be between 1 and 100; namespaces that are not listed have a weight of 1.")
 
 	fs.StringVar(&c.MetricsListenAddress, "metrics-listen-address", c.MetricsListenAddress, ""+
 		"The host and port that the metrics endpoint should listen on.")
@@ -256,6 +291,11 @@ func EnabledControllers(o *config.ControllerConfiguration) sets.Set[string] {
 		enabled = enabled.Insert(defaults.ExperimentalCertificateSigningRequestControllers...)
 	}
 
+	if utilfeature.DefaultFeatureGate.Enabled(feature.ACMERenewalInfo) {
+		logf.Log.Info("enabling the ACME Renewal Information controller")
+		enabled = enabled.Insert(renewalinfo.ControllerName)
+	}
+
 	if utilfeature.DefaultFeatureGate.Enabled(feature.ExperimentalGatewayAPISupport) && o.EnableGatewayAPI {
 		logf.Log.Info("enabling the sig-network Gateway API certificate-shim and HTTP-01 solver")
 		enabled = enabled.Insert(shimgatewaycontroller.ControllerName)
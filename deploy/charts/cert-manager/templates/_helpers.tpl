@@ -140,6 +140,24 @@ Create the name of the service account to use
 {{- end -}}
 {{- end -}}
 
+{{/*
+acmesolver templates
+*/}}
+
+{{- define "acmesolver.name" -}}
+{{- printf "acmesolver" -}}
+{{- end -}}
+
+{{/*
+Create a default fully qualified app name.
+We truncate at 63 chars because some Kubernetes name fields are limited to this (by the DNS naming spec).
+If release name contains chart name it will be used as a full name.
+*/}}
+{{- define "acmesolver.fullname" -}}
+{{- $trimmedName := printf "%s" (include "cert-manager.fullname" .) | trunc 52 | trimSuffix "-" -}}
+{{- printf "%s-acmesolver" $trimmedName | trunc 63 | trimSuffix "-" -}}
+{{- end -}}
+
 {{/*
 Create chart name and version as used by the chart label.
 */}}
@@ -88,9 +88,21 @@ type ChallengeSpec struct {
 	// If the Issuer is not an 'ACME' Issuer, an error will be returned and the
 	// Challenge will be marked as failed.
 	IssuerRef cmmeta.ObjectReference
+
+	// Server is the URL of the ACME server 'directory' endpoint that the
+	// challenge belongs to.
+	// If not set, the challenge belongs to the `server` of the ACME Issuer.
+	// +optional
+	Server string
+
+	// AccountURI is the URI of the ACME account that the challenge belongs
+	// to. It is only set for DNS-ACCOUNT-01 challenges, for which the name of
+	// the TXT record is derived from the account URI.
+	// +optional
+	AccountURI string
 }
 
-// The type of ACME challenge. Only HTTP-01 and DNS-01 are supported.
+// The type of ACME challenge. Only HTTP-01, DNS-01, DNS-ACCOUNT-01 and TLS-ALPN-01 are supported.
 type ACMEChallengeType string
 
 const (
@@ -101,6 +113,14 @@ const (
 	// ACMEChallengeTypeDNS01 denotes a Challenge is of type dns-01
 	// More info: https://letsencrypt.org/docs/challenge-types/#dns-01-challenge
 	ACMEChallengeTypeDNS01 ACMEChallengeType = "DNS-01"
+
+	// ACMEChallengeTypeDNSAccount01 denotes a Challenge is of type dns-account-01
+	// More info: https://datatracker.ietf.org/doc/draft-ietf-acme-dns-account-label/
+	ACMEChallengeTypeDNSAccount01 ACMEChallengeType = "DNS-ACCOUNT-01"
+
+	// ACMEChallengeTypeTLSALPN01 denotes a Challenge is of type tls-alpn-01
+	// More info: https://letsencrypt.org/docs/challenge-types/#tls-alpn-01
+	ACMEChallengeTypeTLSALPN01 ACMEChallengeType = "TLS-ALPN-01"
 )
 
 type ChallengeStatus struct {
@@ -121,6 +141,11 @@ type ChallengeStatus struct {
 	// configured).
 	Presented bool
 
+	// PresentedTime is the time at which the challenge values were first
+	// presented. Self check timeouts are measured from this time.
+	// +optional
+	PresentedTime *metav1.Time
+
 	// Reason contains human readable information on why the Challenge is in the
 	// current state.
 	Reason string
@@ -128,4 +153,49 @@ type ChallengeStatus struct {
 	// State contains the current 'state' of the challenge.
 	// If not set, the state of the challenge is unknown.
 	State State
+
+	// Accepted is set to true once the self check of the challenge has passed
+	// and the ACME server has been asked to validate the challenge.
+	// +optional
+	Accepted bool
+
+	// DNS01Record is the TXT record that must be created to solve the
+	// challenge. It is only set for challenges solved by the manual, the
+	// embedded or the acme-dns DNS01 provider.
+	// +optional
+	DNS01Record *ChallengeDNS01Record
+
+	// SelfCheckPerspectives contains the result of the latest self check
+	// from each of the network perspectives configured on the solver.
+	// +optional
+	SelfCheckPerspectives []ChallengeSelfCheckPerspective
+}
+
+// ChallengeDNS01Record is a DNS TXT record that must be created to solve a
+// DNS01 challenge.
+type ChallengeDNS01Record struct {
+	// FQDN is the fully qualified domain name of the TXT record.
+	FQDN string
+
+	// Value is the value of the TXT record.
+	Value string
+
+	// CNAME is set if the FQDN must be a CNAME record pointing at this name,
+	// which holds the TXT record, instead of holding the TXT record itself.
+	// +optional
+	CNAME string
+}
+
+// ChallengeSelfCheckPerspective is the result of the self check of a
+// challenge from a single network perspective.
+type ChallengeSelfCheckPerspective struct {
+	// Name is the name of the perspective.
+	Name string
+
+	// Passed is true if the self check passed from this perspective.
+	Passed bool
+
+	// Reason is the reason the self check failed from this perspective.
+	// +optional
+	Reason string
 }
@@ -26,12 +26,12 @@ import (
 
 	acme "github.com/cert-manager/cert-manager/internal/apis/acme"
 	meta "github.com/cert-manager/cert-manager/internal/apis/meta"
-	metav1 "github.com/cert-manager/cert-manager/internal/apis/meta/v1"
+	apismetav1 "github.com/cert-manager/cert-manager/internal/apis/meta/v1"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
-	apismetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	pkgapismetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
-	pkgapismetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -44,6 +44,36 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEAbandonedSolver)(nil), (*acme.ACMEAbandonedSolver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEAbandonedSolver_To_acme_ACMEAbandonedSolver(a.(*v1.ACMEAbandonedSolver), b.(*acme.ACMEAbandonedSolver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEAbandonedSolver)(nil), (*v1.ACMEAbandonedSolver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEAbandonedSolver_To_v1_ACMEAbandonedSolver(a.(*acme.ACMEAbandonedSolver), b.(*v1.ACMEAbandonedSolver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEAccountPrivateKey)(nil), (*acme.ACMEAccountPrivateKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEAccountPrivateKey_To_acme_ACMEAccountPrivateKey(a.(*v1.ACMEAccountPrivateKey), b.(*acme.ACMEAccountPrivateKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEAccountPrivateKey)(nil), (*v1.ACMEAccountPrivateKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEAccountPrivateKey_To_v1_ACMEAccountPrivateKey(a.(*acme.ACMEAccountPrivateKey), b.(*v1.ACMEAccountPrivateKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEAcmeDNSRegistration)(nil), (*acme.ACMEAcmeDNSRegistration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEAcmeDNSRegistration_To_acme_ACMEAcmeDNSRegistration(a.(*v1.ACMEAcmeDNSRegistration), b.(*acme.ACMEAcmeDNSRegistration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEAcmeDNSRegistration)(nil), (*v1.ACMEAcmeDNSRegistration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEAcmeDNSRegistration_To_v1_ACMEAcmeDNSRegistration(a.(*acme.ACMEAcmeDNSRegistration), b.(*v1.ACMEAcmeDNSRegistration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.ACMEAuthorization)(nil), (*acme.ACMEAuthorization)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ACMEAuthorization_To_acme_ACMEAuthorization(a.(*v1.ACMEAuthorization), b.(*acme.ACMEAuthorization), scope)
 	}); err != nil {
@@ -84,6 +114,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEChallengeSolverDNS01SelfCheck)(nil), (*acme.ACMEChallengeSolverDNS01SelfCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEChallengeSolverDNS01SelfCheck_To_acme_ACMEChallengeSolverDNS01SelfCheck(a.(*v1.ACMEChallengeSolverDNS01SelfCheck), b.(*acme.ACMEChallengeSolverDNS01SelfCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEChallengeSolverDNS01SelfCheck)(nil), (*v1.ACMEChallengeSolverDNS01SelfCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEChallengeSolverDNS01SelfCheck_To_v1_ACMEChallengeSolverDNS01SelfCheck(a.(*acme.ACMEChallengeSolverDNS01SelfCheck), b.(*v1.ACMEChallengeSolverDNS01SelfCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEChallengeSolverDNS01SelfCheckPerspective)(nil), (*acme.ACMEChallengeSolverDNS01SelfCheckPerspective)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEChallengeSolverDNS01SelfCheckPerspective_To_acme_ACMEChallengeSolverDNS01SelfCheckPerspective(a.(*v1.ACMEChallengeSolverDNS01SelfCheckPerspective), b.(*acme.ACMEChallengeSolverDNS01SelfCheckPerspective), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEChallengeSolverDNS01SelfCheckPerspective)(nil), (*v1.ACMEChallengeSolverDNS01SelfCheckPerspective)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEChallengeSolverDNS01SelfCheckPerspective_To_v1_ACMEChallengeSolverDNS01SelfCheckPerspective(a.(*acme.ACMEChallengeSolverDNS01SelfCheckPerspective), b.(*v1.ACMEChallengeSolverDNS01SelfCheckPerspective), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.ACMEChallengeSolverHTTP01)(nil), (*acme.ACMEChallengeSolverHTTP01)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ACMEChallengeSolverHTTP01_To_acme_ACMEChallengeSolverHTTP01(a.(*v1.ACMEChallengeSolverHTTP01), b.(*acme.ACMEChallengeSolverHTTP01), scope)
 	}); err != nil {
@@ -174,6 +224,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEChallengeSolverHTTP01SelfCheck)(nil), (*acme.ACMEChallengeSolverHTTP01SelfCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEChallengeSolverHTTP01SelfCheck_To_acme_ACMEChallengeSolverHTTP01SelfCheck(a.(*v1.ACMEChallengeSolverHTTP01SelfCheck), b.(*acme.ACMEChallengeSolverHTTP01SelfCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEChallengeSolverHTTP01SelfCheck)(nil), (*v1.ACMEChallengeSolverHTTP01SelfCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEChallengeSolverHTTP01SelfCheck_To_v1_ACMEChallengeSolverHTTP01SelfCheck(a.(*acme.ACMEChallengeSolverHTTP01SelfCheck), b.(*v1.ACMEChallengeSolverHTTP01SelfCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEChallengeSolverHTTP01SelfCheckPerspective)(nil), (*acme.ACMEChallengeSolverHTTP01SelfCheckPerspective)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEChallengeSolverHTTP01SelfCheckPerspective_To_acme_ACMEChallengeSolverHTTP01SelfCheckPerspective(a.(*v1.ACMEChallengeSolverHTTP01SelfCheckPerspective), b.(*acme.ACMEChallengeSolverHTTP01SelfCheckPerspective), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEChallengeSolverHTTP01SelfCheckPerspective)(nil), (*v1.ACMEChallengeSolverHTTP01SelfCheckPerspective)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEChallengeSolverHTTP01SelfCheckPerspective_To_v1_ACMEChallengeSolverHTTP01SelfCheckPerspective(a.(*acme.ACMEChallengeSolverHTTP01SelfCheckPerspective), b.(*v1.ACMEChallengeSolverHTTP01SelfCheckPerspective), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEChallengeSolverTLSALPN01)(nil), (*acme.ACMEChallengeSolverTLSALPN01)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEChallengeSolverTLSALPN01_To_acme_ACMEChallengeSolverTLSALPN01(a.(*v1.ACMEChallengeSolverTLSALPN01), b.(*acme.ACMEChallengeSolverTLSALPN01), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEChallengeSolverTLSALPN01)(nil), (*v1.ACMEChallengeSolverTLSALPN01)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEChallengeSolverTLSALPN01_To_v1_ACMEChallengeSolverTLSALPN01(a.(*acme.ACMEChallengeSolverTLSALPN01), b.(*v1.ACMEChallengeSolverTLSALPN01), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEChallengeSolverTLSALPN01GatewayTLSRoute)(nil), (*acme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEChallengeSolverTLSALPN01GatewayTLSRoute_To_acme_ACMEChallengeSolverTLSALPN01GatewayTLSRoute(a.(*v1.ACMEChallengeSolverTLSALPN01GatewayTLSRoute), b.(*acme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute)(nil), (*v1.ACMEChallengeSolverTLSALPN01GatewayTLSRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEChallengeSolverTLSALPN01GatewayTLSRoute_To_v1_ACMEChallengeSolverTLSALPN01GatewayTLSRoute(a.(*acme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute), b.(*v1.ACMEChallengeSolverTLSALPN01GatewayTLSRoute), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.ACMEExternalAccountBinding)(nil), (*acme.ACMEExternalAccountBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ACMEExternalAccountBinding_To_acme_ACMEExternalAccountBinding(a.(*v1.ACMEExternalAccountBinding), b.(*acme.ACMEExternalAccountBinding), scope)
 	}); err != nil {
@@ -184,6 +274,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEFallbackAccountStatus)(nil), (*acme.ACMEFallbackAccountStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEFallbackAccountStatus_To_acme_ACMEFallbackAccountStatus(a.(*v1.ACMEFallbackAccountStatus), b.(*acme.ACMEFallbackAccountStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEFallbackAccountStatus)(nil), (*v1.ACMEFallbackAccountStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEFallbackAccountStatus_To_v1_ACMEFallbackAccountStatus(a.(*acme.ACMEFallbackAccountStatus), b.(*v1.ACMEFallbackAccountStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEFallbackServer)(nil), (*acme.ACMEFallbackServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEFallbackServer_To_acme_ACMEFallbackServer(a.(*v1.ACMEFallbackServer), b.(*acme.ACMEFallbackServer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEFallbackServer)(nil), (*v1.ACMEFallbackServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEFallbackServer_To_v1_ACMEFallbackServer(a.(*acme.ACMEFallbackServer), b.(*v1.ACMEFallbackServer), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderAcmeDNS)(nil), (*acme.ACMEIssuerDNS01ProviderAcmeDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ACMEIssuerDNS01ProviderAcmeDNS_To_acme_ACMEIssuerDNS01ProviderAcmeDNS(a.(*v1.ACMEIssuerDNS01ProviderAcmeDNS), b.(*acme.ACMEIssuerDNS01ProviderAcmeDNS), scope)
 	}); err != nil {
@@ -244,6 +354,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderEmbedded)(nil), (*acme.ACMEIssuerDNS01ProviderEmbedded)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEIssuerDNS01ProviderEmbedded_To_acme_ACMEIssuerDNS01ProviderEmbedded(a.(*v1.ACMEIssuerDNS01ProviderEmbedded), b.(*acme.ACMEIssuerDNS01ProviderEmbedded), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderEmbedded)(nil), (*v1.ACMEIssuerDNS01ProviderEmbedded)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderEmbedded_To_v1_ACMEIssuerDNS01ProviderEmbedded(a.(*acme.ACMEIssuerDNS01ProviderEmbedded), b.(*v1.ACMEIssuerDNS01ProviderEmbedded), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderManual)(nil), (*acme.ACMEIssuerDNS01ProviderManual)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEIssuerDNS01ProviderManual_To_acme_ACMEIssuerDNS01ProviderManual(a.(*v1.ACMEIssuerDNS01ProviderManual), b.(*acme.ACMEIssuerDNS01ProviderManual), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderManual)(nil), (*v1.ACMEIssuerDNS01ProviderManual)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderManual_To_v1_ACMEIssuerDNS01ProviderManual(a.(*acme.ACMEIssuerDNS01ProviderManual), b.(*v1.ACMEIssuerDNS01ProviderManual), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderRFC2136)(nil), (*acme.ACMEIssuerDNS01ProviderRFC2136)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ACMEIssuerDNS01ProviderRFC2136_To_acme_ACMEIssuerDNS01ProviderRFC2136(a.(*v1.ACMEIssuerDNS01ProviderRFC2136), b.(*acme.ACMEIssuerDNS01ProviderRFC2136), scope)
 	}); err != nil {
@@ -314,6 +444,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.ChallengeDNS01Record)(nil), (*acme.ChallengeDNS01Record)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ChallengeDNS01Record_To_acme_ChallengeDNS01Record(a.(*v1.ChallengeDNS01Record), b.(*acme.ChallengeDNS01Record), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ChallengeDNS01Record)(nil), (*v1.ChallengeDNS01Record)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ChallengeDNS01Record_To_v1_ChallengeDNS01Record(a.(*acme.ChallengeDNS01Record), b.(*v1.ChallengeDNS01Record), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.ChallengeList)(nil), (*acme.ChallengeList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ChallengeList_To_acme_ChallengeList(a.(*v1.ChallengeList), b.(*acme.ChallengeList), scope)
 	}); err != nil {
@@ -324,6 +464,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.ChallengeSelfCheckPerspective)(nil), (*acme.ChallengeSelfCheckPerspective)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ChallengeSelfCheckPerspective_To_acme_ChallengeSelfCheckPerspective(a.(*v1.ChallengeSelfCheckPerspective), b.(*acme.ChallengeSelfCheckPerspective), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ChallengeSelfCheckPerspective)(nil), (*v1.ChallengeSelfCheckPerspective)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ChallengeSelfCheckPerspective_To_v1_ChallengeSelfCheckPerspective(a.(*acme.ChallengeSelfCheckPerspective), b.(*v1.ChallengeSelfCheckPerspective), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.ChallengeSpec)(nil), (*acme.ChallengeSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ChallengeSpec_To_acme_ChallengeSpec(a.(*v1.ChallengeSpec), b.(*acme.ChallengeSpec), scope)
 	}); err != nil {
@@ -427,12 +577,83 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1_ACMEAbandonedSolver_To_acme_ACMEAbandonedSolver(in *v1.ACMEAbandonedSolver, out *acme.ACMEAbandonedSolver, s conversion.Scope) error {
+	out.Type = acme.ACMEChallengeType(in.Type)
+	out.DNSName = in.DNSName
+	out.AbandonedTime = in.AbandonedTime
+	return nil
+}
+
+// Convert_v1_ACMEAbandonedSolver_To_acme_ACMEAbandonedSolver is an autogenerated conversion function.
+func Convert_v1_ACMEAbandonedSolver_To_acme_ACMEAbandonedSolver(in *v1.ACMEAbandonedSolver, out *acme.ACMEAbandonedSolver, s conversion.Scope) error {
+	return autoConvert_v1_ACMEAbandonedSolver_To_acme_ACMEAbandonedSolver(in, out, s)
+}
+
+func autoConvert_acme_ACMEAbandonedSolver_To_v1_ACMEAbandonedSolver(in *acme.ACMEAbandonedSolver, out *v1.ACMEAbandonedSolver, s conversion.Scope) error {
+	out.Type = v1.ACMEChallengeType(in.Type)
+	out.DNSName = in.DNSName
+	out.AbandonedTime = in.AbandonedTime
+	return nil
+}
+
+// Convert_acme_ACMEAbandonedSolver_To_v1_ACMEAbandonedSolver is an autogenerated conversion function.
+func Convert_acme_ACMEAbandonedSolver_To_v1_ACMEAbandonedSolver(in *acme.ACMEAbandonedSolver, out *v1.ACMEAbandonedSolver, s conversion.Scope) error {
+	return autoConvert_acme_ACMEAbandonedSolver_To_v1_ACMEAbandonedSolver(in, out, s)
+}
+
+func autoConvert_v1_ACMEAccountPrivateKey_To_acme_ACMEAccountPrivateKey(in *v1.ACMEAccountPrivateKey, out *acme.ACMEAccountPrivateKey, s conversion.Scope) error {
+	out.Algorithm = acme.ACMEAccountKeyAlgorithm(in.Algorithm)
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_v1_ACMEAccountPrivateKey_To_acme_ACMEAccountPrivateKey is an autogenerated conversion function.
+func Convert_v1_ACMEAccountPrivateKey_To_acme_ACMEAccountPrivateKey(in *v1.ACMEAccountPrivateKey, out *acme.ACMEAccountPrivateKey, s conversion.Scope) error {
+	return autoConvert_v1_ACMEAccountPrivateKey_To_acme_ACMEAccountPrivateKey(in, out, s)
+}
+
+func autoConvert_acme_ACMEAccountPrivateKey_To_v1_ACMEAccountPrivateKey(in *acme.ACMEAccountPrivateKey, out *v1.ACMEAccountPrivateKey, s conversion.Scope) error {
+	out.Algorithm = v1.ACMEAccountKeyAlgorithm(in.Algorithm)
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_acme_ACMEAccountPrivateKey_To_v1_ACMEAccountPrivateKey is an autogenerated conversion function.
+func Convert_acme_ACMEAccountPrivateKey_To_v1_ACMEAccountPrivateKey(in *acme.ACMEAccountPrivateKey, out *v1.ACMEAccountPrivateKey, s conversion.Scope) error {
+	return autoConvert_acme_ACMEAccountPrivateKey_To_v1_ACMEAccountPrivateKey(in, out, s)
+}
+
+func autoConvert_v1_ACMEAcmeDNSRegistration_To_acme_ACMEAcmeDNSRegistration(in *v1.ACMEAcmeDNSRegistration, out *acme.ACMEAcmeDNSRegistration, s conversion.Scope) error {
+	out.Domain = in.Domain
+	out.FullDomain = in.FullDomain
+	return nil
+}
+
+// Convert_v1_ACMEAcmeDNSRegistration_To_acme_ACMEAcmeDNSRegistration is an autogenerated conversion function.
+func Convert_v1_ACMEAcmeDNSRegistration_To_acme_ACMEAcmeDNSRegistration(in *v1.ACMEAcmeDNSRegistration, out *acme.ACMEAcmeDNSRegistration, s conversion.Scope) error {
+	return autoConvert_v1_ACMEAcmeDNSRegistration_To_acme_ACMEAcmeDNSRegistration(in, out, s)
+}
+
+func autoConvert_acme_ACMEAcmeDNSRegistration_To_v1_ACMEAcmeDNSRegistration(in *acme.ACMEAcmeDNSRegistration, out *v1.ACMEAcmeDNSRegistration, s conversion.Scope) error {
+	out.Domain = in.Domain
+	out.FullDomain = in.FullDomain
+	return nil
+}
+
+// Convert_acme_ACMEAcmeDNSRegistration_To_v1_ACMEAcmeDNSRegistration is an autogenerated conversion function.
+func Convert_acme_ACMEAcmeDNSRegistration_To_v1_ACMEAcmeDNSRegistration(in *acme.ACMEAcmeDNSRegistration, out *v1.ACMEAcmeDNSRegistration, s conversion.Scope) error {
+	return autoConvert_acme_ACMEAcmeDNSRegistration_To_v1_ACMEAcmeDNSRegistration(in, out, s)
+}
+
 func autoConvert_v1_ACMEAuthorization_To_acme_ACMEAuthorization(in *v1.ACMEAuthorization, out *acme.ACMEAuthorization, s conversion.Scope) error {
 	out.URL = in.URL
 	out.Identifier = in.Identifier
 	out.Wildcard = (*bool)(unsafe.Pointer(in.Wildcard))
 	out.InitialState = acme.State(in.InitialState)
+	out.Expires = (*metav1.Time)(unsafe.Pointer(in.Expires))
+	out.Cached = in.Cached
 	out.Challenges = *(*[]acme.ACMEChallenge)(unsafe.Pointer(&in.Challenges))
+	out.AbandonedSolvers = *(*[]acme.ACMEAbandonedSolver)(unsafe.Pointer(&in.AbandonedSolvers))
 	return nil
 }
 
@@ -446,7 +667,10 @@ func autoConvert_acme_ACMEAuthorization_To_v1_ACMEAuthorization(in *acme.ACMEAut
 	out.Identifier = in.Identifier
 	out.Wildcard = (*bool)(unsafe.Pointer(in.Wildcard))
 	out.InitialState = v1.State(in.InitialState)
+	out.Expires = (*metav1.Time)(unsafe.Pointer(in.Expires))
+	out.Cached = in.Cached
 	out.Challenges = *(*[]v1.ACMEChallenge)(unsafe.Pointer(&in.Challenges))
+	out.AbandonedSolvers = *(*[]v1.ACMEAbandonedSolver)(unsafe.Pointer(&in.AbandonedSolvers))
 	return nil
 }
 
@@ -491,6 +715,8 @@ func autoConvert_v1_ACMEChallengeSolver_To_acme_ACMEChallengeSolver(in *v1.ACMEC
 	} else {
 		out.DNS01 = nil
 	}
+	out.TLSALPN01 = (*acme.ACMEChallengeSolverTLSALPN01)(unsafe.Pointer(in.TLSALPN01))
+	out.SelfCheckTimeout = (*metav1.Duration)(unsafe.Pointer(in.SelfCheckTimeout))
 	return nil
 }
 
@@ -511,6 +737,8 @@ func autoConvert_acme_ACMEChallengeSolver_To_v1_ACMEChallengeSolver(in *acme.ACM
 	} else {
 		out.DNS01 = nil
 	}
+	out.TLSALPN01 = (*v1.ACMEChallengeSolverTLSALPN01)(unsafe.Pointer(in.TLSALPN01))
+	out.SelfCheckTimeout = (*metav1.Duration)(unsafe.Pointer(in.SelfCheckTimeout))
 	return nil
 }
 
@@ -521,6 +749,8 @@ func Convert_acme_ACMEChallengeSolver_To_v1_ACMEChallengeSolver(in *acme.ACMECha
 
 func autoConvert_v1_ACMEChallengeSolverDNS01_To_acme_ACMEChallengeSolverDNS01(in *v1.ACMEChallengeSolverDNS01, out *acme.ACMEChallengeSolverDNS01, s conversion.Scope) error {
 	out.CNAMEStrategy = acme.CNAMEStrategy(in.CNAMEStrategy)
+	out.UseDNSAccountChallenge = in.UseDNSAccountChallenge
+	out.SelfCheck = (*acme.ACMEChallengeSolverDNS01SelfCheck)(unsafe.Pointer(in.SelfCheck))
 	if in.Akamai != nil {
 		in, out := &in.Akamai, &out.Akamai
 		*out = new(acme.ACMEIssuerDNS01ProviderAkamai)
@@ -594,6 +824,8 @@ func autoConvert_v1_ACMEChallengeSolverDNS01_To_acme_ACMEChallengeSolverDNS01(in
 		out.RFC2136 = nil
 	}
 	out.Webhook = (*acme.ACMEIssuerDNS01ProviderWebhook)(unsafe.Pointer(in.Webhook))
+	out.Manual = (*acme.ACMEIssuerDNS01ProviderManual)(unsafe.Pointer(in.Manual))
+	out.Embedded = (*acme.ACMEIssuerDNS01ProviderEmbedded)(unsafe.Pointer(in.Embedded))
 	return nil
 }
 
@@ -604,6 +836,8 @@ func Convert_v1_ACMEChallengeSolverDNS01_To_acme_ACMEChallengeSolverDNS01(in *v1
 
 func autoConvert_acme_ACMEChallengeSolverDNS01_To_v1_ACMEChallengeSolverDNS01(in *acme.ACMEChallengeSolverDNS01, out *v1.ACMEChallengeSolverDNS01, s conversion.Scope) error {
 	out.CNAMEStrategy = v1.CNAMEStrategy(in.CNAMEStrategy)
+	out.UseDNSAccountChallenge = in.UseDNSAccountChallenge
+	out.SelfCheck = (*v1.ACMEChallengeSolverDNS01SelfCheck)(unsafe.Pointer(in.SelfCheck))
 	if in.Akamai != nil {
 		in, out := &in.Akamai, &out.Akamai
 		*out = new(v1.ACMEIssuerDNS01ProviderAkamai)
@@ -677,6 +911,8 @@ func autoConvert_acme_ACMEChallengeSolverDNS01_To_v1_ACMEChallengeSolverDNS01(in
 		out.RFC2136 = nil
 	}
 	out.Webhook = (*v1.ACMEIssuerDNS01ProviderWebhook)(unsafe.Pointer(in.Webhook))
+	out.Manual = (*v1.ACMEIssuerDNS01ProviderManual)(unsafe.Pointer(in.Manual))
+	out.Embedded = (*v1.ACMEIssuerDNS01ProviderEmbedded)(unsafe.Pointer(in.Embedded))
 	return nil
 }
 
@@ -685,9 +921,60 @@ func Convert_acme_ACMEChallengeSolverDNS01_To_v1_ACMEChallengeSolverDNS01(in *ac
 	return autoConvert_acme_ACMEChallengeSolverDNS01_To_v1_ACMEChallengeSolverDNS01(in, out, s)
 }
 
+func autoConvert_v1_ACMEChallengeSolverDNS01SelfCheck_To_acme_ACMEChallengeSolverDNS01SelfCheck(in *v1.ACMEChallengeSolverDNS01SelfCheck, out *acme.ACMEChallengeSolverDNS01SelfCheck, s conversion.Scope) error {
+	out.RecursiveNameservers = *(*[]string)(unsafe.Pointer(&in.RecursiveNameservers))
+	out.RecursiveNameserversOnly = (*bool)(unsafe.Pointer(in.RecursiveNameserversOnly))
+	out.PropagationTimeout = (*metav1.Duration)(unsafe.Pointer(in.PropagationTimeout))
+	out.Perspectives = *(*[]acme.ACMEChallengeSolverDNS01SelfCheckPerspective)(unsafe.Pointer(&in.Perspectives))
+	out.Quorum = (*int32)(unsafe.Pointer(in.Quorum))
+	return nil
+}
+
+// Convert_v1_ACMEChallengeSolverDNS01SelfCheck_To_acme_ACMEChallengeSolverDNS01SelfCheck is an autogenerated conversion function.
+func Convert_v1_ACMEChallengeSolverDNS01SelfCheck_To_acme_ACMEChallengeSolverDNS01SelfCheck(in *v1.ACMEChallengeSolverDNS01SelfCheck, out *acme.ACMEChallengeSolverDNS01SelfCheck, s conversion.Scope) error {
+	return autoConvert_v1_ACMEChallengeSolverDNS01SelfCheck_To_acme_ACMEChallengeSolverDNS01SelfCheck(in, out, s)
+}
+
+func autoConvert_acme_ACMEChallengeSolverDNS01SelfCheck_To_v1_ACMEChallengeSolverDNS01SelfCheck(in *acme.ACMEChallengeSolverDNS01SelfCheck, out *v1.ACMEChallengeSolverDNS01SelfCheck, s conversion.Scope) error {
+	out.RecursiveNameservers = *(*[]string)(unsafe.Pointer(&in.RecursiveNameservers))
+	out.RecursiveNameserversOnly = (*bool)(unsafe.Pointer(in.RecursiveNameserversOnly))
+	out.PropagationTimeout = (*metav1.Duration)(unsafe.Pointer(in.PropagationTimeout))
+	out.Perspectives = *(*[]v1.ACMEChallengeSolverDNS01SelfCheckPerspective)(unsafe.Pointer(&in.Perspectives))
+	out.Quorum = (*int32)(unsafe.Pointer(in.Quorum))
+	return nil
+}
+
+// Convert_acme_ACMEChallengeSolverDNS01SelfCheck_To_v1_ACMEChallengeSolverDNS01SelfCheck is an autogenerated conversion function.
+func Convert_acme_ACMEChallengeSolverDNS01SelfCheck_To_v1_ACMEChallengeSolverDNS01SelfCheck(in *acme.ACMEChallengeSolverDNS01SelfCheck, out *v1.ACMEChallengeSolverDNS01SelfCheck, s conversion.Scope) error {
+	return autoConvert_acme_ACMEChallengeSolverDNS01SelfCheck_To_v1_ACMEChallengeSolverDNS01SelfCheck(in, out, s)
+}
+
+func autoConvert_v1_ACMEChallengeSolverDNS01SelfCheckPerspective_To_acme_ACMEChallengeSolverDNS01SelfCheckPerspective(in *v1.ACMEChallengeSolverDNS01SelfCheckPerspective, out *acme.ACMEChallengeSolverDNS01SelfCheckPerspective, s conversion.Scope) error {
+	out.Name = in.Name
+	out.RecursiveNameservers = *(*[]string)(unsafe.Pointer(&in.RecursiveNameservers))
+	return nil
+}
+
+// Convert_v1_ACMEChallengeSolverDNS01SelfCheckPerspective_To_acme_ACMEChallengeSolverDNS01SelfCheckPerspective is an autogenerated conversion function.
+func Convert_v1_ACMEChallengeSolverDNS01SelfCheckPerspective_To_acme_ACMEChallengeSolverDNS01SelfCheckPerspective(in *v1.ACMEChallengeSolverDNS01SelfCheckPerspective, out *acme.ACMEChallengeSolverDNS01SelfCheckPerspective, s conversion.Scope) error {
+	return autoConvert_v1_ACMEChallengeSolverDNS01SelfCheckPerspective_To_acme_ACMEChallengeSolverDNS01SelfCheckPerspective(in, out, s)
+}
+
+func autoConvert_acme_ACMEChallengeSolverDNS01SelfCheckPerspective_To_v1_ACMEChallengeSolverDNS01SelfCheckPerspective(in *acme.ACMEChallengeSolverDNS01SelfCheckPerspective, out *v1.ACMEChallengeSolverDNS01SelfCheckPerspective, s conversion.Scope) error {
+	out.Name = in.Name
+	out.RecursiveNameservers = *(*[]string)(unsafe.Pointer(&in.RecursiveNameservers))
+	return nil
+}
+
+// Convert_acme_ACMEChallengeSolverDNS01SelfCheckPerspective_To_v1_ACMEChallengeSolverDNS01SelfCheckPerspective is an autogenerated conversion function.
+func Convert_acme_ACMEChallengeSolverDNS01SelfCheckPerspective_To_v1_ACMEChallengeSolverDNS01SelfCheckPerspective(in *acme.ACMEChallengeSolverDNS01SelfCheckPerspective, out *v1.ACMEChallengeSolverDNS01SelfCheckPerspective, s conversion.Scope) error {
+	return autoConvert_acme_ACMEChallengeSolverDNS01SelfCheckPerspective_To_v1_ACMEChallengeSolverDNS01SelfCheckPerspective(in, out, s)
+}
+
 func autoConvert_v1_ACMEChallengeSolverHTTP01_To_acme_ACMEChallengeSolverHTTP01(in *v1.ACMEChallengeSolverHTTP01, out *acme.ACMEChallengeSolverHTTP01, s conversion.Scope) error {
 	out.Ingress = (*acme.ACMEChallengeSolverHTTP01Ingress)(unsafe.Pointer(in.Ingress))
 	out.GatewayHTTPRoute = (*acme.ACMEChallengeSolverHTTP01GatewayHTTPRoute)(unsafe.Pointer(in.GatewayHTTPRoute))
+	out.SelfCheck = (*acme.ACMEChallengeSolverHTTP01SelfCheck)(unsafe.Pointer(in.SelfCheck))
 	return nil
 }
 
@@ -699,6 +986,7 @@ func Convert_v1_ACMEChallengeSolverHTTP01_To_acme_ACMEChallengeSolverHTTP01(in *
 func autoConvert_acme_ACMEChallengeSolverHTTP01_To_v1_ACMEChallengeSolverHTTP01(in *acme.ACMEChallengeSolverHTTP01, out *v1.ACMEChallengeSolverHTTP01, s conversion.Scope) error {
 	out.Ingress = (*v1.ACMEChallengeSolverHTTP01Ingress)(unsafe.Pointer(in.Ingress))
 	out.GatewayHTTPRoute = (*v1.ACMEChallengeSolverHTTP01GatewayHTTPRoute)(unsafe.Pointer(in.GatewayHTTPRoute))
+	out.SelfCheck = (*v1.ACMEChallengeSolverHTTP01SelfCheck)(unsafe.Pointer(in.SelfCheck))
 	return nil
 }
 
@@ -929,9 +1217,101 @@ func Convert_acme_ACMEChallengeSolverHTTP01IngressTemplate_To_v1_ACMEChallengeSo
 	return autoConvert_acme_ACMEChallengeSolverHTTP01IngressTemplate_To_v1_ACMEChallengeSolverHTTP01IngressTemplate(in, out, s)
 }
 
+func autoConvert_v1_ACMEChallengeSolverHTTP01SelfCheck_To_acme_ACMEChallengeSolverHTTP01SelfCheck(in *v1.ACMEChallengeSolverHTTP01SelfCheck, out *acme.ACMEChallengeSolverHTTP01SelfCheck, s conversion.Scope) error {
+	out.Perspectives = *(*[]acme.ACMEChallengeSolverHTTP01SelfCheckPerspective)(unsafe.Pointer(&in.Perspectives))
+	out.Quorum = (*int32)(unsafe.Pointer(in.Quorum))
+	return nil
+}
+
+// Convert_v1_ACMEChallengeSolverHTTP01SelfCheck_To_acme_ACMEChallengeSolverHTTP01SelfCheck is an autogenerated conversion function.
+func Convert_v1_ACMEChallengeSolverHTTP01SelfCheck_To_acme_ACMEChallengeSolverHTTP01SelfCheck(in *v1.ACMEChallengeSolverHTTP01SelfCheck, out *acme.ACMEChallengeSolverHTTP01SelfCheck, s conversion.Scope) error {
+	return autoConvert_v1_ACMEChallengeSolverHTTP01SelfCheck_To_acme_ACMEChallengeSolverHTTP01SelfCheck(in, out, s)
+}
+
+func autoConvert_acme_ACMEChallengeSolverHTTP01SelfCheck_To_v1_ACMEChallengeSolverHTTP01SelfCheck(in *acme.ACMEChallengeSolverHTTP01SelfCheck, out *v1.ACMEChallengeSolverHTTP01SelfCheck, s conversion.Scope) error {
+	out.Perspectives = *(*[]v1.ACMEChallengeSolverHTTP01SelfCheckPerspective)(unsafe.Pointer(&in.Perspectives))
+	out.Quorum = (*int32)(unsafe.Pointer(in.Quorum))
+	return nil
+}
+
+// Convert_acme_ACMEChallengeSolverHTTP01SelfCheck_To_v1_ACMEChallengeSolverHTTP01SelfCheck is an autogenerated conversion function.
+func Convert_acme_ACMEChallengeSolverHTTP01SelfCheck_To_v1_ACMEChallengeSolverHTTP01SelfCheck(in *acme.ACMEChallengeSolverHTTP01SelfCheck, out *v1.ACMEChallengeSolverHTTP01SelfCheck, s conversion.Scope) error {
+	return autoConvert_acme_ACMEChallengeSolverHTTP01SelfCheck_To_v1_ACMEChallengeSolverHTTP01SelfCheck(in, out, s)
+}
+
+func autoConvert_v1_ACMEChallengeSolverHTTP01SelfCheckPerspective_To_acme_ACMEChallengeSolverHTTP01SelfCheckPerspective(in *v1.ACMEChallengeSolverHTTP01SelfCheckPerspective, out *acme.ACMEChallengeSolverHTTP01SelfCheckPerspective, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ProxyURL = in.ProxyURL
+	out.SourceAddress = in.SourceAddress
+	return nil
+}
+
+// Convert_v1_ACMEChallengeSolverHTTP01SelfCheckPerspective_To_acme_ACMEChallengeSolverHTTP01SelfCheckPerspective is an autogenerated conversion function.
+func Convert_v1_ACMEChallengeSolverHTTP01SelfCheckPerspective_To_acme_ACMEChallengeSolverHTTP01SelfCheckPerspective(in *v1.ACMEChallengeSolverHTTP01SelfCheckPerspective, out *acme.ACMEChallengeSolverHTTP01SelfCheckPerspective, s conversion.Scope) error {
+	return autoConvert_v1_ACMEChallengeSolverHTTP01SelfCheckPerspective_To_acme_ACMEChallengeSolverHTTP01SelfCheckPerspective(in, out, s)
+}
+
+func autoConvert_acme_ACMEChallengeSolverHTTP01SelfCheckPerspective_To_v1_ACMEChallengeSolverHTTP01SelfCheckPerspective(in *acme.ACMEChallengeSolverHTTP01SelfCheckPerspective, out *v1.ACMEChallengeSolverHTTP01SelfCheckPerspective, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ProxyURL = in.ProxyURL
+	out.SourceAddress = in.SourceAddress
+	return nil
+}
+
+// Convert_acme_ACMEChallengeSolverHTTP01SelfCheckPerspective_To_v1_ACMEChallengeSolverHTTP01SelfCheckPerspective is an autogenerated conversion function.
+func Convert_acme_ACMEChallengeSolverHTTP01SelfCheckPerspective_To_v1_ACMEChallengeSolverHTTP01SelfCheckPerspective(in *acme.ACMEChallengeSolverHTTP01SelfCheckPerspective, out *v1.ACMEChallengeSolverHTTP01SelfCheckPerspective, s conversion.Scope) error {
+	return autoConvert_acme_ACMEChallengeSolverHTTP01SelfCheckPerspective_To_v1_ACMEChallengeSolverHTTP01SelfCheckPerspective(in, out, s)
+}
+
+func autoConvert_v1_ACMEChallengeSolverTLSALPN01_To_acme_ACMEChallengeSolverTLSALPN01(in *v1.ACMEChallengeSolverTLSALPN01, out *acme.ACMEChallengeSolverTLSALPN01, s conversion.Scope) error {
+	out.ServiceType = corev1.ServiceType(in.ServiceType)
+	out.GatewayTLSRoute = (*acme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute)(unsafe.Pointer(in.GatewayTLSRoute))
+	out.PodTemplate = (*acme.ACMEChallengeSolverHTTP01IngressPodTemplate)(unsafe.Pointer(in.PodTemplate))
+	return nil
+}
+
+// Convert_v1_ACMEChallengeSolverTLSALPN01_To_acme_ACMEChallengeSolverTLSALPN01 is an autogenerated conversion function.
+func Convert_v1_ACMEChallengeSolverTLSALPN01_To_acme_ACMEChallengeSolverTLSALPN01(in *v1.ACMEChallengeSolverTLSALPN01, out *acme.ACMEChallengeSolverTLSALPN01, s conversion.Scope) error {
+	return autoConvert_v1_ACMEChallengeSolverTLSALPN01_To_acme_ACMEChallengeSolverTLSALPN01(in, out, s)
+}
+
+func autoConvert_acme_ACMEChallengeSolverTLSALPN01_To_v1_ACMEChallengeSolverTLSALPN01(in *acme.ACMEChallengeSolverTLSALPN01, out *v1.ACMEChallengeSolverTLSALPN01, s conversion.Scope) error {
+	out.ServiceType = corev1.ServiceType(in.ServiceType)
+	out.GatewayTLSRoute = (*v1.ACMEChallengeSolverTLSALPN01GatewayTLSRoute)(unsafe.Pointer(in.GatewayTLSRoute))
+	out.PodTemplate = (*v1.ACMEChallengeSolverHTTP01IngressPodTemplate)(unsafe.Pointer(in.PodTemplate))
+	return nil
+}
+
+// Convert_acme_ACMEChallengeSolverTLSALPN01_To_v1_ACMEChallengeSolverTLSALPN01 is an autogenerated conversion function.
+func Convert_acme_ACMEChallengeSolverTLSALPN01_To_v1_ACMEChallengeSolverTLSALPN01(in *acme.ACMEChallengeSolverTLSALPN01, out *v1.ACMEChallengeSolverTLSALPN01, s conversion.Scope) error {
+	return autoConvert_acme_ACMEChallengeSolverTLSALPN01_To_v1_ACMEChallengeSolverTLSALPN01(in, out, s)
+}
+
+func autoConvert_v1_ACMEChallengeSolverTLSALPN01GatewayTLSRoute_To_acme_ACMEChallengeSolverTLSALPN01GatewayTLSRoute(in *v1.ACMEChallengeSolverTLSALPN01GatewayTLSRoute, out *acme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute, s conversion.Scope) error {
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.ParentRefs = *(*[]apisv1.ParentReference)(unsafe.Pointer(&in.ParentRefs))
+	return nil
+}
+
+// Convert_v1_ACMEChallengeSolverTLSALPN01GatewayTLSRoute_To_acme_ACMEChallengeSolverTLSALPN01GatewayTLSRoute is an autogenerated conversion function.
+func Convert_v1_ACMEChallengeSolverTLSALPN01GatewayTLSRoute_To_acme_ACMEChallengeSolverTLSALPN01GatewayTLSRoute(in *v1.ACMEChallengeSolverTLSALPN01GatewayTLSRoute, out *acme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute, s conversion.Scope) error {
+	return autoConvert_v1_ACMEChallengeSolverTLSALPN01GatewayTLSRoute_To_acme_ACMEChallengeSolverTLSALPN01GatewayTLSRoute(in, out, s)
+}
+
+func autoConvert_acme_ACMEChallengeSolverTLSALPN01GatewayTLSRoute_To_v1_ACMEChallengeSolverTLSALPN01GatewayTLSRoute(in *acme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute, out *v1.ACMEChallengeSolverTLSALPN01GatewayTLSRoute, s conversion.Scope) error {
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.ParentRefs = *(*[]apisv1.ParentReference)(unsafe.Pointer(&in.ParentRefs))
+	return nil
+}
+
+// Convert_acme_ACMEChallengeSolverTLSALPN01GatewayTLSRoute_To_v1_ACMEChallengeSolverTLSALPN01GatewayTLSRoute is an autogenerated conversion function.
+func Convert_acme_ACMEChallengeSolverTLSALPN01GatewayTLSRoute_To_v1_ACMEChallengeSolverTLSALPN01GatewayTLSRoute(in *acme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute, out *v1.ACMEChallengeSolverTLSALPN01GatewayTLSRoute, s conversion.Scope) error {
+	return autoConvert_acme_ACMEChallengeSolverTLSALPN01GatewayTLSRoute_To_v1_ACMEChallengeSolverTLSALPN01GatewayTLSRoute(in, out, s)
+}
+
 func autoConvert_v1_ACMEExternalAccountBinding_To_acme_ACMEExternalAccountBinding(in *v1.ACMEExternalAccountBinding, out *acme.ACMEExternalAccountBinding, s conversion.Scope) error {
 	out.KeyID = in.KeyID
-	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.Key, &out.Key, s); err != nil {
+	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.Key, &out.Key, s); err != nil {
 		return err
 	}
 	out.KeyAlgorithm = acme.HMACKeyAlgorithm(in.KeyAlgorithm)
@@ -945,7 +1325,7 @@ func Convert_v1_ACMEExternalAccountBinding_To_acme_ACMEExternalAccountBinding(in
 
 func autoConvert_acme_ACMEExternalAccountBinding_To_v1_ACMEExternalAccountBinding(in *acme.ACMEExternalAccountBinding, out *v1.ACMEExternalAccountBinding, s conversion.Scope) error {
 	out.KeyID = in.KeyID
-	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.Key, &out.Key, s); err != nil {
+	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.Key, &out.Key, s); err != nil {
 		return err
 	}
 	out.KeyAlgorithm = v1.HMACKeyAlgorithm(in.KeyAlgorithm)
@@ -957,10 +1337,92 @@ func Convert_acme_ACMEExternalAccountBinding_To_v1_ACMEExternalAccountBinding(in
 	return autoConvert_acme_ACMEExternalAccountBinding_To_v1_ACMEExternalAccountBinding(in, out, s)
 }
 
+func autoConvert_v1_ACMEFallbackAccountStatus_To_acme_ACMEFallbackAccountStatus(in *v1.ACMEFallbackAccountStatus, out *acme.ACMEFallbackAccountStatus, s conversion.Scope) error {
+	out.Server = in.Server
+	out.URI = in.URI
+	out.LastRegisteredEmail = in.LastRegisteredEmail
+	out.LastPrivateKeyHash = in.LastPrivateKeyHash
+	return nil
+}
+
+// Convert_v1_ACMEFallbackAccountStatus_To_acme_ACMEFallbackAccountStatus is an autogenerated conversion function.
+func Convert_v1_ACMEFallbackAccountStatus_To_acme_ACMEFallbackAccountStatus(in *v1.ACMEFallbackAccountStatus, out *acme.ACMEFallbackAccountStatus, s conversion.Scope) error {
+	return autoConvert_v1_ACMEFallbackAccountStatus_To_acme_ACMEFallbackAccountStatus(in, out, s)
+}
+
+func autoConvert_acme_ACMEFallbackAccountStatus_To_v1_ACMEFallbackAccountStatus(in *acme.ACMEFallbackAccountStatus, out *v1.ACMEFallbackAccountStatus, s conversion.Scope) error {
+	out.Server = in.Server
+	out.URI = in.URI
+	out.LastRegisteredEmail = in.LastRegisteredEmail
+	out.LastPrivateKeyHash = in.LastPrivateKeyHash
+	return nil
+}
+
+// Convert_acme_ACMEFallbackAccountStatus_To_v1_ACMEFallbackAccountStatus is an autogenerated conversion function.
+func Convert_acme_ACMEFallbackAccountStatus_To_v1_ACMEFallbackAccountStatus(in *acme.ACMEFallbackAccountStatus, out *v1.ACMEFallbackAccountStatus, s conversion.Scope) error {
+	return autoConvert_acme_ACMEFallbackAccountStatus_To_v1_ACMEFallbackAccountStatus(in, out, s)
+}
+
+func autoConvert_v1_ACMEFallbackServer_To_acme_ACMEFallbackServer(in *v1.ACMEFallbackServer, out *acme.ACMEFallbackServer, s conversion.Scope) error {
+	out.Server = in.Server
+	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
+		return err
+	}
+	if in.ExternalAccountBinding != nil {
+		in, out := &in.ExternalAccountBinding, &out.ExternalAccountBinding
+		*out = new(acme.ACMEExternalAccountBinding)
+		if err := Convert_v1_ACMEExternalAccountBinding_To_acme_ACMEExternalAccountBinding(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ExternalAccountBinding = nil
+	}
+	return nil
+}
+
+// Convert_v1_ACMEFallbackServer_To_acme_ACMEFallbackServer is an autogenerated conversion function.
+func Convert_v1_ACMEFallbackServer_To_acme_ACMEFallbackServer(in *v1.ACMEFallbackServer, out *acme.ACMEFallbackServer, s conversion.Scope) error {
+	return autoConvert_v1_ACMEFallbackServer_To_acme_ACMEFallbackServer(in, out, s)
+}
+
+func autoConvert_acme_ACMEFallbackServer_To_v1_ACMEFallbackServer(in *acme.ACMEFallbackServer, out *v1.ACMEFallbackServer, s conversion.Scope) error {
+	out.Server = in.Server
+	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
+		return err
+	}
+	if in.ExternalAccountBinding != nil {
+		in, out := &in.ExternalAccountBinding, &out.ExternalAccountBinding
+		*out = new(v1.ACMEExternalAccountBinding)
+		if err := Convert_acme_ACMEExternalAccountBinding_To_v1_ACMEExternalAccountBinding(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ExternalAccountBinding = nil
+	}
+	return nil
+}
+
+// Convert_acme_ACMEFallbackServer_To_v1_ACMEFallbackServer is an autogenerated conversion function.
+func Convert_acme_ACMEFallbackServer_To_v1_ACMEFallbackServer(in *acme.ACMEFallbackServer, out *v1.ACMEFallbackServer, s conversion.Scope) error {
+	return autoConvert_acme_ACMEFallbackServer_To_v1_ACMEFallbackServer(in, out, s)
+}
+
 func autoConvert_v1_ACMEIssuer_To_acme_ACMEIssuer(in *v1.ACMEIssuer, out *acme.ACMEIssuer, s conversion.Scope) error {
 	out.Email = in.Email
 	out.Server = in.Server
+	if in.FallbackServers != nil {
+		in, out := &in.FallbackServers, &out.FallbackServers
+		*out = make([]acme.ACMEFallbackServer, len(*in))
+		for i := range *in {
+			if err := Convert_v1_ACMEFallbackServer_To_acme_ACMEFallbackServer(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.FallbackServers = nil
+	}
 	out.PreferredChain = in.PreferredChain
+	out.Profile = in.Profile
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
 	out.SkipTLSVerify = in.SkipTLSVerify
 	if in.ExternalAccountBinding != nil {
@@ -972,9 +1434,20 @@ func autoConvert_v1_ACMEIssuer_To_acme_ACMEIssuer(in *v1.ACMEIssuer, out *acme.A
 	} else {
 		out.ExternalAccountBinding = nil
 	}
-	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
+	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
 		return err
 	}
+	out.AccountPrivateKey = (*acme.ACMEAccountPrivateKey)(unsafe.Pointer(in.AccountPrivateKey))
+	if in.NextPrivateKey != nil {
+		in, out := &in.NextPrivateKey, &out.NextPrivateKey
+		*out = new(meta.SecretKeySelector)
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.NextPrivateKey = nil
+	}
+	out.AccountDeletionPolicy = acme.ACMEAccountDeletionPolicy(in.AccountDeletionPolicy)
 	if in.Solvers != nil {
 		in, out := &in.Solvers, &out.Solvers
 		*out = make([]acme.ACMEChallengeSolver, len(*in))
@@ -994,7 +1467,19 @@ func autoConvert_v1_ACMEIssuer_To_acme_ACMEIssuer(in *v1.ACMEIssuer, out *acme.A
 func autoConvert_acme_ACMEIssuer_To_v1_ACMEIssuer(in *acme.ACMEIssuer, out *v1.ACMEIssuer, s conversion.Scope) error {
 	out.Email = in.Email
 	out.Server = in.Server
+	if in.FallbackServers != nil {
+		in, out := &in.FallbackServers, &out.FallbackServers
+		*out = make([]v1.ACMEFallbackServer, len(*in))
+		for i := range *in {
+			if err := Convert_acme_ACMEFallbackServer_To_v1_ACMEFallbackServer(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.FallbackServers = nil
+	}
 	out.PreferredChain = in.PreferredChain
+	out.Profile = in.Profile
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
 	out.SkipTLSVerify = in.SkipTLSVerify
 	if in.ExternalAccountBinding != nil {
@@ -1006,9 +1491,20 @@ func autoConvert_acme_ACMEIssuer_To_v1_ACMEIssuer(in *acme.ACMEIssuer, out *v1.A
 	} else {
 		out.ExternalAccountBinding = nil
 	}
-	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
+	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
 		return err
 	}
+	out.AccountPrivateKey = (*v1.ACMEAccountPrivateKey)(unsafe.Pointer(in.AccountPrivateKey))
+	if in.NextPrivateKey != nil {
+		in, out := &in.NextPrivateKey, &out.NextPrivateKey
+		*out = new(pkgapismetav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.NextPrivateKey = nil
+	}
+	out.AccountDeletionPolicy = v1.ACMEAccountDeletionPolicy(in.AccountDeletionPolicy)
 	if in.Solvers != nil {
 		in, out := &in.Solvers, &out.Solvers
 		*out = make([]v1.ACMEChallengeSolver, len(*in))
@@ -1027,9 +1523,10 @@ func autoConvert_acme_ACMEIssuer_To_v1_ACMEIssuer(in *acme.ACMEIssuer, out *v1.A
 
 func autoConvert_v1_ACMEIssuerDNS01ProviderAcmeDNS_To_acme_ACMEIssuerDNS01ProviderAcmeDNS(in *v1.ACMEIssuerDNS01ProviderAcmeDNS, out *acme.ACMEIssuerDNS01ProviderAcmeDNS, s conversion.Scope) error {
 	out.Host = in.Host
-	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.AccountSecret, &out.AccountSecret, s); err != nil {
+	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.AccountSecret, &out.AccountSecret, s); err != nil {
 		return err
 	}
+	out.AutoRegister = in.AutoRegister
 	return nil
 }
 
@@ -1040,9 +1537,10 @@ func Convert_v1_ACMEIssuerDNS01ProviderAcmeDNS_To_acme_ACMEIssuerDNS01ProviderAc
 
 func autoConvert_acme_ACMEIssuerDNS01ProviderAcmeDNS_To_v1_ACMEIssuerDNS01ProviderAcmeDNS(in *acme.ACMEIssuerDNS01ProviderAcmeDNS, out *v1.ACMEIssuerDNS01ProviderAcmeDNS, s conversion.Scope) error {
 	out.Host = in.Host
-	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.AccountSecret, &out.AccountSecret, s); err != nil {
+	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.AccountSecret, &out.AccountSecret, s); err != nil {
 		return err
 	}
+	out.AutoRegister = in.AutoRegister
 	return nil
 }
 
@@ -1053,13 +1551,13 @@ func Convert_acme_ACMEIssuerDNS01ProviderAcmeDNS_To_v1_ACMEIssuerDNS01ProviderAc
 
 func autoConvert_v1_ACMEIssuerDNS01ProviderAkamai_To_acme_ACMEIssuerDNS01ProviderAkamai(in *v1.ACMEIssuerDNS01ProviderAkamai, out *acme.ACMEIssuerDNS01ProviderAkamai, s conversion.Scope) error {
 	out.ServiceConsumerDomain = in.ServiceConsumerDomain
-	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.ClientToken, &out.ClientToken, s); err != nil {
+	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.ClientToken, &out.ClientToken, s); err != nil {
 		return err
 	}
-	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.ClientSecret, &out.ClientSecret, s); err != nil {
+	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.ClientSecret, &out.ClientSecret, s); err != nil {
 		return err
 	}
-	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.AccessToken, &out.AccessToken, s); err != nil {
+	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.AccessToken, &out.AccessToken, s); err != nil {
 		return err
 	}
 	return nil
@@ -1072,13 +1570,13 @@ func Convert_v1_ACMEIssuerDNS01ProviderAkamai_To_acme_ACMEIssuerDNS01ProviderAka
 
 func autoConvert_acme_ACMEIssuerDNS01ProviderAkamai_To_v1_ACMEIssuerDNS01ProviderAkamai(in *acme.ACMEIssuerDNS01ProviderAkamai, out *v1.ACMEIssuerDNS01ProviderAkamai, s conversion.Scope) error {
 	out.ServiceConsumerDomain = in.ServiceConsumerDomain
-	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.ClientToken, &out.ClientToken, s); err != nil {
+	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.ClientToken, &out.ClientToken, s); err != nil {
 		return err
 	}
-	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.ClientSecret, &out.ClientSecret, s); err != nil {
+	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.ClientSecret, &out.ClientSecret, s); err != nil {
 		return err
 	}
-	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.AccessToken, &out.AccessToken, s); err != nil {
+	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.AccessToken, &out.AccessToken, s); err != nil {
 		return err
 	}
 	return nil
@@ -1094,7 +1592,7 @@ func autoConvert_v1_ACMEIssuerDNS01ProviderAzureDNS_To_acme_ACMEIssuerDNS01Provi
 	if in.ClientSecret != nil {
 		in, out := &in.ClientSecret, &out.ClientSecret
 		*out = new(meta.SecretKeySelector)
-		if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1118,8 +1616,8 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderAzureDNS_To_v1_ACMEIssuerDNS01Provi
 	out.ClientID = in.ClientID
 	if in.ClientSecret != nil {
 		in, out := &in.ClientSecret, &out.ClientSecret
-		*out = new(apismetav1.SecretKeySelector)
-		if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+		*out = new(pkgapismetav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1143,7 +1641,7 @@ func autoConvert_v1_ACMEIssuerDNS01ProviderCloudDNS_To_acme_ACMEIssuerDNS01Provi
 	if in.ServiceAccount != nil {
 		in, out := &in.ServiceAccount, &out.ServiceAccount
 		*out = new(meta.SecretKeySelector)
-		if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1162,8 +1660,8 @@ func Convert_v1_ACMEIssuerDNS01ProviderCloudDNS_To_acme_ACMEIssuerDNS01ProviderC
 func autoConvert_acme_ACMEIssuerDNS01ProviderCloudDNS_To_v1_ACMEIssuerDNS01ProviderCloudDNS(in *acme.ACMEIssuerDNS01ProviderCloudDNS, out *v1.ACMEIssuerDNS01ProviderCloudDNS, s conversion.Scope) error {
 	if in.ServiceAccount != nil {
 		in, out := &in.ServiceAccount, &out.ServiceAccount
-		*out = new(apismetav1.SecretKeySelector)
-		if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+		*out = new(pkgapismetav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1184,7 +1682,7 @@ func autoConvert_v1_ACMEIssuerDNS01ProviderCloudflare_To_acme_ACMEIssuerDNS01Pro
 	if in.APIKey != nil {
 		in, out := &in.APIKey, &out.APIKey
 		*out = new(meta.SecretKeySelector)
-		if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1193,7 +1691,7 @@ func autoConvert_v1_ACMEIssuerDNS01ProviderCloudflare_To_acme_ACMEIssuerDNS01Pro
 	if in.APIToken != nil {
 		in, out := &in.APIToken, &out.APIToken
 		*out = new(meta.SecretKeySelector)
-		if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1211,8 +1709,8 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderCloudflare_To_v1_ACMEIssuerDNS01Pro
 	out.Email = in.Email
 	if in.APIKey != nil {
 		in, out := &in.APIKey, &out.APIKey
-		*out = new(apismetav1.SecretKeySelector)
-		if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+		*out = new(pkgapismetav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1220,8 +1718,8 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderCloudflare_To_v1_ACMEIssuerDNS01Pro
 	}
 	if in.APIToken != nil {
 		in, out := &in.APIToken, &out.APIToken
-		*out = new(apismetav1.SecretKeySelector)
-		if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+		*out = new(pkgapismetav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1236,7 +1734,7 @@ func Convert_acme_ACMEIssuerDNS01ProviderCloudflare_To_v1_ACMEIssuerDNS01Provide
 }
 
 func autoConvert_v1_ACMEIssuerDNS01ProviderDigitalOcean_To_acme_ACMEIssuerDNS01ProviderDigitalOcean(in *v1.ACMEIssuerDNS01ProviderDigitalOcean, out *acme.ACMEIssuerDNS01ProviderDigitalOcean, s conversion.Scope) error {
-	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.Token, &out.Token, s); err != nil {
+	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.Token, &out.Token, s); err != nil {
 		return err
 	}
 	return nil
@@ -1248,7 +1746,7 @@ func Convert_v1_ACMEIssuerDNS01ProviderDigitalOcean_To_acme_ACMEIssuerDNS01Provi
 }
 
 func autoConvert_acme_ACMEIssuerDNS01ProviderDigitalOcean_To_v1_ACMEIssuerDNS01ProviderDigitalOcean(in *acme.ACMEIssuerDNS01ProviderDigitalOcean, out *v1.ACMEIssuerDNS01ProviderDigitalOcean, s conversion.Scope) error {
-	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.Token, &out.Token, s); err != nil {
+	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.Token, &out.Token, s); err != nil {
 		return err
 	}
 	return nil
@@ -1259,9 +1757,47 @@ func Convert_acme_ACMEIssuerDNS01ProviderDigitalOcean_To_v1_ACMEIssuerDNS01Provi
 	return autoConvert_acme_ACMEIssuerDNS01ProviderDigitalOcean_To_v1_ACMEIssuerDNS01ProviderDigitalOcean(in, out, s)
 }
 
+func autoConvert_v1_ACMEIssuerDNS01ProviderEmbedded_To_acme_ACMEIssuerDNS01ProviderEmbedded(in *v1.ACMEIssuerDNS01ProviderEmbedded, out *acme.ACMEIssuerDNS01ProviderEmbedded, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1_ACMEIssuerDNS01ProviderEmbedded_To_acme_ACMEIssuerDNS01ProviderEmbedded is an autogenerated conversion function.
+func Convert_v1_ACMEIssuerDNS01ProviderEmbedded_To_acme_ACMEIssuerDNS01ProviderEmbedded(in *v1.ACMEIssuerDNS01ProviderEmbedded, out *acme.ACMEIssuerDNS01ProviderEmbedded, s conversion.Scope) error {
+	return autoConvert_v1_ACMEIssuerDNS01ProviderEmbedded_To_acme_ACMEIssuerDNS01ProviderEmbedded(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderEmbedded_To_v1_ACMEIssuerDNS01ProviderEmbedded(in *acme.ACMEIssuerDNS01ProviderEmbedded, out *v1.ACMEIssuerDNS01ProviderEmbedded, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderEmbedded_To_v1_ACMEIssuerDNS01ProviderEmbedded is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderEmbedded_To_v1_ACMEIssuerDNS01ProviderEmbedded(in *acme.ACMEIssuerDNS01ProviderEmbedded, out *v1.ACMEIssuerDNS01ProviderEmbedded, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderEmbedded_To_v1_ACMEIssuerDNS01ProviderEmbedded(in, out, s)
+}
+
+func autoConvert_v1_ACMEIssuerDNS01ProviderManual_To_acme_ACMEIssuerDNS01ProviderManual(in *v1.ACMEIssuerDNS01ProviderManual, out *acme.ACMEIssuerDNS01ProviderManual, s conversion.Scope) error {
+	out.Timeout = (*metav1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1_ACMEIssuerDNS01ProviderManual_To_acme_ACMEIssuerDNS01ProviderManual is an autogenerated conversion function.
+func Convert_v1_ACMEIssuerDNS01ProviderManual_To_acme_ACMEIssuerDNS01ProviderManual(in *v1.ACMEIssuerDNS01ProviderManual, out *acme.ACMEIssuerDNS01ProviderManual, s conversion.Scope) error {
+	return autoConvert_v1_ACMEIssuerDNS01ProviderManual_To_acme_ACMEIssuerDNS01ProviderManual(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderManual_To_v1_ACMEIssuerDNS01ProviderManual(in *acme.ACMEIssuerDNS01ProviderManual, out *v1.ACMEIssuerDNS01ProviderManual, s conversion.Scope) error {
+	out.Timeout = (*metav1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderManual_To_v1_ACMEIssuerDNS01ProviderManual is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderManual_To_v1_ACMEIssuerDNS01ProviderManual(in *acme.ACMEIssuerDNS01ProviderManual, out *v1.ACMEIssuerDNS01ProviderManual, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderManual_To_v1_ACMEIssuerDNS01ProviderManual(in, out, s)
+}
+
 func autoConvert_v1_ACMEIssuerDNS01ProviderRFC2136_To_acme_ACMEIssuerDNS01ProviderRFC2136(in *v1.ACMEIssuerDNS01ProviderRFC2136, out *acme.ACMEIssuerDNS01ProviderRFC2136, s conversion.Scope) error {
 	out.Nameserver = in.Nameserver
-	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.TSIGSecret, &out.TSIGSecret, s); err != nil {
+	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.TSIGSecret, &out.TSIGSecret, s); err != nil {
 		return err
 	}
 	out.TSIGKeyName = in.TSIGKeyName
@@ -1276,7 +1812,7 @@ func Convert_v1_ACMEIssuerDNS01ProviderRFC2136_To_acme_ACMEIssuerDNS01ProviderRF
 
 func autoConvert_acme_ACMEIssuerDNS01ProviderRFC2136_To_v1_ACMEIssuerDNS01ProviderRFC2136(in *acme.ACMEIssuerDNS01ProviderRFC2136, out *v1.ACMEIssuerDNS01ProviderRFC2136, s conversion.Scope) error {
 	out.Nameserver = in.Nameserver
-	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.TSIGSecret, &out.TSIGSecret, s); err != nil {
+	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.TSIGSecret, &out.TSIGSecret, s); err != nil {
 		return err
 	}
 	out.TSIGKeyName = in.TSIGKeyName
@@ -1295,13 +1831,13 @@ func autoConvert_v1_ACMEIssuerDNS01ProviderRoute53_To_acme_ACMEIssuerDNS01Provid
 	if in.SecretAccessKeyID != nil {
 		in, out := &in.SecretAccessKeyID, &out.SecretAccessKeyID
 		*out = new(meta.SecretKeySelector)
-		if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
 		out.SecretAccessKeyID = nil
 	}
-	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretAccessKey, &out.SecretAccessKey, s); err != nil {
+	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretAccessKey, &out.SecretAccessKey, s); err != nil {
 		return err
 	}
 	out.Role = in.Role
@@ -1320,14 +1856,14 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderRoute53_To_v1_ACMEIssuerDNS01Provid
 	out.AccessKeyID = in.AccessKeyID
 	if in.SecretAccessKeyID != nil {
 		in, out := &in.SecretAccessKeyID, &out.SecretAccessKeyID
-		*out = new(apismetav1.SecretKeySelector)
-		if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+		*out = new(pkgapismetav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
 		out.SecretAccessKeyID = nil
 	}
-	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretAccessKey, &out.SecretAccessKey, s); err != nil {
+	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretAccessKey, &out.SecretAccessKey, s); err != nil {
 		return err
 	}
 	out.Role = in.Role
@@ -1369,6 +1905,8 @@ func autoConvert_v1_ACMEIssuerStatus_To_acme_ACMEIssuerStatus(in *v1.ACMEIssuerS
 	out.URI = in.URI
 	out.LastRegisteredEmail = in.LastRegisteredEmail
 	out.LastPrivateKeyHash = in.LastPrivateKeyHash
+	out.FallbackAccounts = *(*[]acme.ACMEFallbackAccountStatus)(unsafe.Pointer(&in.FallbackAccounts))
+	out.AcmeDNSRegistrations = *(*[]acme.ACMEAcmeDNSRegistration)(unsafe.Pointer(&in.AcmeDNSRegistrations))
 	return nil
 }
 
@@ -1381,6 +1919,8 @@ func autoConvert_acme_ACMEIssuerStatus_To_v1_ACMEIssuerStatus(in *acme.ACMEIssue
 	out.URI = in.URI
 	out.LastRegisteredEmail = in.LastRegisteredEmail
 	out.LastPrivateKeyHash = in.LastPrivateKeyHash
+	out.FallbackAccounts = *(*[]v1.ACMEFallbackAccountStatus)(unsafe.Pointer(&in.FallbackAccounts))
+	out.AcmeDNSRegistrations = *(*[]v1.ACMEAcmeDNSRegistration)(unsafe.Pointer(&in.AcmeDNSRegistrations))
 	return nil
 }
 
@@ -1467,6 +2007,30 @@ func Convert_acme_Challenge_To_v1_Challenge(in *acme.Challenge, out *v1.Challeng
 	return autoConvert_acme_Challenge_To_v1_Challenge(in, out, s)
 }
 
+func autoConvert_v1_ChallengeDNS01Record_To_acme_ChallengeDNS01Record(in *v1.ChallengeDNS01Record, out *acme.ChallengeDNS01Record, s conversion.Scope) error {
+	out.FQDN = in.FQDN
+	out.Value = in.Value
+	out.CNAME = in.CNAME
+	return nil
+}
+
+// Convert_v1_ChallengeDNS01Record_To_acme_ChallengeDNS01Record is an autogenerated conversion function.
+func Convert_v1_ChallengeDNS01Record_To_acme_ChallengeDNS01Record(in *v1.ChallengeDNS01Record, out *acme.ChallengeDNS01Record, s conversion.Scope) error {
+	return autoConvert_v1_ChallengeDNS01Record_To_acme_ChallengeDNS01Record(in, out, s)
+}
+
+func autoConvert_acme_ChallengeDNS01Record_To_v1_ChallengeDNS01Record(in *acme.ChallengeDNS01Record, out *v1.ChallengeDNS01Record, s conversion.Scope) error {
+	out.FQDN = in.FQDN
+	out.Value = in.Value
+	out.CNAME = in.CNAME
+	return nil
+}
+
+// Convert_acme_ChallengeDNS01Record_To_v1_ChallengeDNS01Record is an autogenerated conversion function.
+func Convert_acme_ChallengeDNS01Record_To_v1_ChallengeDNS01Record(in *acme.ChallengeDNS01Record, out *v1.ChallengeDNS01Record, s conversion.Scope) error {
+	return autoConvert_acme_ChallengeDNS01Record_To_v1_ChallengeDNS01Record(in, out, s)
+}
+
 func autoConvert_v1_ChallengeList_To_acme_ChallengeList(in *v1.ChallengeList, out *acme.ChallengeList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -1509,6 +2073,30 @@ func Convert_acme_ChallengeList_To_v1_ChallengeList(in *acme.ChallengeList, out
 	return autoConvert_acme_ChallengeList_To_v1_ChallengeList(in, out, s)
 }
 
+func autoConvert_v1_ChallengeSelfCheckPerspective_To_acme_ChallengeSelfCheckPerspective(in *v1.ChallengeSelfCheckPerspective, out *acme.ChallengeSelfCheckPerspective, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Passed = in.Passed
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_v1_ChallengeSelfCheckPerspective_To_acme_ChallengeSelfCheckPerspective is an autogenerated conversion function.
+func Convert_v1_ChallengeSelfCheckPerspective_To_acme_ChallengeSelfCheckPerspective(in *v1.ChallengeSelfCheckPerspective, out *acme.ChallengeSelfCheckPerspective, s conversion.Scope) error {
+	return autoConvert_v1_ChallengeSelfCheckPerspective_To_acme_ChallengeSelfCheckPerspective(in, out, s)
+}
+
+func autoConvert_acme_ChallengeSelfCheckPerspective_To_v1_ChallengeSelfCheckPerspective(in *acme.ChallengeSelfCheckPerspective, out *v1.ChallengeSelfCheckPerspective, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Passed = in.Passed
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_acme_ChallengeSelfCheckPerspective_To_v1_ChallengeSelfCheckPerspective is an autogenerated conversion function.
+func Convert_acme_ChallengeSelfCheckPerspective_To_v1_ChallengeSelfCheckPerspective(in *acme.ChallengeSelfCheckPerspective, out *v1.ChallengeSelfCheckPerspective, s conversion.Scope) error {
+	return autoConvert_acme_ChallengeSelfCheckPerspective_To_v1_ChallengeSelfCheckPerspective(in, out, s)
+}
+
 func autoConvert_v1_ChallengeSpec_To_acme_ChallengeSpec(in *v1.ChallengeSpec, out *acme.ChallengeSpec, s conversion.Scope) error {
 	out.URL = in.URL
 	out.AuthorizationURL = in.AuthorizationURL
@@ -1520,9 +2108,11 @@ func autoConvert_v1_ChallengeSpec_To_acme_ChallengeSpec(in *v1.ChallengeSpec, ou
 	if err := Convert_v1_ACMEChallengeSolver_To_acme_ACMEChallengeSolver(&in.Solver, &out.Solver, s); err != nil {
 		return err
 	}
-	if err := metav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	if err := apismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
+	out.Server = in.Server
+	out.AccountURI = in.AccountURI
 	return nil
 }
 
@@ -1542,9 +2132,11 @@ func autoConvert_acme_ChallengeSpec_To_v1_ChallengeSpec(in *acme.ChallengeSpec,
 	if err := Convert_acme_ACMEChallengeSolver_To_v1_ACMEChallengeSolver(&in.Solver, &out.Solver, s); err != nil {
 		return err
 	}
-	if err := metav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	if err := apismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
+	out.Server = in.Server
+	out.AccountURI = in.AccountURI
 	return nil
 }
 
@@ -1556,8 +2148,12 @@ func Convert_acme_ChallengeSpec_To_v1_ChallengeSpec(in *acme.ChallengeSpec, out
 func autoConvert_v1_ChallengeStatus_To_acme_ChallengeStatus(in *v1.ChallengeStatus, out *acme.ChallengeStatus, s conversion.Scope) error {
 	out.Processing = in.Processing
 	out.Presented = in.Presented
+	out.PresentedTime = (*metav1.Time)(unsafe.Pointer(in.PresentedTime))
 	out.Reason = in.Reason
 	out.State = acme.State(in.State)
+	out.Accepted = in.Accepted
+	out.DNS01Record = (*acme.ChallengeDNS01Record)(unsafe.Pointer(in.DNS01Record))
+	out.SelfCheckPerspectives = *(*[]acme.ChallengeSelfCheckPerspective)(unsafe.Pointer(&in.SelfCheckPerspectives))
 	return nil
 }
 
@@ -1569,8 +2165,12 @@ func Convert_v1_ChallengeStatus_To_acme_ChallengeStatus(in *v1.ChallengeStatus,
 func autoConvert_acme_ChallengeStatus_To_v1_ChallengeStatus(in *acme.ChallengeStatus, out *v1.ChallengeStatus, s conversion.Scope) error {
 	out.Processing = in.Processing
 	out.Presented = in.Presented
+	out.PresentedTime = (*metav1.Time)(unsafe.Pointer(in.PresentedTime))
 	out.Reason = in.Reason
 	out.State = v1.State(in.State)
+	out.Accepted = in.Accepted
+	out.DNS01Record = (*v1.ChallengeDNS01Record)(unsafe.Pointer(in.DNS01Record))
+	out.SelfCheckPerspectives = *(*[]v1.ChallengeSelfCheckPerspective)(unsafe.Pointer(&in.SelfCheckPerspectives))
 	return nil
 }
 
@@ -1655,13 +2255,14 @@ func Convert_acme_OrderList_To_v1_OrderList(in *acme.OrderList, out *v1.OrderLis
 
 func autoConvert_v1_OrderSpec_To_acme_OrderSpec(in *v1.OrderSpec, out *acme.OrderSpec, s conversion.Scope) error {
 	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
-	if err := metav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	if err := apismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
 	out.CommonName = in.CommonName
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
-	out.Duration = (*pkgapismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Profile = in.Profile
 	return nil
 }
 
@@ -1672,13 +2273,14 @@ func Convert_v1_OrderSpec_To_acme_OrderSpec(in *v1.OrderSpec, out *acme.OrderSpe
 
 func autoConvert_acme_OrderSpec_To_v1_OrderSpec(in *acme.OrderSpec, out *v1.OrderSpec, s conversion.Scope) error {
 	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
-	if err := metav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	if err := apismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
 	out.CommonName = in.CommonName
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
-	out.Duration = (*pkgapismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Profile = in.Profile
 	return nil
 }
 
@@ -1690,11 +2292,14 @@ func Convert_acme_OrderSpec_To_v1_OrderSpec(in *acme.OrderSpec, out *v1.OrderSpe
 func autoConvert_v1_OrderStatus_To_acme_OrderStatus(in *v1.OrderStatus, out *acme.OrderStatus, s conversion.Scope) error {
 	out.URL = in.URL
 	out.FinalizeURL = in.FinalizeURL
+	out.Server = in.Server
+	out.FailedFinalizeAttempts = in.FailedFinalizeAttempts
+	out.RateLimitedUntil = (*metav1.Time)(unsafe.Pointer(in.RateLimitedUntil))
 	out.Authorizations = *(*[]acme.ACMEAuthorization)(unsafe.Pointer(&in.Authorizations))
 	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
 	out.State = acme.State(in.State)
 	out.Reason = in.Reason
-	out.FailureTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.FailureTime))
+	out.FailureTime = (*metav1.Time)(unsafe.Pointer(in.FailureTime))
 	return nil
 }
 
@@ -1706,11 +2311,14 @@ func Convert_v1_OrderStatus_To_acme_OrderStatus(in *v1.OrderStatus, out *acme.Or
 func autoConvert_acme_OrderStatus_To_v1_OrderStatus(in *acme.OrderStatus, out *v1.OrderStatus, s conversion.Scope) error {
 	out.URL = in.URL
 	out.FinalizeURL = in.FinalizeURL
+	out.Server = in.Server
+	out.FailedFinalizeAttempts = in.FailedFinalizeAttempts
+	out.RateLimitedUntil = (*metav1.Time)(unsafe.Pointer(in.RateLimitedUntil))
 	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
 	out.State = v1.State(in.State)
 	out.Reason = in.Reason
 	out.Authorizations = *(*[]v1.ACMEAuthorization)(unsafe.Pointer(&in.Authorizations))
-	out.FailureTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.FailureTime))
+	out.FailureTime = (*metav1.Time)(unsafe.Pointer(in.FailureTime))
 	return nil
 }
 
@@ -29,15 +29,7 @@ func Convert_v1alpha3_ChallengeSpec_To_acme_ChallengeSpec(in *ChallengeSpec, out
 
 	out.AuthorizationURL = in.AuthzURL
 
-	switch in.Type {
-	case ACMEChallengeTypeHTTP01:
-		out.Type = acme.ACMEChallengeTypeHTTP01
-	case ACMEChallengeTypeDNS01:
-		out.Type = acme.ACMEChallengeTypeDNS01
-	default:
-		// this case should never be hit due to validation
-		out.Type = acme.ACMEChallengeType(in.Type)
-	}
+	out.Type = convertChallengeTypeToHub(in.Type)
 
 	return nil
 }
@@ -49,15 +41,7 @@ func Convert_acme_ChallengeSpec_To_v1alpha3_ChallengeSpec(in *acme.ChallengeSpec
 
 	out.AuthzURL = in.AuthorizationURL
 
-	switch in.Type {
-	case acme.ACMEChallengeTypeHTTP01:
-		out.Type = ACMEChallengeTypeHTTP01
-	case acme.ACMEChallengeTypeDNS01:
-		out.Type = ACMEChallengeTypeDNS01
-	default:
-		// this case should never be hit due to validation
-		out.Type = ACMEChallengeType(in.Type)
-	}
+	out.Type = convertChallengeTypeFromHub(in.Type)
 
 	return nil
 }
@@ -93,3 +77,55 @@ func Convert_acme_ACMEIssuer_To_v1alpha3_ACMEIssuer(in *acme.ACMEIssuer, out *AC
 func Convert_v1alpha3_ACMEIssuer_To_acme_ACMEIssuer(in *ACMEIssuer, out *acme.ACMEIssuer, s conversion.Scope) error {
 	return autoConvert_v1alpha3_ACMEIssuer_To_acme_ACMEIssuer(in, out, s)
 }
+
+func Convert_v1alpha3_ACMEAbandonedSolver_To_acme_ACMEAbandonedSolver(in *ACMEAbandonedSolver, out *acme.ACMEAbandonedSolver, s conversion.Scope) error {
+	if err := autoConvert_v1alpha3_ACMEAbandonedSolver_To_acme_ACMEAbandonedSolver(in, out, s); err != nil {
+		return err
+	}
+
+	out.Type = convertChallengeTypeToHub(in.Type)
+
+	return nil
+}
+
+func Convert_acme_ACMEAbandonedSolver_To_v1alpha3_ACMEAbandonedSolver(in *acme.ACMEAbandonedSolver, out *ACMEAbandonedSolver, s conversion.Scope) error {
+	if err := autoConvert_acme_ACMEAbandonedSolver_To_v1alpha3_ACMEAbandonedSolver(in, out, s); err != nil {
+		return err
+	}
+
+	out.Type = convertChallengeTypeFromHub(in.Type)
+
+	return nil
+}
+
+func convertChallengeTypeToHub(in ACMEChallengeType) acme.ACMEChallengeType {
+	switch in {
+	case ACMEChallengeTypeHTTP01:
+		return acme.ACMEChallengeTypeHTTP01
+	case ACMEChallengeTypeDNS01:
+		return acme.ACMEChallengeTypeDNS01
+	case ACMEChallengeTypeDNSAccount01:
+		return acme.ACMEChallengeTypeDNSAccount01
+	case ACMEChallengeTypeTLSALPN01:
+		return acme.ACMEChallengeTypeTLSALPN01
+	default:
+		// this case should never be hit due to validation
+		return acme.ACMEChallengeType(in)
+	}
+}
+
+func convertChallengeTypeFromHub(in acme.ACMEChallengeType) ACMEChallengeType {
+	switch in {
+	case acme.ACMEChallengeTypeHTTP01:
+		return ACMEChallengeTypeHTTP01
+	case acme.ACMEChallengeTypeDNS01:
+		return ACMEChallengeTypeDNS01
+	case acme.ACMEChallengeTypeDNSAccount01:
+		return ACMEChallengeTypeDNSAccount01
+	case acme.ACMEChallengeTypeTLSALPN01:
+		return ACMEChallengeTypeTLSALPN01
+	default:
+		// this case should never be hit due to validation
+		return ACMEChallengeType(in)
+	}
+}
@@ -97,10 +97,22 @@ type ChallengeSpec struct {
 	// If the Issuer is not an 'ACME' Issuer, an error will be returned and the
 	// Challenge will be marked as failed.
 	IssuerRef cmmeta.ObjectReference `json:"issuerRef"`
+
+	// Server is the URL of the ACME server 'directory' endpoint that the
+	// challenge belongs to.
+	// If not set, the challenge belongs to the `server` of the ACME Issuer.
+	// +optional
+	Server string `json:"server,omitempty"`
+
+	// AccountURI is the URI of the ACME account that the challenge belongs
+	// to. It is only set for DNS-ACCOUNT-01 challenges, for which the name of
+	// the TXT record is derived from the account URI.
+	// +optional
+	AccountURI string `json:"accountURI,omitempty"`
 }
 
-// The type of ACME challenge. Only http-01 and dns-01 are supported.
-// +kubebuilder:validation:Enum=http-01;dns-01
+// The type of ACME challenge. Only http-01, dns-01, dns-account-01 and tls-alpn-01 are supported.
+// +kubebuilder:validation:Enum=http-01;dns-01;dns-account-01;tls-alpn-01
 type ACMEChallengeType string
 
 const (
@@ -111,6 +123,14 @@ const (
 	// ACMEChallengeTypeDNS01 denotes a Challenge is of type dns-01
 	// More info: https://letsencrypt.org/docs/challenge-types/#dns-01-challenge
 	ACMEChallengeTypeDNS01 ACMEChallengeType = "dns-01"
+
+	// ACMEChallengeTypeDNSAccount01 denotes a Challenge is of type dns-account-01
+	// More info: https://datatracker.ietf.org/doc/draft-ietf-acme-dns-account-label/
+	ACMEChallengeTypeDNSAccount01 ACMEChallengeType = "dns-account-01"
+
+	// ACMEChallengeTypeTLSALPN01 denotes a Challenge is of type tls-alpn-01
+	// More info: https://letsencrypt.org/docs/challenge-types/#tls-alpn-01
+	ACMEChallengeTypeTLSALPN01 ACMEChallengeType = "tls-alpn-01"
 )
 
 type ChallengeStatus struct {
@@ -133,6 +153,11 @@ type ChallengeStatus struct {
 	// +optional
 	Presented bool `json:"presented"`
 
+	// PresentedTime is the time at which the challenge values were first
+	// presented. Self check timeouts are measured from this time.
+	// +optional
+	PresentedTime *metav1.Time `json:"presentedTime,omitempty"`
+
 	// Reason contains human readable information on why the Challenge is in the
 	// current state.
 	// +optional
@@ -142,4 +167,51 @@ type ChallengeStatus struct {
 	// If not set, the state of the challenge is unknown.
 	// +optional
 	State State `json:"state,omitempty"`
+
+	// Accepted is set to true once the self check of the challenge has passed
+	// and the ACME server has been asked to validate the challenge.
+	// +optional
+	Accepted bool `json:"accepted,omitempty"`
+
+	// DNS01Record is the TXT record that must be created to solve the
+	// challenge. It is only set for challenges solved by the manual, the
+	// embedded or the acme-dns DNS01 provider.
+	// +optional
+	DNS01Record *ChallengeDNS01Record `json:"dns01Record,omitempty"`
+
+	// SelfCheckPerspectives contains the result of the latest self check
+	// from each of the network perspectives configured on the solver.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	SelfCheckPerspectives []ChallengeSelfCheckPerspective `json:"selfCheckPerspectives,omitempty"`
+}
+
+// ChallengeDNS01Record is a DNS TXT record that must be created to solve a
+// DNS01 challenge.
+type ChallengeDNS01Record struct {
+	// FQDN is the fully qualified domain name of the TXT record.
+	FQDN string `json:"fqdn"`
+
+	// Value is the value of the TXT record.
+	Value string `json:"value"`
+
+	// CNAME is set if the FQDN must be a CNAME record pointing at this name,
+	// which holds the TXT record, instead of holding the TXT record itself.
+	// +optional
+	CNAME string `json:"cname,omitempty"`
+}
+
+// ChallengeSelfCheckPerspective is the result of the self check of a
+// challenge from a single network perspective.
+type ChallengeSelfCheckPerspective struct {
+	// Name is the name of the perspective.
+	Name string `json:"name"`
+
+	// Passed is true if the self check passed from this perspective.
+	Passed bool `json:"passed"`
+
+	// Reason is the reason the self check failed from this perspective.
+	// +optional
+	Reason string `json:"reason,omitempty"`
 }
@@ -19,6 +19,7 @@ package v1alpha3
 import (
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gwapi "sigs.k8s.io/gateway-api/apis/v1"
 
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -43,6 +44,18 @@ type ACMEIssuer struct {
 	// Only ACME v2 endpoints (i.e. RFC 8555) are supported.
 	Server string `json:"server"`
 
+	// FallbackServers is an ordered list of additional ACME servers that
+	// Orders fail over to when the ACME server configured in `server` is
+	// unavailable, rate limits the account, or repeatedly fails to finalize
+	// orders.
+	// Each fallback server uses its own ACME account, which is registered
+	// using the `email` and account key settings of this issuer.
+	// The ACME server that an Order was submitted to is recorded in the
+	// Order's `status.server` field.
+	// +optional
+	// +listType=atomic
+	FallbackServers []ACMEFallbackServer `json:"fallbackServers,omitempty"`
+
 	// PreferredChain is the chain to use if the ACME server outputs multiple.
 	// PreferredChain is no guarantee that this one gets delivered by the ACME
 	// endpoint.
@@ -54,6 +67,20 @@ type ACMEIssuer struct {
 	// +kubebuilder:validation:MaxLength=64
 	PreferredChain string `json:"preferredChain"`
 
+	// Profile is the name of the ACME certificate profile to request for
+	// certificates issued by this issuer, as defined in
+	// draft-ietf-acme-profiles. For example, Let's Encrypt offers the
+	// "classic", "tlsserver" and "shortlived" profiles.
+	// The profile must be one of the profiles advertised in the metadata of
+	// the ACME server's directory.
+	// Certificates can override this value with `spec.acme.profile`.
+	// Changing this field triggers a re-issuance of the certificates which do
+	// not override it.
+	// If not set, the ACME server's default profile is used.
+	// +optional
+	// +kubebuilder:validation:MaxLength=64
+	Profile string `json:"profile,omitempty"`
+
 	// Base64-encoded bundle of PEM CAs which can be used to validate the certificate
 	// chain presented by the ACME server.
 	// Mutually exclusive with SkipTLSVerify; prefer using CABundle to prevent various
@@ -89,6 +116,42 @@ type ACMEIssuer struct {
 	// If `key` is not specified, a default of `tls.key` will be used.
 	PrivateKey cmmeta.SecretKeySelector `json:"privateKeySecretRef"`
 
+	// AccountPrivateKey configures the private key that is generated for the
+	// ACME account when the Secret referenced by `privateKeySecretRef` does not
+	// exist yet.
+	// It has no effect on an existing account private key.
+	// If not set, a 2048 bit RSA key is generated.
+	// +optional
+	AccountPrivateKey *ACMEAccountPrivateKey `json:"privateKey,omitempty"`
+
+	// NextPrivateKey is a reference to a Kubernetes Secret resource that holds
+	// the private key that the ACME account key should be rolled over to.
+	// When set and the key differs from the current account key, cert-manager
+	// performs an account key rollover (RFC 8555 section 7.3.5) for the
+	// registered ACME account and then replaces the key stored in
+	// `privateKeySecretRef` with the new key. This keeps the ACME account, and
+	// thus any rate limit history and external account binding, intact.
+	// If the referenced Secret does not exist, a new key is generated and
+	// stored in it unless `disableAccountKeyGeneration` is set.
+	// Once the rollover has completed, this field can be removed.
+	// If `key` is not specified, a default of `tls.key` will be used.
+	// +optional
+	NextPrivateKey *cmmeta.SecretKeySelector `json:"nextPrivateKeySecretRef,omitempty"`
+
+	// AccountDeletionPolicy controls what happens to the ACME account when the
+	// Issuer is deleted.
+	// If set to `Retain`, the account is left active at the ACME server.
+	// If set to `Deactivate`, the account is deactivated at the ACME server
+	// (RFC 8555 section 7.3.6) before the Issuer is removed.
+	// If set to `DeactivateAndDeleteSecret`, the account is deactivated and the
+	// Secret referenced by `privateKeySecretRef` is deleted as well, unless
+	// `disableAccountKeyGeneration` is set.
+	// The account is not deactivated while other issuers use the same account
+	// key Secret.
+	// Defaults to `Retain`.
+	// +optional
+	AccountDeletionPolicy ACMEAccountDeletionPolicy `json:"accountDeletionPolicy,omitempty"`
+
 	// Solvers is a list of challenge solvers that will be used to solve
 	// ACME challenges for the matching domains.
 	// Solver configurations must be provided in order to obtain certificates
@@ -115,6 +178,28 @@ type ACMEIssuer struct {
 	EnableDurationFeature bool `json:"enableDurationFeature,omitempty"`
 }
 
+// ACMEFallbackServer configures an ACME server that Orders can fail over to.
+type ACMEFallbackServer struct {
+	// Server is the URL used to access the ACME server's 'directory' endpoint.
+	// Only ACME v2 endpoints (i.e. RFC 8555) are supported.
+	Server string `json:"server"`
+
+	// PrivateKey is the name of a Kubernetes Secret resource that will be used to
+	// store the automatically generated ACME account private key for this
+	// ACME server.
+	// Optionally, a `key` may be specified to select a specific entry within
+	// the named Secret resource.
+	// If `key` is not specified, a default of `tls.key` will be used.
+	PrivateKey cmmeta.SecretKeySelector `json:"privateKeySecretRef"`
+
+	// ExternalAccountBinding is a reference to a CA external account of the
+	// ACME server.
+	// If set, upon registration cert-manager will attempt to associate the
+	// given external account credentials with the registered ACME account.
+	// +optional
+	ExternalAccountBinding *ACMEExternalAccountBinding `json:"externalAccountBinding,omitempty"`
+}
+
 // ACMEExternalAccountBinding is a reference to a CA external account of the ACME
 // server.
 type ACMEExternalAccountBinding struct {
@@ -137,6 +222,61 @@ type ACMEExternalAccountBinding struct {
 	KeyAlgorithm HMACKeyAlgorithm `json:"keyAlgorithm,omitempty"`
 }
 
+// ACMEAccountPrivateKey configures the ACME account private key.
+type ACMEAccountPrivateKey struct {
+	// Algorithm is the private key algorithm of the ACME account key.
+	// If `algorithm` is specified and `size` is not provided,
+	// key size of 2048 will be used for `RSA` key algorithm and
+	// key size of 256 will be used for `ECDSA` key algorithm.
+	// Not all ACME servers support `Ed25519` account keys.
+	// +optional
+	Algorithm ACMEAccountKeyAlgorithm `json:"algorithm,omitempty"`
+
+	// Size is the key bit size of the ACME account private key.
+	// If `algorithm` is set to `RSA`, valid values are between `2048` and `8192`,
+	// and will default to `2048` if not specified.
+	// If `algorithm` is set to `ECDSA`, valid values are `256` or `384`,
+	// and will default to `256` if not specified.
+	// If `algorithm` is set to `Ed25519`, Size is ignored.
+	// No other values are allowed.
+	// +optional
+	Size int `json:"size,omitempty"`
+}
+
+// ACMEAccountKeyAlgorithm is the algorithm of an ACME account private key.
+// +kubebuilder:validation:Enum=RSA;ECDSA;Ed25519
+type ACMEAccountKeyAlgorithm string
+
+const (
+	// RSA ACME account key algorithm.
+	RSAACMEAccountKeyAlgorithm ACMEAccountKeyAlgorithm = "RSA"
+
+	// ECDSA ACME account key algorithm.
+	ECDSAACMEAccountKeyAlgorithm ACMEAccountKeyAlgorithm = "ECDSA"
+
+	// Ed25519 ACME account key algorithm.
+	Ed25519ACMEAccountKeyAlgorithm ACMEAccountKeyAlgorithm = "Ed25519"
+)
+
+// ACMEAccountDeletionPolicy controls what happens to an ACME account when its
+// Issuer is deleted.
+// +kubebuilder:validation:Enum=Retain;Deactivate;DeactivateAndDeleteSecret
+type ACMEAccountDeletionPolicy string
+
+const (
+	// RetainACMEAccountDeletionPolicy leaves the ACME account active when the
+	// Issuer is deleted.
+	RetainACMEAccountDeletionPolicy ACMEAccountDeletionPolicy = "Retain"
+
+	// DeactivateACMEAccountDeletionPolicy deactivates the ACME account when
+	// the Issuer is deleted.
+	DeactivateACMEAccountDeletionPolicy ACMEAccountDeletionPolicy = "Deactivate"
+
+	// DeactivateAndDeleteSecretACMEAccountDeletionPolicy deactivates the ACME
+	// account and deletes its private key Secret when the Issuer is deleted.
+	DeactivateAndDeleteSecretACMEAccountDeletionPolicy ACMEAccountDeletionPolicy = "DeactivateAndDeleteSecret"
+)
+
 // HMACKeyAlgorithm is the name of a key algorithm used for HMAC encryption
 // +kubebuilder:validation:Enum=HS256;HS384;HS512
 type HMACKeyAlgorithm string
@@ -169,6 +309,22 @@ type ACMEChallengeSolver struct {
 	// performing the DNS01 challenge flow.
 	// +optional
 	DNS01 *ACMEChallengeSolverDNS01 `json:"dns01,omitempty"`
+
+	// Configures cert-manager to attempt to complete authorizations by
+	// performing the TLS-ALPN-01 challenge flow.
+	// It is not possible to obtain certificates for wildcard domain names
+	// (e.g. `*.example.com`) using the TLS-ALPN-01 challenge mechanism.
+	// +optional
+	TLSALPN01 *ACMEChallengeSolverTLSALPN01 `json:"tlsALPN01,omitempty"`
+
+	// SelfCheckTimeout is the maximum time a challenge solved using this
+	// solver may take to pass its self check, measured from when the
+	// challenge was presented. If the self check has not passed within this
+	// time, the solver is abandoned and the next matching solver, in order of
+	// preference, is used to solve the authorization instead. If not set, or if
+	// there is no other matching solver, the solver is never abandoned.
+	// +optional
+	SelfCheckTimeout *metav1.Duration `json:"selfCheckTimeout,omitempty"`
 }
 
 // CertificateDomainSelector selects certificates using a label selector, and
@@ -224,6 +380,49 @@ type ACMEChallengeSolverHTTP01 struct {
 	// This solver is experimental, and fields / behaviour may change in the future.
 	// +optional
 	GatewayHTTPRoute *ACMEChallengeSolverHTTP01GatewayHTTPRoute `json:"gatewayHTTPRoute,omitempty"`
+
+	// SelfCheck configures how cert-manager checks that the challenge is
+	// reachable before asking the ACME server to validate it. If not set, the
+	// challenge is requested from the controller only.
+	// +optional
+	SelfCheck *ACMEChallengeSolverHTTP01SelfCheck `json:"selfCheck,omitempty"`
+}
+
+// ACMEChallengeSolverHTTP01SelfCheck configures the HTTP01 self check for the
+// challenges of a single solver.
+type ACMEChallengeSolverHTTP01SelfCheck struct {
+	// Perspectives are the network perspectives from which the challenge is
+	// requested. If set, the self check is performed from each of them
+	// instead of from the controller, and it passes once it passes from the
+	// quorum of perspectives.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Perspectives []ACMEChallengeSolverHTTP01SelfCheckPerspective `json:"perspectives,omitempty"`
+
+	// Quorum is the number of perspectives from which the self check must
+	// pass. Defaults to the number of perspectives.
+	// +optional
+	Quorum *int32 `json:"quorum,omitempty"`
+}
+
+// ACMEChallengeSolverHTTP01SelfCheckPerspective is a network perspective from
+// which the HTTP01 self check is performed.
+type ACMEChallengeSolverHTTP01SelfCheckPerspective struct {
+	// Name identifies the perspective in the Challenge status.
+	Name string `json:"name"`
+
+	// ProxyURL is the URL of the HTTP proxy through which the challenge is
+	// requested from this perspective, e.g. "http://proxy.example.com:3128".
+	// If not set, the challenge is requested directly.
+	// +optional
+	ProxyURL string `json:"proxyURL,omitempty"`
+
+	// SourceAddress is the local IP address from which the challenge, or the
+	// proxy, is connected to from this perspective.
+	// If not set, the address is chosen by the operating system.
+	// +optional
+	SourceAddress string `json:"sourceAddress,omitempty"`
 }
 
 type ACMEChallengeSolverHTTP01Ingress struct {
@@ -289,6 +488,47 @@ type ACMEChallengeSolverHTTP01GatewayHTTPRoute struct {
 	PodTemplate *ACMEChallengeSolverHTTP01IngressPodTemplate `json:"podTemplate,omitempty"`
 }
 
+// ACMEChallengeSolverTLSALPN01 contains configuration detailing how to solve
+// TLS-ALPN-01 challenges.
+// cert-manager runs a solver pod which answers the challenge on TLS
+// connections negotiating the `acme-tls/1` protocol. The ACME server
+// connects to port 443 of the domain being validated, so that port needs to
+// be routed to the solver pod, either through the Service created for it
+// (e.g. of type LoadBalancer) or through a Gateway API TLSRoute.
+type ACMEChallengeSolverTLSALPN01 struct {
+	// Optional service type for Kubernetes solver service. Supported values
+	// are NodePort, ClusterIP or LoadBalancer. If unset, defaults to NodePort.
+	// +optional
+	ServiceType corev1.ServiceType `json:"serviceType,omitempty"`
+
+	// When set, cert-manager will create a Gateway API TLSRoute that routes
+	// TLS connections for the domain being validated to the solver pod.
+	// +optional
+	GatewayTLSRoute *ACMEChallengeSolverTLSALPN01GatewayTLSRoute `json:"gatewayTLSRoute,omitempty"`
+
+	// Optional pod template used to configure the ACME challenge solver pods
+	// used for TLS-ALPN-01 challenges.
+	// +optional
+	PodTemplate *ACMEChallengeSolverHTTP01IngressPodTemplate `json:"podTemplate,omitempty"`
+}
+
+// The ACMEChallengeSolverTLSALPN01GatewayTLSRoute solver will create a
+// TLSRoute object with the domain being validated as hostname, pointing to
+// the solver Service. The Gateway referenced by the parentRefs needs a TLS
+// listener in Passthrough mode on port 443.
+type ACMEChallengeSolverTLSALPN01GatewayTLSRoute struct {
+	// Custom labels that will be applied to TLSRoutes created by cert-manager
+	// while solving TLS-ALPN-01 challenges.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// When solving a TLS-ALPN-01 challenge, cert-manager creates a TLSRoute.
+	// cert-manager needs to know which parentRefs should be used when creating
+	// the TLSRoute. Usually, the parentRef references a Gateway. See:
+	// https://gateway-api.sigs.k8s.io/guides/tls/#tls-passthrough
+	ParentRefs []gwapi.ParentReference `json:"parentRefs,omitempty"`
+}
+
 type ACMEChallengeSolverHTTP01IngressPodTemplate struct {
 	// ObjectMeta overrides for the pod used to solve HTTP01 challenges.
 	// Only the 'labels' and 'annotations' fields may be set.
@@ -374,6 +614,24 @@ type ACMEChallengeSolverDNS01 struct {
 	// +optional
 	CNAMEStrategy CNAMEStrategy `json:"cnameStrategy,omitempty"`
 
+	// UseDNSAccountChallenge configures the solver to solve `dns-account-01`
+	// challenges (draft-ietf-acme-dns-account-label) instead of `dns-01`
+	// challenges, if the ACME server offers them.
+	// The TXT record of a dns-account-01 challenge is presented at an ACME
+	// account specific `_<label>._acme-challenge` name, which allows multiple
+	// ACME accounts, e.g. of several clusters, to validate the same domain at
+	// the same time.
+	// If the ACME server does not offer dns-account-01 challenges, dns-01
+	// challenges are solved instead.
+	// +optional
+	UseDNSAccountChallenge bool `json:"useDNSAccountChallenge,omitempty"`
+
+	// SelfCheck configures how cert-manager checks that the challenge TXT
+	// record has propagated before asking the ACME server to validate the
+	// challenge. If not set, the controller wide DNS01 settings are used.
+	// +optional
+	SelfCheck *ACMEChallengeSolverDNS01SelfCheck `json:"selfCheck,omitempty"`
+
 	// Use the Akamai DNS zone management API to manage DNS01 challenge records.
 	// +optional
 	Akamai *ACMEIssuerDNS01ProviderAkamai `json:"akamai,omitempty"`
@@ -412,6 +670,21 @@ type ACMEChallengeSolverDNS01 struct {
 	// DNS01 challenge records.
 	// +optional
 	Webhook *ACMEIssuerDNS01ProviderWebhook `json:"webhook,omitempty"`
+
+	// Do not manage DNS01 challenge records, but publish the record that
+	// must be created in the Challenge status and as an Event, so that it
+	// can be created by hand or by external automation.
+	// +optional
+	Manual *ACMEIssuerDNS01ProviderManual `json:"manual,omitempty"`
+
+	// Use the authoritative DNS server embedded in the cert-manager
+	// controller to serve DNS01 challenge records. The `_acme-challenge`
+	// record of each domain must either be one of the zones of the embedded
+	// server, delegated to it using an NS record, or be a CNAME record pointing
+	// to a name in one of its zones, in which case cnameStrategy must be set to
+	// Follow. The embedded DNS server may only be used by ClusterIssuers.
+	// +optional
+	Embedded *ACMEIssuerDNS01ProviderEmbedded `json:"embedded,omitempty"`
 }
 
 type ACMEChallengeSolverHTTP01IngressPodSecurityContext struct {
@@ -507,6 +780,62 @@ const (
 	FollowStrategy = "Follow"
 )
 
+// ACMEChallengeSolverDNS01SelfCheck configures the DNS01 self check for the
+// challenges of a single solver, overriding the controller wide settings.
+type ACMEChallengeSolverDNS01SelfCheck struct {
+	// RecursiveNameservers is a list of nameservers used to look up the
+	// challenge record, its zone and its authoritative nameservers. Each
+	// nameserver can be either the IP address and port of a standard
+	// recursive DNS server, or the endpoint to an RFC 8484 DNS over HTTPS
+	// endpoint, for example ["8.8.8.8:53", "https://1.1.1.1/dns-query"].
+	// If not set, the controller wide recursive nameservers are used.
+	// +optional
+	// +listType=atomic
+	RecursiveNameservers []string `json:"recursiveNameservers,omitempty"`
+
+	// RecursiveNameserversOnly configures whether only the recursive
+	// nameservers are queried for the challenge record. If false, the
+	// authoritative nameservers of the zone are queried instead.
+	// If not set, the controller wide setting is used.
+	// +optional
+	RecursiveNameserversOnly *bool `json:"recursiveNameserversOnly,omitempty"`
+
+	// PropagationTimeout is the maximum time to wait for the challenge record
+	// to propagate, measured from when the record was first presented. Once
+	// it has elapsed without a successful self check, the Challenge fails.
+	// If not set, cert-manager waits until the self check passes.
+	// +optional
+	PropagationTimeout *metav1.Duration `json:"propagationTimeout,omitempty"`
+
+	// Perspectives are the network perspectives from which the challenge
+	// record is looked up. If set, the self check is performed from each of
+	// them instead of from the recursive nameservers above, and it passes
+	// once it passes from the quorum of perspectives. Each perspective only
+	// queries its own recursive nameservers, regardless of
+	// recursiveNameserversOnly.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Perspectives []ACMEChallengeSolverDNS01SelfCheckPerspective `json:"perspectives,omitempty"`
+
+	// Quorum is the number of perspectives from which the self check must
+	// pass. Defaults to the number of perspectives.
+	// +optional
+	Quorum *int32 `json:"quorum,omitempty"`
+}
+
+// ACMEChallengeSolverDNS01SelfCheckPerspective is a network perspective from
+// which the DNS01 self check is performed.
+type ACMEChallengeSolverDNS01SelfCheckPerspective struct {
+	// Name identifies the perspective in the Challenge status.
+	Name string `json:"name"`
+
+	// RecursiveNameservers is the list of nameservers of this perspective, in
+	// the same format as the recursiveNameservers of the self check.
+	// +listType=atomic
+	RecursiveNameservers []string `json:"recursiveNameservers"`
+}
+
 // ACMEIssuerDNS01ProviderAkamai is a structure containing the DNS
 // configuration for Akamai DNS—Zone Record Management API
 type ACMEIssuerDNS01ProviderAkamai struct {
@@ -680,6 +1009,15 @@ type ACMEIssuerDNS01ProviderAcmeDNS struct {
 	Host string `json:"host"`
 
 	AccountSecret cmmeta.SecretKeySelector `json:"accountSecretRef"`
+
+	// If true, cert-manager registers a new account with the acme-dns server
+	// for each domain that has no credentials in the account Secret, and
+	// stores the credentials in that Secret, creating it if it does not exist.
+	// The acme-dns subdomain that must be the target of the domain's
+	// `_acme-challenge` CNAME record is reported in the Challenge and Issuer
+	// status.
+	// +optional
+	AutoRegister bool `json:"autoRegister,omitempty"`
 }
 
 // ACMEIssuerDNS01ProviderRFC2136 is a structure containing the
@@ -735,6 +1073,28 @@ type ACMEIssuerDNS01ProviderWebhook struct {
 	Config *apiextensionsv1.JSON `json:"config,omitempty"`
 }
 
+// ACMEIssuerDNS01ProviderManual is a structure containing the configuration
+// for solving DNS01 challenges without managing any DNS records.
+// The TXT record that must be created is published in the Challenge status
+// and as an Event, and the challenge is accepted once the record has
+// propagated.
+type ACMEIssuerDNS01ProviderManual struct {
+	// Timeout is the maximum time to wait for the TXT record to be created
+	// and to propagate, measured from when the record was first published.
+	// If the record has not propagated within this time, the Challenge and
+	// its Order fail.
+	// If not set, the Challenge waits until the ACME authorization expires.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// ACMEIssuerDNS01ProviderEmbedded is a structure containing the configuration
+// for the DNS01 provider which serves challenge records from the
+// authoritative DNS server embedded in the cert-manager controller.
+// The embedded DNS server must be enabled on the controller, and may only
+// be used by ClusterIssuers.
+type ACMEIssuerDNS01ProviderEmbedded struct{}
+
 type ACMEIssuerStatus struct {
 	// URI is the unique account identifier, which can also be used to retrieve
 	// account details from the CA
@@ -751,4 +1111,51 @@ type ACMEIssuerStatus struct {
 	// registered ACME account, in order to track changes made to registered account
 	// associated with the Issuer
 	LastPrivateKeyHash string `json:"lastPrivateKeyHash,omitempty"`
+
+	// FallbackAccounts contains the ACME accounts registered with the
+	// issuer's fallback servers.
+	// +optional
+	// +listType=map
+	// +listMapKey=server
+	FallbackAccounts []ACMEFallbackAccountStatus `json:"fallbackAccounts,omitempty"`
+
+	// AcmeDNSRegistrations contains the accounts that were registered
+	// automatically with acme-dns servers for DNS01 challenges.
+	// +optional
+	// +listType=map
+	// +listMapKey=domain
+	AcmeDNSRegistrations []ACMEAcmeDNSRegistration `json:"acmeDNSRegistrations,omitempty"`
+}
+
+// ACMEFallbackAccountStatus contains the status of the ACME account
+// registered with one of the issuer's fallback servers.
+type ACMEFallbackAccountStatus struct {
+	// Server is the URL of the fallback ACME server's 'directory' endpoint.
+	Server string `json:"server"`
+
+	// URI is the unique account identifier, which can also be used to retrieve
+	// account details from the CA
+	// +optional
+	URI string `json:"uri,omitempty"`
+
+	// LastRegisteredEmail is the email associated with the latest registered
+	// ACME account
+	// +optional
+	LastRegisteredEmail string `json:"lastRegisteredEmail,omitempty"`
+
+	// LastPrivateKeyHash is a hash of the private key associated with the latest
+	// registered ACME account
+	// +optional
+	LastPrivateKeyHash string `json:"lastPrivateKeyHash,omitempty"`
+}
+
+// ACMEAcmeDNSRegistration is an account that was registered automatically
+// with an acme-dns server.
+type ACMEAcmeDNSRegistration struct {
+	// Domain is the domain the account was registered for.
+	Domain string `json:"domain"`
+
+	// FullDomain is the acme-dns subdomain the account updates. The
+	// `_acme-challenge` record of Domain must be a CNAME pointing at it.
+	FullDomain string `json:"fullDomain"`
 }
@@ -62,6 +62,10 @@ func ValidateOrderStatusUpdate(oldStatus, newStatus cmacme.OrderStatus, fldPath
 	if oldStatus.FinalizeURL != "" && oldStatus.FinalizeURL != newStatus.FinalizeURL {
 		el = append(el, field.Forbidden(fldPath.Child("finalizeURL"), "field is immutable once set"))
 	}
+	// once the ACME server has been set, it cannot be changed
+	if oldStatus.Server != "" && oldStatus.Server != newStatus.Server {
+		el = append(el, field.Forbidden(fldPath.Child("server"), "field is immutable once set"))
+	}
 	// once the Certificate has been issued, it cannot be changed
 	if len(oldStatus.Certificate) > 0 && !bytes.Equal(oldStatus.Certificate, newStatus.Certificate) {
 		el = append(el, field.Forbidden(fldPath.Child("certificate"), "field is immutable once set"))
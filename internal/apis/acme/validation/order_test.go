@@ -114,6 +114,9 @@ func TestValidateOrderUpdate(t *testing.T) {
 	testImmutableOrderField(t, field.NewPath("status", "finalizeURL"), func(o *cmacme.Order, s testValue) {
 		o.Status.FinalizeURL = string(s)
 	})
+	testImmutableOrderField(t, field.NewPath("status", "server"), func(o *cmacme.Order, s testValue) {
+		o.Status.Server = string(s)
+	})
 	testImmutableOrderField(t, field.NewPath("status", "certificate"), func(o *cmacme.Order, s testValue) {
 		if s == testValueNone {
 			o.Status.Certificate = nil
@@ -40,6 +40,12 @@ const (
 	// be issued.
 	// The `status.failureTime` field should be set in this case.
 	CertificateRequestReasonDenied = "Denied"
+
+	// RateLimited is a Ready condition reason that indicates that a
+	// CertificateRequest is still in progress, but that the issuer is
+	// currently being rate limited by the certificate authority and the
+	// request will be continued once the rate limit has been reset.
+	CertificateRequestReasonRateLimited = "RateLimited"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -82,6 +82,12 @@ type IssuerList struct {
 // configuration required for the issuer.
 type IssuerSpec struct {
 	IssuerConfig
+
+	// RenewalWindow restricts the renewal of the certificates of all
+	// Certificates that reference this issuer, and do not define their own
+	// renewal window, to recurring maintenance windows.
+	// +optional
+	RenewalWindow *CertificateRenewalWindow
 }
 
 // IssuerConfig is a generic wrapper around custom issuer types
@@ -348,6 +354,16 @@ type CAIssuer struct {
 	// As an example, such a URL might be "http://ca.domain.com/ca.crt".
 	// +optional
 	IssuingCertificateURLs []string `json:"issuingCertificateURLs,omitempty"`
+
+	// CRLSecretName is the name of a Secret in which cert-manager maintains a
+	// Certificate Revocation List (CRL), signed by the CA, listing the
+	// certificates revoked by this Issuer.
+	// The PEM encoded CRL is stored in the `ca.crl` key of the Secret and
+	// can be served at one of the `crlDistributionPoints`.
+	// The Secret is created in the same namespace as the CA Secret.
+	// Revoking certificates issued by this Issuer fails if not set.
+	// +optional
+	CRLSecretName string
 }
 
 // IssuerStatus contains status information about an Issuer
@@ -64,6 +64,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateACMEOptions)(nil), (*certmanager.CertificateACMEOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateACMEOptions_To_certmanager_CertificateACMEOptions(a.(*v1.CertificateACMEOptions), b.(*certmanager.CertificateACMEOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateACMEOptions)(nil), (*v1.CertificateACMEOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateACMEOptions_To_v1_CertificateACMEOptions(a.(*certmanager.CertificateACMEOptions), b.(*v1.CertificateACMEOptions), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateAdditionalOutputFormat)(nil), (*certmanager.CertificateAdditionalOutputFormat)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(a.(*v1.CertificateAdditionalOutputFormat), b.(*certmanager.CertificateAdditionalOutputFormat), scope)
 	}); err != nil {
@@ -84,6 +94,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateIssuance)(nil), (*certmanager.CertificateIssuance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateIssuance_To_certmanager_CertificateIssuance(a.(*v1.CertificateIssuance), b.(*certmanager.CertificateIssuance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateIssuance)(nil), (*v1.CertificateIssuance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateIssuance_To_v1_CertificateIssuance(a.(*certmanager.CertificateIssuance), b.(*v1.CertificateIssuance), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateKeystores)(nil), (*certmanager.CertificateKeystores)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateKeystores_To_certmanager_CertificateKeystores(a.(*v1.CertificateKeystores), b.(*certmanager.CertificateKeystores), scope)
 	}); err != nil {
@@ -114,6 +134,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRenewalInfo)(nil), (*certmanager.CertificateRenewalInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRenewalInfo_To_certmanager_CertificateRenewalInfo(a.(*v1.CertificateRenewalInfo), b.(*certmanager.CertificateRenewalInfo), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRenewalInfo)(nil), (*v1.CertificateRenewalInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRenewalInfo_To_v1_CertificateRenewalInfo(a.(*certmanager.CertificateRenewalInfo), b.(*v1.CertificateRenewalInfo), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRenewalWindow)(nil), (*certmanager.CertificateRenewalWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(a.(*v1.CertificateRenewalWindow), b.(*certmanager.CertificateRenewalWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRenewalWindow)(nil), (*v1.CertificateRenewalWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRenewalWindow_To_v1_CertificateRenewalWindow(a.(*certmanager.CertificateRenewalWindow), b.(*v1.CertificateRenewalWindow), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequest)(nil), (*certmanager.CertificateRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateRequest_To_certmanager_CertificateRequest(a.(*v1.CertificateRequest), b.(*certmanager.CertificateRequest), scope)
 	}); err != nil {
@@ -164,6 +204,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRetainPrevious)(nil), (*certmanager.CertificateRetainPrevious)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRetainPrevious_To_certmanager_CertificateRetainPrevious(a.(*v1.CertificateRetainPrevious), b.(*certmanager.CertificateRetainPrevious), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRetainPrevious)(nil), (*v1.CertificateRetainPrevious)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRetainPrevious_To_v1_CertificateRetainPrevious(a.(*certmanager.CertificateRetainPrevious), b.(*v1.CertificateRetainPrevious), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRevocation)(nil), (*certmanager.CertificateRevocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRevocation_To_certmanager_CertificateRevocation(a.(*v1.CertificateRevocation), b.(*certmanager.CertificateRevocation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRevocation)(nil), (*v1.CertificateRevocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRevocation_To_v1_CertificateRevocation(a.(*certmanager.CertificateRevocation), b.(*v1.CertificateRevocation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRevocationPolicy)(nil), (*certmanager.CertificateRevocationPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRevocationPolicy_To_certmanager_CertificateRevocationPolicy(a.(*v1.CertificateRevocationPolicy), b.(*certmanager.CertificateRevocationPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRevocationPolicy)(nil), (*v1.CertificateRevocationPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRevocationPolicy_To_v1_CertificateRevocationPolicy(a.(*certmanager.CertificateRevocationPolicy), b.(*v1.CertificateRevocationPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateSecretTemplate)(nil), (*certmanager.CertificateSecretTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(a.(*v1.CertificateSecretTemplate), b.(*certmanager.CertificateSecretTemplate), scope)
 	}); err != nil {
@@ -442,6 +512,7 @@ func autoConvert_v1_CAIssuer_To_certmanager_CAIssuer(in *v1.CAIssuer, out *certm
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
 	out.IssuingCertificateURLs = *(*[]string)(unsafe.Pointer(&in.IssuingCertificateURLs))
+	out.CRLSecretName = in.CRLSecretName
 	return nil
 }
 
@@ -455,6 +526,7 @@ func autoConvert_certmanager_CAIssuer_To_v1_CAIssuer(in *certmanager.CAIssuer, o
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
 	out.IssuingCertificateURLs = *(*[]string)(unsafe.Pointer(&in.IssuingCertificateURLs))
+	out.CRLSecretName = in.CRLSecretName
 	return nil
 }
 
@@ -495,6 +567,26 @@ func Convert_certmanager_Certificate_To_v1_Certificate(in *certmanager.Certifica
 	return autoConvert_certmanager_Certificate_To_v1_Certificate(in, out, s)
 }
 
+func autoConvert_v1_CertificateACMEOptions_To_certmanager_CertificateACMEOptions(in *v1.CertificateACMEOptions, out *certmanager.CertificateACMEOptions, s conversion.Scope) error {
+	out.Profile = in.Profile
+	return nil
+}
+
+// Convert_v1_CertificateACMEOptions_To_certmanager_CertificateACMEOptions is an autogenerated conversion function.
+func Convert_v1_CertificateACMEOptions_To_certmanager_CertificateACMEOptions(in *v1.CertificateACMEOptions, out *certmanager.CertificateACMEOptions, s conversion.Scope) error {
+	return autoConvert_v1_CertificateACMEOptions_To_certmanager_CertificateACMEOptions(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateACMEOptions_To_v1_CertificateACMEOptions(in *certmanager.CertificateACMEOptions, out *v1.CertificateACMEOptions, s conversion.Scope) error {
+	out.Profile = in.Profile
+	return nil
+}
+
+// Convert_certmanager_CertificateACMEOptions_To_v1_CertificateACMEOptions is an autogenerated conversion function.
+func Convert_certmanager_CertificateACMEOptions_To_v1_CertificateACMEOptions(in *certmanager.CertificateACMEOptions, out *v1.CertificateACMEOptions, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateACMEOptions_To_v1_CertificateACMEOptions(in, out, s)
+}
+
 func autoConvert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in *v1.CertificateAdditionalOutputFormat, out *certmanager.CertificateAdditionalOutputFormat, s conversion.Scope) error {
 	out.Type = certmanager.CertificateOutputFormatType(in.Type)
 	return nil
@@ -545,6 +637,38 @@ func Convert_certmanager_CertificateCondition_To_v1_CertificateCondition(in *cer
 	return autoConvert_certmanager_CertificateCondition_To_v1_CertificateCondition(in, out, s)
 }
 
+func autoConvert_v1_CertificateIssuance_To_certmanager_CertificateIssuance(in *v1.CertificateIssuance, out *certmanager.CertificateIssuance, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.CertificateRequest = in.CertificateRequest
+	out.IssuanceTime = in.IssuanceTime
+	out.SerialNumber = in.SerialNumber
+	out.SHA256Fingerprint = in.SHA256Fingerprint
+	out.Issuer = in.Issuer
+	out.KeyAlgorithm = certmanager.PrivateKeyAlgorithm(in.KeyAlgorithm)
+	return nil
+}
+
+// Convert_v1_CertificateIssuance_To_certmanager_CertificateIssuance is an autogenerated conversion function.
+func Convert_v1_CertificateIssuance_To_certmanager_CertificateIssuance(in *v1.CertificateIssuance, out *certmanager.CertificateIssuance, s conversion.Scope) error {
+	return autoConvert_v1_CertificateIssuance_To_certmanager_CertificateIssuance(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateIssuance_To_v1_CertificateIssuance(in *certmanager.CertificateIssuance, out *v1.CertificateIssuance, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.CertificateRequest = in.CertificateRequest
+	out.IssuanceTime = in.IssuanceTime
+	out.SerialNumber = in.SerialNumber
+	out.SHA256Fingerprint = in.SHA256Fingerprint
+	out.Issuer = in.Issuer
+	out.KeyAlgorithm = v1.PrivateKeyAlgorithm(in.KeyAlgorithm)
+	return nil
+}
+
+// Convert_certmanager_CertificateIssuance_To_v1_CertificateIssuance is an autogenerated conversion function.
+func Convert_certmanager_CertificateIssuance_To_v1_CertificateIssuance(in *certmanager.CertificateIssuance, out *v1.CertificateIssuance, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateIssuance_To_v1_CertificateIssuance(in, out, s)
+}
+
 func autoConvert_v1_CertificateKeystores_To_certmanager_CertificateKeystores(in *v1.CertificateKeystores, out *certmanager.CertificateKeystores, s conversion.Scope) error {
 	if in.JKS != nil {
 		in, out := &in.JKS, &out.JKS
@@ -643,6 +767,7 @@ func Convert_certmanager_CertificateList_To_v1_CertificateList(in *certmanager.C
 
 func autoConvert_v1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(in *v1.CertificatePrivateKey, out *certmanager.CertificatePrivateKey, s conversion.Scope) error {
 	out.RotationPolicy = certmanager.PrivateKeyRotationPolicy(in.RotationPolicy)
+	out.RotationMaxAge = (*metav1.Duration)(unsafe.Pointer(in.RotationMaxAge))
 	out.Encoding = certmanager.PrivateKeyEncoding(in.Encoding)
 	out.Algorithm = certmanager.PrivateKeyAlgorithm(in.Algorithm)
 	out.Size = in.Size
@@ -656,6 +781,7 @@ func Convert_v1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(in *v
 
 func autoConvert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(in *certmanager.CertificatePrivateKey, out *v1.CertificatePrivateKey, s conversion.Scope) error {
 	out.RotationPolicy = v1.PrivateKeyRotationPolicy(in.RotationPolicy)
+	out.RotationMaxAge = (*metav1.Duration)(unsafe.Pointer(in.RotationMaxAge))
 	out.Encoding = v1.PrivateKeyEncoding(in.Encoding)
 	out.Algorithm = v1.PrivateKeyAlgorithm(in.Algorithm)
 	out.Size = in.Size
@@ -667,6 +793,60 @@ func Convert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(in *c
 	return autoConvert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(in, out, s)
 }
 
+func autoConvert_v1_CertificateRenewalInfo_To_certmanager_CertificateRenewalInfo(in *v1.CertificateRenewalInfo, out *certmanager.CertificateRenewalInfo, s conversion.Scope) error {
+	out.CertID = in.CertID
+	out.SuggestedWindowStart = in.SuggestedWindowStart
+	out.SuggestedWindowEnd = in.SuggestedWindowEnd
+	out.ExplanationURL = in.ExplanationURL
+	out.NextCheckTime = (*metav1.Time)(unsafe.Pointer(in.NextCheckTime))
+	return nil
+}
+
+// Convert_v1_CertificateRenewalInfo_To_certmanager_CertificateRenewalInfo is an autogenerated conversion function.
+func Convert_v1_CertificateRenewalInfo_To_certmanager_CertificateRenewalInfo(in *v1.CertificateRenewalInfo, out *certmanager.CertificateRenewalInfo, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRenewalInfo_To_certmanager_CertificateRenewalInfo(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRenewalInfo_To_v1_CertificateRenewalInfo(in *certmanager.CertificateRenewalInfo, out *v1.CertificateRenewalInfo, s conversion.Scope) error {
+	out.CertID = in.CertID
+	out.SuggestedWindowStart = in.SuggestedWindowStart
+	out.SuggestedWindowEnd = in.SuggestedWindowEnd
+	out.ExplanationURL = in.ExplanationURL
+	out.NextCheckTime = (*metav1.Time)(unsafe.Pointer(in.NextCheckTime))
+	return nil
+}
+
+// Convert_certmanager_CertificateRenewalInfo_To_v1_CertificateRenewalInfo is an autogenerated conversion function.
+func Convert_certmanager_CertificateRenewalInfo_To_v1_CertificateRenewalInfo(in *certmanager.CertificateRenewalInfo, out *v1.CertificateRenewalInfo, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRenewalInfo_To_v1_CertificateRenewalInfo(in, out, s)
+}
+
+func autoConvert_v1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in *v1.CertificateRenewalWindow, out *certmanager.CertificateRenewalWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	out.TimeZone = in.TimeZone
+	out.OverrideBeforeExpiry = (*metav1.Duration)(unsafe.Pointer(in.OverrideBeforeExpiry))
+	return nil
+}
+
+// Convert_v1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow is an autogenerated conversion function.
+func Convert_v1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in *v1.CertificateRenewalWindow, out *certmanager.CertificateRenewalWindow, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRenewalWindow_To_v1_CertificateRenewalWindow(in *certmanager.CertificateRenewalWindow, out *v1.CertificateRenewalWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	out.TimeZone = in.TimeZone
+	out.OverrideBeforeExpiry = (*metav1.Duration)(unsafe.Pointer(in.OverrideBeforeExpiry))
+	return nil
+}
+
+// Convert_certmanager_CertificateRenewalWindow_To_v1_CertificateRenewalWindow is an autogenerated conversion function.
+func Convert_certmanager_CertificateRenewalWindow_To_v1_CertificateRenewalWindow(in *certmanager.CertificateRenewalWindow, out *v1.CertificateRenewalWindow, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRenewalWindow_To_v1_CertificateRenewalWindow(in, out, s)
+}
+
 func autoConvert_v1_CertificateRequest_To_certmanager_CertificateRequest(in *v1.CertificateRequest, out *certmanager.CertificateRequest, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -835,6 +1015,88 @@ func Convert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestStatus
 	return autoConvert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestStatus(in, out, s)
 }
 
+func autoConvert_v1_CertificateRetainPrevious_To_certmanager_CertificateRetainPrevious(in *v1.CertificateRetainPrevious, out *certmanager.CertificateRetainPrevious, s conversion.Scope) error {
+	out.GracePeriod = in.GracePeriod
+	return nil
+}
+
+// Convert_v1_CertificateRetainPrevious_To_certmanager_CertificateRetainPrevious is an autogenerated conversion function.
+func Convert_v1_CertificateRetainPrevious_To_certmanager_CertificateRetainPrevious(in *v1.CertificateRetainPrevious, out *certmanager.CertificateRetainPrevious, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRetainPrevious_To_certmanager_CertificateRetainPrevious(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRetainPrevious_To_v1_CertificateRetainPrevious(in *certmanager.CertificateRetainPrevious, out *v1.CertificateRetainPrevious, s conversion.Scope) error {
+	out.GracePeriod = in.GracePeriod
+	return nil
+}
+
+// Convert_certmanager_CertificateRetainPrevious_To_v1_CertificateRetainPrevious is an autogenerated conversion function.
+func Convert_certmanager_CertificateRetainPrevious_To_v1_CertificateRetainPrevious(in *certmanager.CertificateRetainPrevious, out *v1.CertificateRetainPrevious, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRetainPrevious_To_v1_CertificateRetainPrevious(in, out, s)
+}
+
+func autoConvert_v1_CertificateRevocation_To_certmanager_CertificateRevocation(in *v1.CertificateRevocation, out *certmanager.CertificateRevocation, s conversion.Scope) error {
+	out.SerialNumber = in.SerialNumber
+	if err := internalapismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
+	out.Reason = certmanager.CertificateRevocationReason(in.Reason)
+	out.State = certmanager.CertificateRevocationState(in.State)
+	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
+	out.Message = in.Message
+	out.FailedAttempts = in.FailedAttempts
+	out.LastAttemptTime = (*metav1.Time)(unsafe.Pointer(in.LastAttemptTime))
+	out.RevocationTime = (*metav1.Time)(unsafe.Pointer(in.RevocationTime))
+	return nil
+}
+
+// Convert_v1_CertificateRevocation_To_certmanager_CertificateRevocation is an autogenerated conversion function.
+func Convert_v1_CertificateRevocation_To_certmanager_CertificateRevocation(in *v1.CertificateRevocation, out *certmanager.CertificateRevocation, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRevocation_To_certmanager_CertificateRevocation(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRevocation_To_v1_CertificateRevocation(in *certmanager.CertificateRevocation, out *v1.CertificateRevocation, s conversion.Scope) error {
+	out.SerialNumber = in.SerialNumber
+	if err := internalapismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
+	out.Reason = v1.CertificateRevocationReason(in.Reason)
+	out.State = v1.CertificateRevocationState(in.State)
+	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
+	out.Message = in.Message
+	out.FailedAttempts = in.FailedAttempts
+	out.LastAttemptTime = (*metav1.Time)(unsafe.Pointer(in.LastAttemptTime))
+	out.RevocationTime = (*metav1.Time)(unsafe.Pointer(in.RevocationTime))
+	return nil
+}
+
+// Convert_certmanager_CertificateRevocation_To_v1_CertificateRevocation is an autogenerated conversion function.
+func Convert_certmanager_CertificateRevocation_To_v1_CertificateRevocation(in *certmanager.CertificateRevocation, out *v1.CertificateRevocation, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRevocation_To_v1_CertificateRevocation(in, out, s)
+}
+
+func autoConvert_v1_CertificateRevocationPolicy_To_certmanager_CertificateRevocationPolicy(in *v1.CertificateRevocationPolicy, out *certmanager.CertificateRevocationPolicy, s conversion.Scope) error {
+	out.OnKeyRotation = in.OnKeyRotation
+	out.OnDeletion = in.OnDeletion
+	return nil
+}
+
+// Convert_v1_CertificateRevocationPolicy_To_certmanager_CertificateRevocationPolicy is an autogenerated conversion function.
+func Convert_v1_CertificateRevocationPolicy_To_certmanager_CertificateRevocationPolicy(in *v1.CertificateRevocationPolicy, out *certmanager.CertificateRevocationPolicy, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRevocationPolicy_To_certmanager_CertificateRevocationPolicy(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRevocationPolicy_To_v1_CertificateRevocationPolicy(in *certmanager.CertificateRevocationPolicy, out *v1.CertificateRevocationPolicy, s conversion.Scope) error {
+	out.OnKeyRotation = in.OnKeyRotation
+	out.OnDeletion = in.OnDeletion
+	return nil
+}
+
+// Convert_certmanager_CertificateRevocationPolicy_To_v1_CertificateRevocationPolicy is an autogenerated conversion function.
+func Convert_certmanager_CertificateRevocationPolicy_To_v1_CertificateRevocationPolicy(in *certmanager.CertificateRevocationPolicy, out *v1.CertificateRevocationPolicy, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRevocationPolicy_To_v1_CertificateRevocationPolicy(in, out, s)
+}
+
 func autoConvert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in *v1.CertificateSecretTemplate, out *certmanager.CertificateSecretTemplate, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
@@ -864,6 +1126,8 @@ func autoConvert_v1_CertificateSpec_To_certmanager_CertificateSpec(in *v1.Certif
 	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
 	out.RenewBefore = (*metav1.Duration)(unsafe.Pointer(in.RenewBefore))
 	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
+	out.RenewalJitter = (*metav1.Duration)(unsafe.Pointer(in.RenewalJitter))
+	out.RenewalWindow = (*certmanager.CertificateRenewalWindow)(unsafe.Pointer(in.RenewalWindow))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
@@ -889,7 +1153,10 @@ func autoConvert_v1_CertificateSpec_To_certmanager_CertificateSpec(in *v1.Certif
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	out.AdditionalOutputFormats = *(*[]certmanager.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.RetainPrevious = (*certmanager.CertificateRetainPrevious)(unsafe.Pointer(in.RetainPrevious))
 	out.NameConstraints = (*certmanager.NameConstraints)(unsafe.Pointer(in.NameConstraints))
+	out.RevocationPolicy = (*certmanager.CertificateRevocationPolicy)(unsafe.Pointer(in.RevocationPolicy))
+	out.ACME = (*certmanager.CertificateACMEOptions)(unsafe.Pointer(in.ACME))
 	return nil
 }
 
@@ -905,6 +1172,8 @@ func autoConvert_certmanager_CertificateSpec_To_v1_CertificateSpec(in *certmanag
 	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
 	out.RenewBefore = (*metav1.Duration)(unsafe.Pointer(in.RenewBefore))
 	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
+	out.RenewalJitter = (*metav1.Duration)(unsafe.Pointer(in.RenewalJitter))
+	out.RenewalWindow = (*v1.CertificateRenewalWindow)(unsafe.Pointer(in.RenewalWindow))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
@@ -930,7 +1199,10 @@ func autoConvert_certmanager_CertificateSpec_To_v1_CertificateSpec(in *certmanag
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	out.AdditionalOutputFormats = *(*[]v1.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.RetainPrevious = (*v1.CertificateRetainPrevious)(unsafe.Pointer(in.RetainPrevious))
 	out.NameConstraints = (*v1.NameConstraints)(unsafe.Pointer(in.NameConstraints))
+	out.RevocationPolicy = (*v1.CertificateRevocationPolicy)(unsafe.Pointer(in.RevocationPolicy))
+	out.ACME = (*v1.CertificateACMEOptions)(unsafe.Pointer(in.ACME))
 	return nil
 }
 
@@ -947,7 +1219,21 @@ func autoConvert_v1_CertificateStatus_To_certmanager_CertificateStatus(in *v1.Ce
 	out.RenewalTime = (*metav1.Time)(unsafe.Pointer(in.RenewalTime))
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
+	out.PrivateKeyCreationTime = (*metav1.Time)(unsafe.Pointer(in.PrivateKeyCreationTime))
 	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	out.RenewalInfo = (*certmanager.CertificateRenewalInfo)(unsafe.Pointer(in.RenewalInfo))
+	if in.Revocations != nil {
+		in, out := &in.Revocations, &out.Revocations
+		*out = make([]certmanager.CertificateRevocation, len(*in))
+		for i := range *in {
+			if err := Convert_v1_CertificateRevocation_To_certmanager_CertificateRevocation(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Revocations = nil
+	}
+	out.History = *(*[]certmanager.CertificateIssuance)(unsafe.Pointer(&in.History))
 	return nil
 }
 
@@ -964,7 +1250,21 @@ func autoConvert_certmanager_CertificateStatus_To_v1_CertificateStatus(in *certm
 	out.RenewalTime = (*metav1.Time)(unsafe.Pointer(in.RenewalTime))
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
+	out.PrivateKeyCreationTime = (*metav1.Time)(unsafe.Pointer(in.PrivateKeyCreationTime))
 	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	out.RenewalInfo = (*v1.CertificateRenewalInfo)(unsafe.Pointer(in.RenewalInfo))
+	if in.Revocations != nil {
+		in, out := &in.Revocations, &out.Revocations
+		*out = make([]v1.CertificateRevocation, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_CertificateRevocation_To_v1_CertificateRevocation(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Revocations = nil
+	}
+	out.History = *(*[]v1.CertificateIssuance)(unsafe.Pointer(&in.History))
 	return nil
 }
 
@@ -1231,6 +1531,7 @@ func autoConvert_v1_IssuerSpec_To_certmanager_IssuerSpec(in *v1.IssuerSpec, out
 	if err := Convert_v1_IssuerConfig_To_certmanager_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.RenewalWindow = (*certmanager.CertificateRenewalWindow)(unsafe.Pointer(in.RenewalWindow))
 	return nil
 }
 
@@ -1243,6 +1544,7 @@ func autoConvert_certmanager_IssuerSpec_To_v1_IssuerSpec(in *certmanager.IssuerS
 	if err := Convert_certmanager_IssuerConfig_To_v1_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.RenewalWindow = (*v1.CertificateRenewalWindow)(unsafe.Pointer(in.RenewalWindow))
 	return nil
 }
 
@@ -62,6 +62,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CertificateACMEOptions)(nil), (*certmanager.CertificateACMEOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_CertificateACMEOptions_To_certmanager_CertificateACMEOptions(a.(*CertificateACMEOptions), b.(*certmanager.CertificateACMEOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateACMEOptions)(nil), (*CertificateACMEOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateACMEOptions_To_v1alpha2_CertificateACMEOptions(a.(*certmanager.CertificateACMEOptions), b.(*CertificateACMEOptions), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CertificateAdditionalOutputFormat)(nil), (*certmanager.CertificateAdditionalOutputFormat)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(a.(*CertificateAdditionalOutputFormat), b.(*certmanager.CertificateAdditionalOutputFormat), scope)
 	}); err != nil {
@@ -82,6 +92,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CertificateIssuance)(nil), (*certmanager.CertificateIssuance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_CertificateIssuance_To_certmanager_CertificateIssuance(a.(*CertificateIssuance), b.(*certmanager.CertificateIssuance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateIssuance)(nil), (*CertificateIssuance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateIssuance_To_v1alpha2_CertificateIssuance(a.(*certmanager.CertificateIssuance), b.(*CertificateIssuance), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CertificateKeystores)(nil), (*certmanager.CertificateKeystores)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_CertificateKeystores_To_certmanager_CertificateKeystores(a.(*CertificateKeystores), b.(*certmanager.CertificateKeystores), scope)
 	}); err != nil {
@@ -107,6 +127,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CertificateRenewalInfo)(nil), (*certmanager.CertificateRenewalInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_CertificateRenewalInfo_To_certmanager_CertificateRenewalInfo(a.(*CertificateRenewalInfo), b.(*certmanager.CertificateRenewalInfo), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRenewalInfo)(nil), (*CertificateRenewalInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRenewalInfo_To_v1alpha2_CertificateRenewalInfo(a.(*certmanager.CertificateRenewalInfo), b.(*CertificateRenewalInfo), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CertificateRenewalWindow)(nil), (*certmanager.CertificateRenewalWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(a.(*CertificateRenewalWindow), b.(*certmanager.CertificateRenewalWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRenewalWindow)(nil), (*CertificateRenewalWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRenewalWindow_To_v1alpha2_CertificateRenewalWindow(a.(*certmanager.CertificateRenewalWindow), b.(*CertificateRenewalWindow), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CertificateRequest)(nil), (*certmanager.CertificateRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_CertificateRequest_To_certmanager_CertificateRequest(a.(*CertificateRequest), b.(*certmanager.CertificateRequest), scope)
 	}); err != nil {
@@ -147,6 +187,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CertificateRetainPrevious)(nil), (*certmanager.CertificateRetainPrevious)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_CertificateRetainPrevious_To_certmanager_CertificateRetainPrevious(a.(*CertificateRetainPrevious), b.(*certmanager.CertificateRetainPrevious), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRetainPrevious)(nil), (*CertificateRetainPrevious)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRetainPrevious_To_v1alpha2_CertificateRetainPrevious(a.(*certmanager.CertificateRetainPrevious), b.(*CertificateRetainPrevious), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CertificateRevocation)(nil), (*certmanager.CertificateRevocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_CertificateRevocation_To_certmanager_CertificateRevocation(a.(*CertificateRevocation), b.(*certmanager.CertificateRevocation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRevocation)(nil), (*CertificateRevocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRevocation_To_v1alpha2_CertificateRevocation(a.(*certmanager.CertificateRevocation), b.(*CertificateRevocation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CertificateRevocationPolicy)(nil), (*certmanager.CertificateRevocationPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_CertificateRevocationPolicy_To_certmanager_CertificateRevocationPolicy(a.(*CertificateRevocationPolicy), b.(*certmanager.CertificateRevocationPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRevocationPolicy)(nil), (*CertificateRevocationPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRevocationPolicy_To_v1alpha2_CertificateRevocationPolicy(a.(*certmanager.CertificateRevocationPolicy), b.(*CertificateRevocationPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CertificateSecretTemplate)(nil), (*certmanager.CertificateSecretTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(a.(*CertificateSecretTemplate), b.(*certmanager.CertificateSecretTemplate), scope)
 	}); err != nil {
@@ -440,6 +510,7 @@ func autoConvert_v1alpha2_CAIssuer_To_certmanager_CAIssuer(in *CAIssuer, out *ce
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
 	out.IssuingCertificateURLs = *(*[]string)(unsafe.Pointer(&in.IssuingCertificateURLs))
+	out.CRLSecretName = in.CRLSecretName
 	return nil
 }
 
@@ -453,6 +524,7 @@ func autoConvert_certmanager_CAIssuer_To_v1alpha2_CAIssuer(in *certmanager.CAIss
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
 	out.IssuingCertificateURLs = *(*[]string)(unsafe.Pointer(&in.IssuingCertificateURLs))
+	out.CRLSecretName = in.CRLSecretName
 	return nil
 }
 
@@ -493,6 +565,26 @@ func Convert_certmanager_Certificate_To_v1alpha2_Certificate(in *certmanager.Cer
 	return autoConvert_certmanager_Certificate_To_v1alpha2_Certificate(in, out, s)
 }
 
+func autoConvert_v1alpha2_CertificateACMEOptions_To_certmanager_CertificateACMEOptions(in *CertificateACMEOptions, out *certmanager.CertificateACMEOptions, s conversion.Scope) error {
+	out.Profile = in.Profile
+	return nil
+}
+
+// Convert_v1alpha2_CertificateACMEOptions_To_certmanager_CertificateACMEOptions is an autogenerated conversion function.
+func Convert_v1alpha2_CertificateACMEOptions_To_certmanager_CertificateACMEOptions(in *CertificateACMEOptions, out *certmanager.CertificateACMEOptions, s conversion.Scope) error {
+	return autoConvert_v1alpha2_CertificateACMEOptions_To_certmanager_CertificateACMEOptions(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateACMEOptions_To_v1alpha2_CertificateACMEOptions(in *certmanager.CertificateACMEOptions, out *CertificateACMEOptions, s conversion.Scope) error {
+	out.Profile = in.Profile
+	return nil
+}
+
+// Convert_certmanager_CertificateACMEOptions_To_v1alpha2_CertificateACMEOptions is an autogenerated conversion function.
+func Convert_certmanager_CertificateACMEOptions_To_v1alpha2_CertificateACMEOptions(in *certmanager.CertificateACMEOptions, out *CertificateACMEOptions, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateACMEOptions_To_v1alpha2_CertificateACMEOptions(in, out, s)
+}
+
 func autoConvert_v1alpha2_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in *CertificateAdditionalOutputFormat, out *certmanager.CertificateAdditionalOutputFormat, s conversion.Scope) error {
 	out.Type = certmanager.CertificateOutputFormatType(in.Type)
 	return nil
@@ -543,6 +635,38 @@ func Convert_certmanager_CertificateCondition_To_v1alpha2_CertificateCondition(i
 	return autoConvert_certmanager_CertificateCondition_To_v1alpha2_CertificateCondition(in, out, s)
 }
 
+func autoConvert_v1alpha2_CertificateIssuance_To_certmanager_CertificateIssuance(in *CertificateIssuance, out *certmanager.CertificateIssuance, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.CertificateRequest = in.CertificateRequest
+	out.IssuanceTime = in.IssuanceTime
+	out.SerialNumber = in.SerialNumber
+	out.SHA256Fingerprint = in.SHA256Fingerprint
+	out.Issuer = in.Issuer
+	out.KeyAlgorithm = certmanager.PrivateKeyAlgorithm(in.KeyAlgorithm)
+	return nil
+}
+
+// Convert_v1alpha2_CertificateIssuance_To_certmanager_CertificateIssuance is an autogenerated conversion function.
+func Convert_v1alpha2_CertificateIssuance_To_certmanager_CertificateIssuance(in *CertificateIssuance, out *certmanager.CertificateIssuance, s conversion.Scope) error {
+	return autoConvert_v1alpha2_CertificateIssuance_To_certmanager_CertificateIssuance(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateIssuance_To_v1alpha2_CertificateIssuance(in *certmanager.CertificateIssuance, out *CertificateIssuance, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.CertificateRequest = in.CertificateRequest
+	out.IssuanceTime = in.IssuanceTime
+	out.SerialNumber = in.SerialNumber
+	out.SHA256Fingerprint = in.SHA256Fingerprint
+	out.Issuer = in.Issuer
+	out.KeyAlgorithm = KeyAlgorithm(in.KeyAlgorithm)
+	return nil
+}
+
+// Convert_certmanager_CertificateIssuance_To_v1alpha2_CertificateIssuance is an autogenerated conversion function.
+func Convert_certmanager_CertificateIssuance_To_v1alpha2_CertificateIssuance(in *certmanager.CertificateIssuance, out *CertificateIssuance, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateIssuance_To_v1alpha2_CertificateIssuance(in, out, s)
+}
+
 func autoConvert_v1alpha2_CertificateKeystores_To_certmanager_CertificateKeystores(in *CertificateKeystores, out *certmanager.CertificateKeystores, s conversion.Scope) error {
 	if in.JKS != nil {
 		in, out := &in.JKS, &out.JKS
@@ -641,6 +765,7 @@ func Convert_certmanager_CertificateList_To_v1alpha2_CertificateList(in *certman
 
 func autoConvert_v1alpha2_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(in *CertificatePrivateKey, out *certmanager.CertificatePrivateKey, s conversion.Scope) error {
 	out.RotationPolicy = certmanager.PrivateKeyRotationPolicy(in.RotationPolicy)
+	out.RotationMaxAge = (*v1.Duration)(unsafe.Pointer(in.RotationMaxAge))
 	return nil
 }
 
@@ -651,12 +776,67 @@ func Convert_v1alpha2_CertificatePrivateKey_To_certmanager_CertificatePrivateKey
 
 func autoConvert_certmanager_CertificatePrivateKey_To_v1alpha2_CertificatePrivateKey(in *certmanager.CertificatePrivateKey, out *CertificatePrivateKey, s conversion.Scope) error {
 	out.RotationPolicy = PrivateKeyRotationPolicy(in.RotationPolicy)
+	out.RotationMaxAge = (*v1.Duration)(unsafe.Pointer(in.RotationMaxAge))
 	// WARNING: in.Encoding requires manual conversion: does not exist in peer-type
 	// WARNING: in.Algorithm requires manual conversion: does not exist in peer-type
 	// WARNING: in.Size requires manual conversion: does not exist in peer-type
 	return nil
 }
 
+func autoConvert_v1alpha2_CertificateRenewalInfo_To_certmanager_CertificateRenewalInfo(in *CertificateRenewalInfo, out *certmanager.CertificateRenewalInfo, s conversion.Scope) error {
+	out.CertID = in.CertID
+	out.SuggestedWindowStart = in.SuggestedWindowStart
+	out.SuggestedWindowEnd = in.SuggestedWindowEnd
+	out.ExplanationURL = in.ExplanationURL
+	out.NextCheckTime = (*v1.Time)(unsafe.Pointer(in.NextCheckTime))
+	return nil
+}
+
+// Convert_v1alpha2_CertificateRenewalInfo_To_certmanager_CertificateRenewalInfo is an autogenerated conversion function.
+func Convert_v1alpha2_CertificateRenewalInfo_To_certmanager_CertificateRenewalInfo(in *CertificateRenewalInfo, out *certmanager.CertificateRenewalInfo, s conversion.Scope) error {
+	return autoConvert_v1alpha2_CertificateRenewalInfo_To_certmanager_CertificateRenewalInfo(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRenewalInfo_To_v1alpha2_CertificateRenewalInfo(in *certmanager.CertificateRenewalInfo, out *CertificateRenewalInfo, s conversion.Scope) error {
+	out.CertID = in.CertID
+	out.SuggestedWindowStart = in.SuggestedWindowStart
+	out.SuggestedWindowEnd = in.SuggestedWindowEnd
+	out.ExplanationURL = in.ExplanationURL
+	out.NextCheckTime = (*v1.Time)(unsafe.Pointer(in.NextCheckTime))
+	return nil
+}
+
+// Convert_certmanager_CertificateRenewalInfo_To_v1alpha2_CertificateRenewalInfo is an autogenerated conversion function.
+func Convert_certmanager_CertificateRenewalInfo_To_v1alpha2_CertificateRenewalInfo(in *certmanager.CertificateRenewalInfo, out *CertificateRenewalInfo, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRenewalInfo_To_v1alpha2_CertificateRenewalInfo(in, out, s)
+}
+
+func autoConvert_v1alpha2_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in *CertificateRenewalWindow, out *certmanager.CertificateRenewalWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	out.TimeZone = in.TimeZone
+	out.OverrideBeforeExpiry = (*v1.Duration)(unsafe.Pointer(in.OverrideBeforeExpiry))
+	return nil
+}
+
+// Convert_v1alpha2_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow is an autogenerated conversion function.
+func Convert_v1alpha2_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in *CertificateRenewalWindow, out *certmanager.CertificateRenewalWindow, s conversion.Scope) error {
+	return autoConvert_v1alpha2_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRenewalWindow_To_v1alpha2_CertificateRenewalWindow(in *certmanager.CertificateRenewalWindow, out *CertificateRenewalWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	out.TimeZone = in.TimeZone
+	out.OverrideBeforeExpiry = (*v1.Duration)(unsafe.Pointer(in.OverrideBeforeExpiry))
+	return nil
+}
+
+// Convert_certmanager_CertificateRenewalWindow_To_v1alpha2_CertificateRenewalWindow is an autogenerated conversion function.
+func Convert_certmanager_CertificateRenewalWindow_To_v1alpha2_CertificateRenewalWindow(in *certmanager.CertificateRenewalWindow, out *CertificateRenewalWindow, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRenewalWindow_To_v1alpha2_CertificateRenewalWindow(in, out, s)
+}
+
 func autoConvert_v1alpha2_CertificateRequest_To_certmanager_CertificateRequest(in *CertificateRequest, out *certmanager.CertificateRequest, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha2_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -815,6 +995,88 @@ func Convert_certmanager_CertificateRequestStatus_To_v1alpha2_CertificateRequest
 	return autoConvert_certmanager_CertificateRequestStatus_To_v1alpha2_CertificateRequestStatus(in, out, s)
 }
 
+func autoConvert_v1alpha2_CertificateRetainPrevious_To_certmanager_CertificateRetainPrevious(in *CertificateRetainPrevious, out *certmanager.CertificateRetainPrevious, s conversion.Scope) error {
+	out.GracePeriod = in.GracePeriod
+	return nil
+}
+
+// Convert_v1alpha2_CertificateRetainPrevious_To_certmanager_CertificateRetainPrevious is an autogenerated conversion function.
+func Convert_v1alpha2_CertificateRetainPrevious_To_certmanager_CertificateRetainPrevious(in *CertificateRetainPrevious, out *certmanager.CertificateRetainPrevious, s conversion.Scope) error {
+	return autoConvert_v1alpha2_CertificateRetainPrevious_To_certmanager_CertificateRetainPrevious(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRetainPrevious_To_v1alpha2_CertificateRetainPrevious(in *certmanager.CertificateRetainPrevious, out *CertificateRetainPrevious, s conversion.Scope) error {
+	out.GracePeriod = in.GracePeriod
+	return nil
+}
+
+// Convert_certmanager_CertificateRetainPrevious_To_v1alpha2_CertificateRetainPrevious is an autogenerated conversion function.
+func Convert_certmanager_CertificateRetainPrevious_To_v1alpha2_CertificateRetainPrevious(in *certmanager.CertificateRetainPrevious, out *CertificateRetainPrevious, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRetainPrevious_To_v1alpha2_CertificateRetainPrevious(in, out, s)
+}
+
+func autoConvert_v1alpha2_CertificateRevocation_To_certmanager_CertificateRevocation(in *CertificateRevocation, out *certmanager.CertificateRevocation, s conversion.Scope) error {
+	out.SerialNumber = in.SerialNumber
+	if err := apismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
+	out.Reason = certmanager.CertificateRevocationReason(in.Reason)
+	out.State = certmanager.CertificateRevocationState(in.State)
+	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
+	out.Message = in.Message
+	out.FailedAttempts = in.FailedAttempts
+	out.LastAttemptTime = (*v1.Time)(unsafe.Pointer(in.LastAttemptTime))
+	out.RevocationTime = (*v1.Time)(unsafe.Pointer(in.RevocationTime))
+	return nil
+}
+
+// Convert_v1alpha2_CertificateRevocation_To_certmanager_CertificateRevocation is an autogenerated conversion function.
+func Convert_v1alpha2_CertificateRevocation_To_certmanager_CertificateRevocation(in *CertificateRevocation, out *certmanager.CertificateRevocation, s conversion.Scope) error {
+	return autoConvert_v1alpha2_CertificateRevocation_To_certmanager_CertificateRevocation(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRevocation_To_v1alpha2_CertificateRevocation(in *certmanager.CertificateRevocation, out *CertificateRevocation, s conversion.Scope) error {
+	out.SerialNumber = in.SerialNumber
+	if err := apismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
+	out.Reason = CertificateRevocationReason(in.Reason)
+	out.State = CertificateRevocationState(in.State)
+	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
+	out.Message = in.Message
+	out.FailedAttempts = in.FailedAttempts
+	out.LastAttemptTime = (*v1.Time)(unsafe.Pointer(in.LastAttemptTime))
+	out.RevocationTime = (*v1.Time)(unsafe.Pointer(in.RevocationTime))
+	return nil
+}
+
+// Convert_certmanager_CertificateRevocation_To_v1alpha2_CertificateRevocation is an autogenerated conversion function.
+func Convert_certmanager_CertificateRevocation_To_v1alpha2_CertificateRevocation(in *certmanager.CertificateRevocation, out *CertificateRevocation, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRevocation_To_v1alpha2_CertificateRevocation(in, out, s)
+}
+
+func autoConvert_v1alpha2_CertificateRevocationPolicy_To_certmanager_CertificateRevocationPolicy(in *CertificateRevocationPolicy, out *certmanager.CertificateRevocationPolicy, s conversion.Scope) error {
+	out.OnKeyRotation = in.OnKeyRotation
+	out.OnDeletion = in.OnDeletion
+	return nil
+}
+
+// Convert_v1alpha2_CertificateRevocationPolicy_To_certmanager_CertificateRevocationPolicy is an autogenerated conversion function.
+func Convert_v1alpha2_CertificateRevocationPolicy_To_certmanager_CertificateRevocationPolicy(in *CertificateRevocationPolicy, out *certmanager.CertificateRevocationPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha2_CertificateRevocationPolicy_To_certmanager_CertificateRevocationPolicy(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRevocationPolicy_To_v1alpha2_CertificateRevocationPolicy(in *certmanager.CertificateRevocationPolicy, out *CertificateRevocationPolicy, s conversion.Scope) error {
+	out.OnKeyRotation = in.OnKeyRotation
+	out.OnDeletion = in.OnDeletion
+	return nil
+}
+
+// Convert_certmanager_CertificateRevocationPolicy_To_v1alpha2_CertificateRevocationPolicy is an autogenerated conversion function.
+func Convert_certmanager_CertificateRevocationPolicy_To_v1alpha2_CertificateRevocationPolicy(in *certmanager.CertificateRevocationPolicy, out *CertificateRevocationPolicy, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRevocationPolicy_To_v1alpha2_CertificateRevocationPolicy(in, out, s)
+}
+
 func autoConvert_v1alpha2_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in *CertificateSecretTemplate, out *certmanager.CertificateSecretTemplate, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
@@ -853,6 +1115,8 @@ func autoConvert_v1alpha2_CertificateSpec_To_certmanager_CertificateSpec(in *Cer
 	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
 	out.RenewBefore = (*v1.Duration)(unsafe.Pointer(in.RenewBefore))
 	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
+	out.RenewalJitter = (*v1.Duration)(unsafe.Pointer(in.RenewalJitter))
+	out.RenewalWindow = (*certmanager.CertificateRenewalWindow)(unsafe.Pointer(in.RenewalWindow))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	// WARNING: in.URISANs requires manual conversion: does not exist in peer-type
@@ -889,7 +1153,10 @@ func autoConvert_v1alpha2_CertificateSpec_To_certmanager_CertificateSpec(in *Cer
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	out.AdditionalOutputFormats = *(*[]certmanager.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.RetainPrevious = (*certmanager.CertificateRetainPrevious)(unsafe.Pointer(in.RetainPrevious))
 	out.NameConstraints = (*certmanager.NameConstraints)(unsafe.Pointer(in.NameConstraints))
+	out.RevocationPolicy = (*certmanager.CertificateRevocationPolicy)(unsafe.Pointer(in.RevocationPolicy))
+	out.ACME = (*certmanager.CertificateACMEOptions)(unsafe.Pointer(in.ACME))
 	return nil
 }
 
@@ -908,6 +1175,8 @@ func autoConvert_certmanager_CertificateSpec_To_v1alpha2_CertificateSpec(in *cer
 	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
 	out.RenewBefore = (*v1.Duration)(unsafe.Pointer(in.RenewBefore))
 	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
+	out.RenewalJitter = (*v1.Duration)(unsafe.Pointer(in.RenewalJitter))
+	out.RenewalWindow = (*CertificateRenewalWindow)(unsafe.Pointer(in.RenewalWindow))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	// WARNING: in.URIs requires manual conversion: does not exist in peer-type
@@ -941,7 +1210,10 @@ func autoConvert_certmanager_CertificateSpec_To_v1alpha2_CertificateSpec(in *cer
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	out.AdditionalOutputFormats = *(*[]CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.RetainPrevious = (*CertificateRetainPrevious)(unsafe.Pointer(in.RetainPrevious))
 	out.NameConstraints = (*NameConstraints)(unsafe.Pointer(in.NameConstraints))
+	out.RevocationPolicy = (*CertificateRevocationPolicy)(unsafe.Pointer(in.RevocationPolicy))
+	out.ACME = (*CertificateACMEOptions)(unsafe.Pointer(in.ACME))
 	return nil
 }
 
@@ -953,7 +1225,21 @@ func autoConvert_v1alpha2_CertificateStatus_To_certmanager_CertificateStatus(in
 	out.RenewalTime = (*v1.Time)(unsafe.Pointer(in.RenewalTime))
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
+	out.PrivateKeyCreationTime = (*v1.Time)(unsafe.Pointer(in.PrivateKeyCreationTime))
 	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	out.RenewalInfo = (*certmanager.CertificateRenewalInfo)(unsafe.Pointer(in.RenewalInfo))
+	if in.Revocations != nil {
+		in, out := &in.Revocations, &out.Revocations
+		*out = make([]certmanager.CertificateRevocation, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_CertificateRevocation_To_certmanager_CertificateRevocation(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Revocations = nil
+	}
+	out.History = *(*[]certmanager.CertificateIssuance)(unsafe.Pointer(&in.History))
 	return nil
 }
 
@@ -970,7 +1256,21 @@ func autoConvert_certmanager_CertificateStatus_To_v1alpha2_CertificateStatus(in
 	out.RenewalTime = (*v1.Time)(unsafe.Pointer(in.RenewalTime))
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
+	out.PrivateKeyCreationTime = (*v1.Time)(unsafe.Pointer(in.PrivateKeyCreationTime))
 	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	out.RenewalInfo = (*CertificateRenewalInfo)(unsafe.Pointer(in.RenewalInfo))
+	if in.Revocations != nil {
+		in, out := &in.Revocations, &out.Revocations
+		*out = make([]CertificateRevocation, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_CertificateRevocation_To_v1alpha2_CertificateRevocation(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Revocations = nil
+	}
+	out.History = *(*[]CertificateIssuance)(unsafe.Pointer(&in.History))
 	return nil
 }
 
@@ -1237,6 +1537,7 @@ func autoConvert_v1alpha2_IssuerSpec_To_certmanager_IssuerSpec(in *IssuerSpec, o
 	if err := Convert_v1alpha2_IssuerConfig_To_certmanager_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.RenewalWindow = (*certmanager.CertificateRenewalWindow)(unsafe.Pointer(in.RenewalWindow))
 	return nil
 }
 
@@ -1249,6 +1550,7 @@ func autoConvert_certmanager_IssuerSpec_To_v1alpha2_IssuerSpec(in *certmanager.I
 	if err := Convert_certmanager_IssuerConfig_To_v1alpha2_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.RenewalWindow = (*CertificateRenewalWindow)(unsafe.Pointer(in.RenewalWindow))
 	return nil
 }
 
@@ -134,6 +134,29 @@ type CertificateSpec struct {
 	// +optional
 	RenewBeforePercentage *int32 `json:"renewBeforePercentage,omitempty"`
 
+	// `renewalJitter` spreads the renewals of Certificates that would
+	// otherwise be renewed at the same time, for example because they were
+	// created together with the same duration. The renewal time of the
+	// certificate is moved earlier by a stable offset between zero and
+	// `renewalJitter`, derived from the Certificate's namespace and name.
+	// The offset is reflected in `status.renewalTime`.
+	//
+	// If unset, the default renewal jitter configured on the cert-manager
+	// controller is used. A value of zero disables the jitter.
+	// Value must be in units accepted by Go time.ParseDuration https://golang.org/pkg/time/#ParseDuration.
+	// +optional
+	RenewalJitter *metav1.Duration `json:"renewalJitter,omitempty"`
+
+	// `renewalWindow` restricts the renewal of the certificate to recurring
+	// maintenance windows, e.g. because the consumers of the certificate are
+	// restarted when it changes.
+	// If unset, the renewal window of the Issuer or ClusterIssuer referenced
+	// by `issuerRef` applies, if any.
+	// Renewals suggested by ACME Renewal Information and re-issuances caused
+	// by changes to the Certificate are not restricted.
+	// +optional
+	RenewalWindow *CertificateRenewalWindow `json:"renewalWindow,omitempty"`
+
 	// DNSNames is a list of DNS subjectAltNames to be set on the Certificate.
 	// +optional
 	DNSNames []string `json:"dnsNames,omitempty"`
@@ -246,6 +269,16 @@ type CertificateSpec struct {
 	// +optional
 	AdditionalOutputFormats []CertificateAdditionalOutputFormat `json:"additionalOutputFormats,omitempty"`
 
+	// RetainPrevious keeps the previously issued certificate and private key
+	// in the `tls.previous.crt` and `tls.previous.key` keys of the target
+	// Secret for a grace period after the certificate has been re-issued.
+	// This allows consumers that pin or cache the previous certificate, and
+	// peers that have not yet reloaded their trust, to keep working during a
+	// rollover.
+	// If unset, the previous certificate and private key are not kept.
+	// +optional
+	RetainPrevious *CertificateRetainPrevious `json:"retainPrevious,omitempty"`
+
 	// x.509 certificate NameConstraint extension which MUST NOT be used in a non-CA certificate.
 	// More Info: https://datatracker.ietf.org/doc/html/rfc5280#section-4.2.1.10
 	//
@@ -254,6 +287,51 @@ type CertificateSpec struct {
 	// the controller and webhook components.
 	// +optional
 	NameConstraints *NameConstraints `json:"nameConstraints,omitempty"`
+
+	// RevocationPolicy controls whether, and when, cert-manager revokes the
+	// certificates it has issued for this Certificate.
+	// If not set, certificates are never revoked by cert-manager.
+	// Revocation is supported by the ACME, CA and Vault issuers.
+	//
+	// This is an Alpha Feature and is only enabled with the
+	// `--feature-gates=CertificateRevocation=true` option set on the
+	// controller component.
+	// +optional
+	RevocationPolicy *CertificateRevocationPolicy `json:"revocationPolicy,omitempty"`
+
+	// ACME contains options that only apply when the Certificate is issued
+	// by an ACME Issuer.
+	// +optional
+	ACME *CertificateACMEOptions `json:"acme,omitempty"`
+}
+
+// CertificateRenewalWindow defines recurring maintenance windows in which
+// certificates may be renewed.
+type CertificateRenewalWindow struct {
+	// `schedule` is a cron expression in the standard five field format
+	// (minute, hour, day of month, month and day of week) that defines when
+	// each maintenance window starts, e.g. `0 2 * * 6` for every Saturday at
+	// 02:00.
+	Schedule string `json:"schedule"`
+
+	// `duration` is how long each maintenance window lasts.
+	// Value must be in units accepted by Go time.ParseDuration https://golang.org/pkg/time/#ParseDuration.
+	Duration metav1.Duration `json:"duration"`
+
+	// `timeZone` is the name of the IANA time zone in which the schedule is
+	// evaluated, e.g. `Europe/Amsterdam`. Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// `overrideBeforeExpiry` is a safety override that renews the certificate
+	// outside of a maintenance window if no maintenance window starts before
+	// the certificate is this close to its expiry.
+	// If unset, the certificate is renewed outside of a maintenance window
+	// once half of the time between its regular renewal time and its expiry
+	// has passed.
+	// Value must be in units accepted by Go time.ParseDuration https://golang.org/pkg/time/#ParseDuration.
+	// +optional
+	OverrideBeforeExpiry *metav1.Duration `json:"overrideBeforeExpiry,omitempty"`
 }
 
 type OtherName struct {
@@ -282,6 +360,18 @@ type CertificatePrivateKey struct {
 	// Default is 'Never' for backward compatibility.
 	// +optional
 	RotationPolicy PrivateKeyRotationPolicy `json:"rotationPolicy,omitempty"`
+
+	// RotationMaxAge is the maximum age of the private key when the
+	// `rotationPolicy` is `Never`. The private key is reused across
+	// re-issuances until it is older than this, at which point the
+	// certificate is re-issued with a newly generated private key.
+	// The age of the private key is tracked in the Secret's
+	// `cert-manager.io/private-key-creation-time` annotation and in
+	// `status.privateKeyCreationTime`.
+	// May not be set if the `rotationPolicy` is `Always`.
+	// Value must be in units accepted by Go time.ParseDuration https://golang.org/pkg/time/#ParseDuration.
+	// +optional
+	RotationMaxAge *metav1.Duration `json:"rotationMaxAge,omitempty"`
 }
 
 // Denotes how private keys should be generated or sourced when a Certificate
@@ -467,6 +557,17 @@ type CertificateStatus struct {
 	// +optional
 	NextPrivateKeySecretName *string `json:"nextPrivateKeySecretName,omitempty"`
 
+	// The time at which the private key stored in the Secret was generated.
+	// It is used to rotate the private key once it is older than
+	// `spec.privateKey.rotationMaxAge`. The issuing controller also records it
+	// in the `cert-manager.io/private-key-creation-time` annotation on the
+	// Secret, which takes precedence over this field.
+	// If the private key was generated outside of cert-manager, this is the
+	// notBefore time of the certificate stored with it when cert-manager first
+	// issued a certificate for it.
+	// +optional
+	PrivateKeyCreationTime *metav1.Time `json:"privateKeyCreationTime,omitempty"`
+
 	// The number of continuous failed issuance attempts up till now. This
 	// field gets removed (if set) on a successful issuance and gets set to
 	// 1 if unset and an issuance has failed. If an issuance has failed, the
@@ -474,8 +575,166 @@ type CertificateStatus struct {
 	// time.Hour * 2 ^ (failedIssuanceAttempts - 1).
 	// +optional
 	FailedIssuanceAttempts *int `json:"failedIssuanceAttempts,omitempty"`
+
+	// RenewalInfo contains the ACME Renewal Information (ARI, RFC 9773)
+	// most recently retrieved from the ACME server that issued the
+	// certificate stored in the Secret named by `spec.secretName`.
+	// When set, the suggested renewal window is taken into account when
+	// calculating `renewalTime`.
+	// This field is only set for Certificates issued by an ACME Issuer
+	// whose ACME server supports ARI and if the `ACMERenewalInfo` feature
+	// gate is enabled.
+	// +optional
+	RenewalInfo *CertificateRenewalInfo `json:"renewalInfo,omitempty"`
+
+	// Revocations records the certificates issued for this Certificate that
+	// are pending revocation, or that cert-manager has attempted to revoke,
+	// as configured by `spec.revocationPolicy`.
+	// Only the most recent entries are retained.
+	// +listType=atomic
+	// +optional
+	Revocations []CertificateRevocation `json:"revocations,omitempty"`
+
+	// History records the most recent certificates issued for this
+	// Certificate and stored in its Secret, oldest first. The number of
+	// entries retained is set by the controller's `--certificate-history-limit`
+	// flag and defaults to 10. Entries are kept when the CertificateRequests
+	// they were issued for are deleted, e.g. because of
+	// `spec.revisionHistoryLimit`.
+	// +listType=atomic
+	// +optional
+	History []CertificateIssuance `json:"history,omitempty"`
+}
+
+// CertificateRenewalInfo contains the ACME Renewal Information (ARI) for an
+// issued certificate, as defined in RFC 9773.
+type CertificateRenewalInfo struct {
+	// CertID is the ARI certificate identifier of the certificate that this
+	// renewal information applies to.
+	CertID string `json:"certID"`
+
+	// SuggestedWindowStart is the start of the renewal window suggested by
+	// the ACME server.
+	SuggestedWindowStart metav1.Time `json:"suggestedWindowStart"`
+
+	// SuggestedWindowEnd is the end of the renewal window suggested by the
+	// ACME server.
+	// A suggested window that lies in the past indicates that the ACME
+	// server wants the certificate to be renewed immediately, for example
+	// because it is about to be revoked.
+	SuggestedWindowEnd metav1.Time `json:"suggestedWindowEnd"`
+
+	// ExplanationURL is an optional URL provided by the ACME server which
+	// explains why the suggested window was chosen.
+	// +optional
+	ExplanationURL string `json:"explanationURL,omitempty"`
+
+	// NextCheckTime is the time after which the renewal information will be
+	// retrieved from the ACME server again.
+	// +optional
+	NextCheckTime *metav1.Time `json:"nextCheckTime,omitempty"`
+}
+
+// CertificateRevocation records the revocation of a single certificate.
+type CertificateRevocation struct {
+	// SerialNumber is the hex encoded serial number of the certificate.
+	SerialNumber string `json:"serialNumber"`
+
+	// IssuerRef is a reference to the issuer that issued the certificate
+	// and is asked to revoke it.
+	IssuerRef cmmeta.ObjectReference `json:"issuerRef"`
+
+	// Reason is the reason for revoking the certificate.
+	Reason CertificateRevocationReason `json:"reason"`
+
+	// State of the revocation.
+	State CertificateRevocationState `json:"state"`
+
+	// Certificate is the PEM encoded certificate to be revoked.
+	// It is only set while the revocation is pending.
+	// +optional
+	Certificate []byte `json:"certificate,omitempty"`
+
+	// Message is a human readable description of the outcome of the most
+	// recent revocation attempt.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// FailedAttempts is the number of failed attempts to revoke the
+	// certificate.
+	// +optional
+	FailedAttempts int `json:"failedAttempts,omitempty"`
+
+	// LastAttemptTime is the time of the most recent attempt to revoke the
+	// certificate.
+	// +optional
+	LastAttemptTime *metav1.Time `json:"lastAttemptTime,omitempty"`
+
+	// RevocationTime is the time at which the certificate was revoked.
+	// +optional
+	RevocationTime *metav1.Time `json:"revocationTime,omitempty"`
 }
 
+// CertificateIssuance records a certificate that was issued for a Certificate
+// and stored in its Secret.
+type CertificateIssuance struct {
+	// Revision of the Certificate that the certificate was issued for.
+	Revision int `json:"revision"`
+
+	// CertificateRequest is the name of the CertificateRequest that the
+	// certificate was issued for.
+	CertificateRequest string `json:"certificateRequest"`
+
+	// IssuanceTime is the time at which the certificate was stored in the
+	// Secret.
+	IssuanceTime metav1.Time `json:"issuanceTime"`
+
+	// SerialNumber is the hex encoded serial number of the certificate.
+	SerialNumber string `json:"serialNumber"`
+
+	// SHA256Fingerprint is the hex encoded SHA-256 fingerprint of the DER
+	// encoded certificate.
+	SHA256Fingerprint string `json:"sha256Fingerprint"`
+
+	// Issuer is the distinguished name of the issuer of the certificate.
+	Issuer string `json:"issuer"`
+
+	// KeyAlgorithm is the algorithm of the certificate's public key.
+	// +optional
+	KeyAlgorithm KeyAlgorithm `json:"keyAlgorithm,omitempty"`
+}
+
+// CertificateRevocationReason is the reason for revoking a certificate, as
+// defined in RFC 5280 section 5.3.1.
+// +kubebuilder:validation:Enum=Unspecified;KeyCompromise;AffiliationChanged;Superseded;CessationOfOperation
+type CertificateRevocationReason string
+
+const (
+	RevocationReasonUnspecified          CertificateRevocationReason = "Unspecified"
+	RevocationReasonKeyCompromise        CertificateRevocationReason = "KeyCompromise"
+	RevocationReasonAffiliationChanged   CertificateRevocationReason = "AffiliationChanged"
+	RevocationReasonSuperseded           CertificateRevocationReason = "Superseded"
+	RevocationReasonCessationOfOperation CertificateRevocationReason = "CessationOfOperation"
+)
+
+// CertificateRevocationState is the state of a certificate revocation.
+// +kubebuilder:validation:Enum=Pending;Revoked;Failed
+type CertificateRevocationState string
+
+const (
+	// RevocationStatePending means the certificate has not yet been revoked.
+	// Failed attempts are retried with an exponential back-off.
+	RevocationStatePending CertificateRevocationState = "Pending"
+
+	// RevocationStateRevoked means the certificate has been revoked.
+	RevocationStateRevoked CertificateRevocationState = "Revoked"
+
+	// RevocationStateFailed means cert-manager gave up revoking the
+	// certificate, either because the issuer does not support revocation or
+	// because too many attempts have failed.
+	RevocationStateFailed CertificateRevocationState = "Failed"
+)
+
 // CertificateCondition contains condition information for an Certificate.
 type CertificateCondition struct {
 	// Type of the condition, known values are (`Ready`, `Issuing`).
@@ -585,6 +844,47 @@ type CertificateAdditionalOutputFormat struct {
 	Type CertificateOutputFormatType `json:"type"`
 }
 
+// CertificateRetainPrevious controls how long the previously issued
+// certificate and private key are kept in the Certificate's target Secret.
+type CertificateRetainPrevious struct {
+	// GracePeriod is how long the previous certificate and private key are
+	// kept in the Secret after the certificate has been re-issued.
+	// Value must be in units accepted by Go time.ParseDuration https://golang.org/pkg/time/#ParseDuration.
+	GracePeriod metav1.Duration `json:"gracePeriod"`
+}
+
+// CertificateRevocationPolicy controls when the certificates issued for a
+// Certificate are revoked.
+type CertificateRevocationPolicy struct {
+	// OnKeyRotation controls whether a certificate is revoked, with reason
+	// `Superseded`, once it has been replaced by a newly issued certificate
+	// for a different private key.
+	// Certificates that are renewed using the same private key are never
+	// revoked.
+	// +optional
+	OnKeyRotation bool `json:"onKeyRotation,omitempty"`
+
+	// OnDeletion controls whether the current certificate is revoked, with
+	// reason `CessationOfOperation`, when the Certificate resource is
+	// deleted.
+	// When enabled, a finalizer is added to the Certificate which prevents
+	// it from being removed until the revocation has been attempted.
+	// +optional
+	OnDeletion bool `json:"onDeletion,omitempty"`
+}
+
+// CertificateACMEOptions contains options for Certificates issued by an ACME
+// Issuer.
+type CertificateACMEOptions struct {
+	// Profile is the name of the ACME certificate profile to request, as
+	// defined in draft-ietf-acme-profiles.
+	// Overrides the `profile` configured on the ACME Issuer.
+	// Changing this field triggers a re-issuance of the certificate.
+	// +optional
+	// +kubebuilder:validation:MaxLength=64
+	Profile string `json:"profile,omitempty"`
+}
+
 // NameConstraints is a type to represent x509 NameConstraints
 type NameConstraints struct {
 	// if true then the name constraints are marked critical.
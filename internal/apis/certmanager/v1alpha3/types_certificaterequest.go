@@ -38,6 +38,12 @@ const (
 	// CertificateRequest has been denied, and the CertificateRequest will never
 	// be issued.
 	CertificateRequestReasonDenied = "Denied"
+
+	// RateLimited is a Ready condition reason that indicates that a
+	// CertificateRequest is still in progress, but that the issuer is
+	// currently being rate limited by the certificate authority and the
+	// request will be continued once the rate limit has been reset.
+	CertificateRequestReasonRateLimited = "RateLimited"
 )
 
 // +genclient
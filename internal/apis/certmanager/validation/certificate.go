@@ -33,6 +33,7 @@ import (
 
 	internalcmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
 	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
+	"github.com/cert-manager/cert-manager/internal/cron"
 	"github.com/cert-manager/cert-manager/internal/webhook/feature"
 	"github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -160,17 +161,35 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 		default:
 			el = append(el, field.Invalid(fldPath.Child("privateKey", "algorithm"), crt.PrivateKey.Algorithm, "must be either empty or one of rsa, ecdsa or ed25519"))
 		}
+
+		if maxAge := crt.PrivateKey.RotationMaxAge; maxAge != nil {
+			switch {
+			case crt.PrivateKey.RotationPolicy == internalcmapi.RotationPolicyAlways:
+				el = append(el, field.Forbidden(fldPath.Child("privateKey", "rotationMaxAge"), "cannot be set when rotationPolicy is Always, as a new private key is generated for every issuance"))
+			case maxAge.Duration <= 0:
+				el = append(el, field.Invalid(fldPath.Child("privateKey", "rotationMaxAge"), maxAge.Duration, "must be greater than 0"))
+			}
+		}
 	}
 
 	if crt.Duration != nil || crt.RenewBefore != nil {
 		el = append(el, ValidateDuration(crt, fldPath)...)
 	}
+	if crt.RenewalJitter != nil && crt.RenewalJitter.Duration < 0 {
+		el = append(el, field.Invalid(fldPath.Child("renewalJitter"), crt.RenewalJitter.Duration, "must not be negative"))
+	}
+	if crt.RenewalWindow != nil {
+		el = append(el, ValidateCertificateRenewalWindow(crt.RenewalWindow, fldPath.Child("renewalWindow"))...)
+	}
 	if len(crt.Usages) > 0 {
 		el = append(el, validateUsages(crt, fldPath)...)
 	}
 	if crt.RevisionHistoryLimit != nil && *crt.RevisionHistoryLimit < 1 {
 		el = append(el, field.Invalid(fldPath.Child("revisionHistoryLimit"), *crt.RevisionHistoryLimit, "must not be less than 1"))
 	}
+	if crt.RetainPrevious != nil && crt.RetainPrevious.GracePeriod.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("retainPrevious", "gracePeriod"), crt.RetainPrevious.GracePeriod.Duration, "must be greater than 0"))
+	}
 
 	if crt.SecretTemplate != nil {
 		if len(crt.SecretTemplate.Labels) > 0 {
@@ -203,15 +222,43 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 func ValidateCertificate(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string) {
 	crt := obj.(*internalcmapi.Certificate)
 	allErrs := ValidateCertificateSpec(&crt.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateRevokeAnnotation(crt.Annotations, field.NewPath("metadata", "annotations"))...)
 	return allErrs, nil
 }
 
 func ValidateUpdateCertificate(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string) {
 	crt := obj.(*internalcmapi.Certificate)
 	allErrs := ValidateCertificateSpec(&crt.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateRevokeAnnotation(crt.Annotations, field.NewPath("metadata", "annotations"))...)
 	return allErrs, nil
 }
 
+// validateRevokeAnnotation checks that the revocation reason requested using
+// the cert-manager.io/revoke annotation is a known revocation reason.
+func validateRevokeAnnotation(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	reason, ok := annotations[cmapi.RevokeCertificateAnnotationKey]
+	if !ok || reason == "" {
+		return nil
+	}
+
+	switch internalcmapi.CertificateRevocationReason(reason) {
+	case internalcmapi.RevocationReasonUnspecified,
+		internalcmapi.RevocationReasonKeyCompromise,
+		internalcmapi.RevocationReasonAffiliationChanged,
+		internalcmapi.RevocationReasonSuperseded,
+		internalcmapi.RevocationReasonCessationOfOperation:
+		return nil
+	default:
+		return field.ErrorList{field.NotSupported(fldPath.Key(cmapi.RevokeCertificateAnnotationKey), reason, []string{
+			string(internalcmapi.RevocationReasonUnspecified),
+			string(internalcmapi.RevocationReasonKeyCompromise),
+			string(internalcmapi.RevocationReasonAffiliationChanged),
+			string(internalcmapi.RevocationReasonSuperseded),
+			string(internalcmapi.RevocationReasonCessationOfOperation),
+		})}
+	}
+}
+
 func validateIssuerRef(issuerRef cmmeta.ObjectReference, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
@@ -376,3 +423,26 @@ func validateAdditionalOutputFormats(crt *internalcmapi.CertificateSpec, fldPath
 
 	return el
 }
+
+// ValidateCertificateRenewalWindow validates the maintenance window in which
+// certificates may be renewed, as set on a Certificate or an Issuer.
+func ValidateCertificateRenewalWindow(window *internalcmapi.CertificateRenewalWindow, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if window.Schedule == "" {
+		el = append(el, field.Required(fldPath.Child("schedule"), ""))
+	} else if _, err := cron.Parse(window.Schedule); err != nil {
+		el = append(el, field.Invalid(fldPath.Child("schedule"), window.Schedule, err.Error()))
+	}
+	if window.Duration.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("duration"), window.Duration.Duration, "must be greater than 0"))
+	}
+	if window.TimeZone != "" {
+		if _, err := time.LoadLocation(window.TimeZone); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("timeZone"), window.TimeZone, "unknown time zone"))
+		}
+	}
+	if window.OverrideBeforeExpiry != nil && window.OverrideBeforeExpiry.Duration < 0 {
+		el = append(el, field.Invalid(fldPath.Child("overrideBeforeExpiry"), window.OverrideBeforeExpiry.Duration, "must not be negative"))
+	}
+	return el
+}
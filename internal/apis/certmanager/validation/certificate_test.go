@@ -613,6 +613,147 @@ func TestValidateCertificate(t *testing.T) {
 				field.Invalid(fldPath.Child("revisionHistoryLimit"), int32(0), "must not be less than 1"),
 			},
 		},
+		"invalid certificate with negative renewal jitter": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:    "abc",
+					SecretName:    "abc",
+					IssuerRef:     validIssuerRef,
+					RenewalJitter: &metav1.Duration{Duration: -time.Minute},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("renewalJitter"), -time.Minute, "must not be negative"),
+			},
+		},
+		"valid certificate with renewal window": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					RenewalWindow: &internalcmapi.CertificateRenewalWindow{
+						Schedule:             "0 2 * * sat",
+						Duration:             metav1.Duration{Duration: 4 * time.Hour},
+						TimeZone:             "Europe/Amsterdam",
+						OverrideBeforeExpiry: &metav1.Duration{Duration: 24 * time.Hour},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with invalid renewal window": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					RenewalWindow: &internalcmapi.CertificateRenewalWindow{
+						Schedule:             "0 25 * * *",
+						TimeZone:             "Not/AZone",
+						OverrideBeforeExpiry: &metav1.Duration{Duration: -time.Hour},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("renewalWindow", "schedule"), "0 25 * * *", `invalid hour "25": must be between 0 and 23`),
+				field.Invalid(fldPath.Child("renewalWindow", "duration"), time.Duration(0), "must be greater than 0"),
+				field.Invalid(fldPath.Child("renewalWindow", "timeZone"), "Not/AZone", "unknown time zone"),
+				field.Invalid(fldPath.Child("renewalWindow", "overrideBeforeExpiry"), -time.Hour, "must not be negative"),
+			},
+		},
+		"invalid certificate with renewal window without schedule": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					RenewalWindow: &internalcmapi.CertificateRenewalWindow{
+						Duration: metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Required(fldPath.Child("renewalWindow", "schedule"), ""),
+			},
+		},
+		"valid certificate with private key rotation max age": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						RotationPolicy: internalcmapi.RotationPolicyNever,
+						RotationMaxAge: &metav1.Duration{Duration: 90 * 24 * time.Hour},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with non-positive private key rotation max age": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						RotationMaxAge: &metav1.Duration{},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("privateKey", "rotationMaxAge"), time.Duration(0), "must be greater than 0"),
+			},
+		},
+		"invalid certificate with private key rotation max age and rotation policy Always": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						RotationPolicy: internalcmapi.RotationPolicyAlways,
+						RotationMaxAge: &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("privateKey", "rotationMaxAge"), "cannot be set when rotationPolicy is Always, as a new private key is generated for every issuance"),
+			},
+		},
+		"valid certificate retaining the previous certificate": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					RetainPrevious: &internalcmapi.CertificateRetainPrevious{
+						GracePeriod: metav1.Duration{Duration: 24 * time.Hour},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with non-positive retain previous grace period": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:     "abc",
+					SecretName:     "abc",
+					IssuerRef:      validIssuerRef,
+					RetainPrevious: &internalcmapi.CertificateRetainPrevious{},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("retainPrevious", "gracePeriod"), time.Duration(0), "must be greater than 0"),
+			},
+		},
 		"valid with empty secretTemplate": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
@@ -740,6 +881,37 @@ func TestValidateCertificate(t *testing.T) {
 			},
 			nameConstraintsFeatureEnabled: true,
 		},
+		"valid with a revoke annotation": {
+			cfg: &internalcmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{cmapi.RevokeCertificateAnnotationKey: "KeyCompromise"},
+				},
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid with an unknown revocation reason in the revoke annotation": {
+			cfg: &internalcmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{cmapi.RevokeCertificateAnnotationKey: "Compromised"},
+				},
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.NotSupported(field.NewPath("metadata", "annotations").Key(cmapi.RevokeCertificateAnnotationKey), "Compromised", []string{
+					"Unspecified", "KeyCompromise", "AffiliationChanged", "Superseded", "CessationOfOperation",
+				}),
+			},
+		},
 		"valid name constraints with feature gate disabled": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
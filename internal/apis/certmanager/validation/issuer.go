@@ -19,11 +19,14 @@ package validation
 import (
 	"crypto/x509"
 	"fmt"
+	"net"
+	"net/url"
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -38,18 +41,42 @@ import (
 func ValidateIssuer(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string) {
 	iss := obj.(*certmanager.Issuer)
 	allErrs, warnings := ValidateIssuerSpec(&iss.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateNamespacedIssuerSolvers(&iss.Spec, field.NewPath("spec"))...)
 	return allErrs, warnings
 }
 
 func ValidateUpdateIssuer(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string) {
 	iss := obj.(*certmanager.Issuer)
 	allErrs, warnings := ValidateIssuerSpec(&iss.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateNamespacedIssuerSolvers(&iss.Spec, field.NewPath("spec"))...)
 	// Admission request should never be nil
 	return allErrs, warnings
 }
 
+// validateNamespacedIssuerSolvers forbids the ACME challenge solvers which
+// may only be used by ClusterIssuers. The embedded DNS server serves the
+// records of a zone shared by the whole cluster, so allowing Issuers to use
+// it would let anyone able to create an Issuer obtain certificates for every
+// domain delegated to it.
+func validateNamespacedIssuerSolvers(iss *certmanager.IssuerSpec, fldPath *field.Path) field.ErrorList {
+	if iss.ACME == nil {
+		return nil
+	}
+	var el field.ErrorList
+	for i, sol := range iss.ACME.Solvers {
+		if sol.DNS01 != nil && sol.DNS01.Embedded != nil {
+			el = append(el, field.Forbidden(fldPath.Child("acme", "solvers").Index(i).Child("dns01", "embedded"), "the embedded DNS01 provider may only be used by ClusterIssuers"))
+		}
+	}
+	return el
+}
+
 func ValidateIssuerSpec(iss *certmanager.IssuerSpec, fldPath *field.Path) (field.ErrorList, []string) {
-	return ValidateIssuerConfig(&iss.IssuerConfig, fldPath)
+	el, warnings := ValidateIssuerConfig(&iss.IssuerConfig, fldPath)
+	if iss.RenewalWindow != nil {
+		el = append(el, ValidateCertificateRenewalWindow(iss.RenewalWindow, fldPath.Child("renewalWindow"))...)
+	}
+	return el, warnings
 }
 
 func ValidateIssuerConfig(iss *certmanager.IssuerConfig, fldPath *field.Path) (field.ErrorList, []string) {
@@ -128,6 +155,36 @@ func ValidateACMEIssuerConfig(iss *cmacme.ACMEIssuer, fldPath *field.Path) (fiel
 		el = append(el, field.Required(fldPath.Child("server"), "acme server URL is a required field"))
 	}
 
+	if next := iss.NextPrivateKey; next != nil {
+		if len(next.Name) == 0 {
+			el = append(el, field.Required(fldPath.Child("nextPrivateKeySecretRef", "name"), "next private key secret name is a required field"))
+		} else if next.Name == iss.PrivateKey.Name && next.Key == iss.PrivateKey.Key {
+			el = append(el, field.Invalid(fldPath.Child("nextPrivateKeySecretRef"), next.Name, "must not refer to the same Secret key as privateKeySecretRef"))
+		}
+	}
+
+	if pk := iss.AccountPrivateKey; pk != nil {
+		pkFldPath := fldPath.Child("privateKey")
+		switch pk.Algorithm {
+		case "", cmacme.RSAACMEAccountKeyAlgorithm:
+			if pk.Size > 0 && (pk.Size < 2048 || pk.Size > 8192) {
+				el = append(el, field.Invalid(pkFldPath.Child("size"), pk.Size, "must be between 2048 & 8192 for RSA algorithm"))
+			}
+		case cmacme.ECDSAACMEAccountKeyAlgorithm:
+			if pk.Size > 0 && pk.Size != 256 && pk.Size != 384 {
+				el = append(el, field.NotSupported(pkFldPath.Child("size"), pk.Size, []string{"256", "384"}))
+			}
+		case cmacme.Ed25519ACMEAccountKeyAlgorithm:
+			break
+		default:
+			el = append(el, field.NotSupported(pkFldPath.Child("algorithm"), pk.Algorithm, []string{
+				string(cmacme.RSAACMEAccountKeyAlgorithm),
+				string(cmacme.ECDSAACMEAccountKeyAlgorithm),
+				string(cmacme.Ed25519ACMEAccountKeyAlgorithm),
+			}))
+		}
+	}
+
 	if eab := iss.ExternalAccountBinding; eab != nil {
 		eabFldPath := fldPath.Child("externalAccountBinding")
 		if len(eab.KeyID) == 0 {
@@ -142,6 +199,32 @@ func ValidateACMEIssuerConfig(iss *cmacme.ACMEIssuer, fldPath *field.Path) (fiel
 		}
 	}
 
+	servers := sets.New(iss.Server)
+	for i, fs := range iss.FallbackServers {
+		fsFldPath := fldPath.Child("fallbackServers").Index(i)
+		switch {
+		case len(fs.Server) == 0:
+			el = append(el, field.Required(fsFldPath.Child("server"), "acme server URL is a required field"))
+		case servers.Has(fs.Server):
+			el = append(el, field.Duplicate(fsFldPath.Child("server"), fs.Server))
+		default:
+			servers.Insert(fs.Server)
+		}
+
+		if len(fs.PrivateKey.Name) == 0 {
+			el = append(el, field.Required(fsFldPath.Child("privateKeySecretRef", "name"), "private key secret name is a required field"))
+		}
+
+		if eab := fs.ExternalAccountBinding; eab != nil {
+			eabFldPath := fsFldPath.Child("externalAccountBinding")
+			if len(eab.KeyID) == 0 {
+				el = append(el, field.Required(eabFldPath.Child("keyID"), "the keyID field is required when using externalAccountBinding"))
+			}
+
+			el = append(el, ValidateSecretKeySelector(&eab.Key, eabFldPath.Child("keySecretRef"))...)
+		}
+	}
+
 	for i, sol := range iss.Solvers {
 		el = append(el, ValidateACMEIssuerChallengeSolverConfig(&sol, fldPath.Child("solvers").Index(i))...) // #nosec G601 -- False positive. See https://github.com/golang/go/discussions/56010
 	}
@@ -165,9 +248,20 @@ func ValidateACMEIssuerChallengeSolverConfig(sol *cmacme.ACMEChallengeSolver, fl
 			el = append(el, ValidateACMEChallengeSolverDNS01(sol.DNS01, fldPath.Child("dns01"))...)
 		}
 	}
+	if sol.TLSALPN01 != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath, "may not specify more than one solver type in a single solver"))
+		} else {
+			numProviders++
+			el = append(el, ValidateACMEIssuerChallengeSolverTLSALPN01Config(sol.TLSALPN01, fldPath.Child("tlsALPN01"))...)
+		}
+	}
 	if numProviders == 0 {
 		el = append(el, field.Required(fldPath, "no solver type configured"))
 	}
+	if sol.SelfCheckTimeout != nil && sol.SelfCheckTimeout.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("selfCheckTimeout"), sol.SelfCheckTimeout.Duration.String(), "must be greater than zero"))
+	}
 
 	return el
 }
@@ -190,6 +284,9 @@ func ValidateACMEIssuerChallengeSolverHTTP01Config(http01 *cmacme.ACMEChallengeS
 	if numDefined > 1 {
 		el = append(el, field.Required(fldPath, "only 1 HTTP01 solver type may be configured"))
 	}
+	if http01.SelfCheck != nil {
+		el = append(el, ValidateACMEChallengeSolverHTTP01SelfCheck(http01.SelfCheck, fldPath.Child("selfCheck"))...)
+	}
 
 	return el
 }
@@ -235,6 +332,20 @@ func ValidateACMEIssuerChallengeSolverHTTP01GatewayConfig(gateway *cmacme.ACMECh
 	return el
 }
 
+func ValidateACMEIssuerChallengeSolverTLSALPN01Config(tlsALPN01 *cmacme.ACMEChallengeSolverTLSALPN01, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	switch tlsALPN01.ServiceType {
+	case "", corev1.ServiceTypeClusterIP, corev1.ServiceTypeNodePort, corev1.ServiceTypeLoadBalancer:
+	default:
+		el = append(el, field.Invalid(fldPath.Child("serviceType"), tlsALPN01.ServiceType, `must be empty, "ClusterIP", "NodePort" or "LoadBalancer"`))
+	}
+	if tlsALPN01.GatewayTLSRoute != nil && len(tlsALPN01.GatewayTLSRoute.ParentRefs) == 0 {
+		el = append(el, field.Required(fldPath.Child("gatewayTLSRoute", "parentRefs"), `at least 1 parentRef is required`))
+	}
+	return el
+}
+
 func ValidateCAIssuerConfig(iss *certmanager.CAIssuer, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 	if len(iss.SecretName) == 0 {
@@ -421,6 +532,94 @@ var supportedTSIGAlgorithms = []string{
 	"HMACSHA512",
 }
 
+func ValidateACMEChallengeSolverDNS01SelfCheck(sc *cmacme.ACMEChallengeSolverDNS01SelfCheck, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	el = append(el, validateRecursiveNameservers(sc.RecursiveNameservers, fldPath.Child("recursiveNameservers"))...)
+
+	if sc.PropagationTimeout != nil && sc.PropagationTimeout.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("propagationTimeout"), sc.PropagationTimeout.Duration.String(), "must be greater than zero"))
+	}
+
+	names := make([]string, len(sc.Perspectives))
+	for i, perspective := range sc.Perspectives {
+		names[i] = perspective.Name
+		if len(perspective.RecursiveNameservers) == 0 {
+			el = append(el, field.Required(fldPath.Child("perspectives").Index(i).Child("recursiveNameservers"), ""))
+		}
+		el = append(el, validateRecursiveNameservers(perspective.RecursiveNameservers, fldPath.Child("perspectives").Index(i).Child("recursiveNameservers"))...)
+	}
+	el = append(el, validateSelfCheckPerspectives(names, sc.Quorum, fldPath)...)
+
+	return el
+}
+
+func ValidateACMEChallengeSolverHTTP01SelfCheck(sc *cmacme.ACMEChallengeSolverHTTP01SelfCheck, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	names := make([]string, len(sc.Perspectives))
+	for i, perspective := range sc.Perspectives {
+		names[i] = perspective.Name
+		if perspective.ProxyURL != "" {
+			if u, err := url.ParseRequestURI(perspective.ProxyURL); err != nil || u.Host == "" {
+				el = append(el, field.Invalid(fldPath.Child("perspectives").Index(i).Child("proxyURL"), perspective.ProxyURL, "must be a valid URL, e.g. http://proxy.example.com:3128"))
+			}
+		}
+		if perspective.SourceAddress != "" && net.ParseIP(perspective.SourceAddress) == nil {
+			el = append(el, field.Invalid(fldPath.Child("perspectives").Index(i).Child("sourceAddress"), perspective.SourceAddress, "must be a valid IP address"))
+		}
+	}
+	el = append(el, validateSelfCheckPerspectives(names, sc.Quorum, fldPath)...)
+
+	return el
+}
+
+func validateRecursiveNameservers(servers []string, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	for i, server := range servers {
+		if strings.HasPrefix(server, "https://") {
+			if u, err := url.ParseRequestURI(server); err != nil || u.Host == "" {
+				el = append(el, field.Invalid(fldPath.Index(i), server, "must be in the format https://<DoH RFC 8484 server address>"))
+			}
+			continue
+		}
+		if _, _, err := net.SplitHostPort(server); err != nil {
+			el = append(el, field.Invalid(fldPath.Index(i), server, "must be in the format <ip address>:<port> or https://<DoH RFC 8484 server address>"))
+		}
+	}
+
+	return el
+}
+
+// validateSelfCheckPerspectives validates the names of the self check
+// perspectives and the quorum of a self check.
+func validateSelfCheckPerspectives(names []string, quorum *int32, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	seen := sets.New[string]()
+	for i, name := range names {
+		switch {
+		case name == "":
+			el = append(el, field.Required(fldPath.Child("perspectives").Index(i).Child("name"), ""))
+		case seen.Has(name):
+			el = append(el, field.Duplicate(fldPath.Child("perspectives").Index(i).Child("name"), name))
+		}
+		seen.Insert(name)
+	}
+
+	if quorum != nil {
+		switch {
+		case len(names) == 0:
+			el = append(el, field.Forbidden(fldPath.Child("quorum"), "may only be set if perspectives are configured"))
+		case *quorum < 1 || int(*quorum) > len(names):
+			el = append(el, field.Invalid(fldPath.Child("quorum"), *quorum, fmt.Sprintf("must be between 1 and the number of perspectives (%d)", len(names))))
+		}
+	}
+
+	return el
+}
+
 func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
@@ -434,6 +633,9 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			el = append(el, field.Invalid(fldPath.Child("cnameStrategy"), p.CNAMEStrategy, fmt.Sprintf("must be one of %q or %q", cmacme.NoneStrategy, cmacme.FollowStrategy)))
 		}
 	}
+	if p.SelfCheck != nil {
+		el = append(el, ValidateACMEChallengeSolverDNS01SelfCheck(p.SelfCheck, fldPath.Child("selfCheck"))...)
+	}
 	numProviders := 0
 	if p.Akamai != nil {
 		numProviders++
@@ -606,6 +808,23 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			}
 		}
 	}
+	if p.Manual != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("manual"), "may not specify more than one provider type"))
+		} else {
+			numProviders++
+			if p.Manual.Timeout != nil && p.Manual.Timeout.Duration <= 0 {
+				el = append(el, field.Invalid(fldPath.Child("manual", "timeout"), p.Manual.Timeout.Duration.String(), "must be greater than zero"))
+			}
+		}
+	}
+	if p.Embedded != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("embedded"), "may not specify more than one provider type"))
+		} else {
+			numProviders++
+		}
+	}
 	if numProviders == 0 {
 		el = append(el, field.Required(fldPath, "no DNS01 provider configured"))
 	}
@@ -19,10 +19,12 @@ package validation
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
@@ -376,6 +378,126 @@ func TestValidateACMEIssuerConfig(t *testing.T) {
 				field.Required(fldPath.Child("server"), "acme server URL is a required field"),
 			},
 		},
+		"acme issuer with ECDSA P-384 account key": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				AccountPrivateKey: &cmacme.ACMEAccountPrivateKey{
+					Algorithm: cmacme.ECDSAACMEAccountKeyAlgorithm,
+					Size:      384,
+				},
+			},
+		},
+		"acme issuer with Ed25519 account key": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				AccountPrivateKey: &cmacme.ACMEAccountPrivateKey{
+					Algorithm: cmacme.Ed25519ACMEAccountKeyAlgorithm,
+				},
+			},
+		},
+		"acme issuer with invalid ECDSA account key size": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				AccountPrivateKey: &cmacme.ACMEAccountPrivateKey{
+					Algorithm: cmacme.ECDSAACMEAccountKeyAlgorithm,
+					Size:      521,
+				},
+			},
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("privateKey", "size"), 521, []string{"256", "384"}),
+			},
+		},
+		"acme issuer with invalid RSA account key size": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				AccountPrivateKey: &cmacme.ACMEAccountPrivateKey{
+					Size: 1024,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("privateKey", "size"), 1024, "must be between 2048 & 8192 for RSA algorithm"),
+			},
+		},
+		"acme issuer with unknown account key algorithm": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				AccountPrivateKey: &cmacme.ACMEAccountPrivateKey{
+					Algorithm: "DSA",
+				},
+			},
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("privateKey", "algorithm"), cmacme.ACMEAccountKeyAlgorithm("DSA"), []string{"RSA", "ECDSA", "Ed25519"}),
+			},
+		},
+		"acme issuer with next private key": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				NextPrivateKey: &cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "next"},
+				},
+			},
+		},
+		"acme issuer with next private key without name": {
+			spec: &cmacme.ACMEIssuer{
+				Email:          "valid-email",
+				Server:         "valid-server",
+				PrivateKey:     validSecretKeyRef,
+				NextPrivateKey: &cmmeta.SecretKeySelector{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("nextPrivateKeySecretRef", "name"), "next private key secret name is a required field"),
+			},
+		},
+		"acme issuer with next private key equal to the private key": {
+			spec: &cmacme.ACMEIssuer{
+				Email:          "valid-email",
+				Server:         "valid-server",
+				PrivateKey:     validSecretKeyRef,
+				NextPrivateKey: validSecretKeyRef.DeepCopy(),
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("nextPrivateKeySecretRef"), validSecretKeyRef.Name, "must not refer to the same Secret key as privateKeySecretRef"),
+			},
+		},
+		"acme issuer with fallback servers": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				FallbackServers: []cmacme.ACMEFallbackServer{
+					{Server: "fallback-server", PrivateKey: validSecretKeyRef},
+				},
+			},
+		},
+		"acme issuer with invalid fallback servers": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				FallbackServers: []cmacme.ACMEFallbackServer{
+					{PrivateKey: validSecretKeyRef},
+					{Server: "valid-server", PrivateKey: validSecretKeyRef},
+					{Server: "fallback-server"},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("fallbackServers").Index(0).Child("server"), "acme server URL is a required field"),
+				field.Duplicate(fldPath.Child("fallbackServers").Index(1).Child("server"), "valid-server"),
+				field.Required(fldPath.Child("fallbackServers").Index(2).Child("privateKeySecretRef", "name"), "private key secret name is a required field"),
+			},
+		},
 		"acme issuer with an invalid CA bundle": {
 			spec: &cmacme.ACMEIssuer{
 				Email:      "valid-email",
@@ -441,6 +563,39 @@ func TestValidateACMEIssuerConfig(t *testing.T) {
 				},
 			},
 		},
+		"acme solver with a self check timeout": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				Solvers: []cmacme.ACMEChallengeSolver{
+					{
+						DNS01: &cmacme.ACMEChallengeSolverDNS01{
+							CloudDNS: &validCloudDNSProvider,
+						},
+						SelfCheckTimeout: &metav1.Duration{Duration: 5 * time.Minute},
+					},
+				},
+			},
+		},
+		"acme solver with a negative self check timeout": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				Solvers: []cmacme.ACMEChallengeSolver{
+					{
+						DNS01: &cmacme.ACMEChallengeSolverDNS01{
+							CloudDNS: &validCloudDNSProvider,
+						},
+						SelfCheckTimeout: &metav1.Duration{Duration: -time.Minute},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("solvers").Index(0).Child("selfCheckTimeout"), "-1m0s", "must be greater than zero"),
+			},
+		},
 		"acme solver with external account binding missing required fields": {
 			spec: &cmacme.ACMEIssuer{
 				Email:                  "valid-email",
@@ -598,6 +753,71 @@ func TestValidateACMEIssuerConfig(t *testing.T) {
 				),
 			},
 		},
+		"acme solver with valid tlsALPN01 config": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				Solvers: []cmacme.ACMEChallengeSolver{
+					{
+						TLSALPN01: &cmacme.ACMEChallengeSolverTLSALPN01{
+							ServiceType: corev1.ServiceTypeLoadBalancer,
+							GatewayTLSRoute: &cmacme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute{
+								ParentRefs: []gwapi.ParentReference{
+									{
+										Name: "blah",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"acme solver with invalid tlsALPN01 config": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				Solvers: []cmacme.ACMEChallengeSolver{
+					{
+						TLSALPN01: &cmacme.ACMEChallengeSolverTLSALPN01{
+							ServiceType:     corev1.ServiceTypeExternalName,
+							GatewayTLSRoute: &cmacme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute{},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(
+					fldPath.Child("solvers").Index(0).Child("tlsALPN01", "serviceType"),
+					corev1.ServiceTypeExternalName,
+					`must be empty, "ClusterIP", "NodePort" or "LoadBalancer"`,
+				),
+				field.Required(
+					fldPath.Child("solvers").Index(0).Child("tlsALPN01", "gatewayTLSRoute", "parentRefs"),
+					"at least 1 parentRef is required",
+				),
+			},
+		},
+		"acme solver with both http01 and tlsALPN01 config": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				Solvers: []cmacme.ACMEChallengeSolver{
+					{
+						HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+							Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+						},
+						TLSALPN01: &cmacme.ACMEChallengeSolverTLSALPN01{},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("solvers").Index(0), "may not specify more than one solver type in a single solver"),
+			},
+		},
 		"acme issue with valid pod template ObjectMeta attributes": {
 			spec: &cmacme.ACMEIssuer{
 				Email:      "valid-email",
@@ -804,6 +1024,32 @@ func TestValidateIssuerSpec(t *testing.T) {
 			},
 			errs: []*field.Error{},
 		},
+		"valid issuer with renewal window": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				RenewalWindow: &cmapi.CertificateRenewalWindow{
+					Schedule: "0 2 * * sat",
+					Duration: metav1.Duration{Duration: time.Hour},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"invalid renewal window": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				RenewalWindow: &cmapi.CertificateRenewalWindow{
+					Schedule: "every saturday",
+					Duration: metav1.Duration{Duration: time.Hour},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("renewalWindow", "schedule"), "every saturday", `expected 5 fields, found 2: "every saturday"`),
+			},
+		},
 		"invalid IssuingCertificateURLs": {
 			spec: &cmapi.IssuerSpec{
 				IssuerConfig: cmapi.IssuerConfig{
@@ -914,6 +1160,36 @@ func TestValidateACMEIssuerHTTP01Config(t *testing.T) {
 				field.Invalid(fldPath.Child("ingress", "serviceType"), corev1.ServiceType("InvalidServiceType"), `must be empty, "ClusterIP" or "NodePort"`),
 			},
 		},
+		"valid self check perspectives": {
+			cfg: &cmacme.ACMEChallengeSolverHTTP01{
+				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+				SelfCheck: &cmacme.ACMEChallengeSolverHTTP01SelfCheck{
+					Perspectives: []cmacme.ACMEChallengeSolverHTTP01SelfCheckPerspective{
+						{Name: "eu", ProxyURL: "http://proxy.eu.example.com:3128"},
+						{Name: "us", SourceAddress: "10.0.0.1"},
+					},
+					Quorum: ptr.To(int32(1)),
+				},
+			},
+		},
+		"invalid self check perspectives": {
+			cfg: &cmacme.ACMEChallengeSolverHTTP01{
+				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+				SelfCheck: &cmacme.ACMEChallengeSolverHTTP01SelfCheck{
+					Perspectives: []cmacme.ACMEChallengeSolverHTTP01SelfCheckPerspective{
+						{Name: "eu", ProxyURL: "proxy"},
+						{Name: "eu", SourceAddress: "not-an-ip"},
+					},
+					Quorum: ptr.To(int32(3)),
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("selfCheck", "perspectives").Index(0).Child("proxyURL"), "proxy", "must be a valid URL, e.g. http://proxy.example.com:3128"),
+				field.Invalid(fldPath.Child("selfCheck", "perspectives").Index(1).Child("sourceAddress"), "not-an-ip", "must be a valid IP address"),
+				field.Duplicate(fldPath.Child("selfCheck", "perspectives").Index(1).Child("name"), "eu"),
+				field.Invalid(fldPath.Child("selfCheck", "quorum"), int32(3), "must be between 1 and the number of perspectives (2)"),
+			},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -1503,6 +1779,114 @@ func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 				field.Forbidden(fldPath.Child("cloudflare"), "may not specify more than one provider type"),
 			},
 		},
+		"valid manual provider": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Manual: &cmacme.ACMEIssuerDNS01ProviderManual{
+					Timeout: &metav1.Duration{Duration: time.Hour},
+				},
+			},
+		},
+		"manual provider with an invalid timeout": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Manual: &cmacme.ACMEIssuerDNS01ProviderManual{
+					Timeout: &metav1.Duration{},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("manual", "timeout"), "0s", "must be greater than zero"),
+			},
+		},
+		"manual provider configured together with another provider": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				CloudDNS: &cmacme.ACMEIssuerDNS01ProviderCloudDNS{
+					Project: "something",
+				},
+				Manual: &cmacme.ACMEIssuerDNS01ProviderManual{},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("manual"), "may not specify more than one provider type"),
+			},
+		},
+		"valid embedded provider": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Embedded: &cmacme.ACMEIssuerDNS01ProviderEmbedded{},
+			},
+		},
+		"valid self check configuration": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				CloudDNS: &cmacme.ACMEIssuerDNS01ProviderCloudDNS{
+					Project: "valid",
+				},
+				SelfCheck: &cmacme.ACMEChallengeSolverDNS01SelfCheck{
+					RecursiveNameservers:     []string{"10.0.0.53:53", "https://dns.example.com/dns-query"},
+					RecursiveNameserversOnly: ptr.To(true),
+					PropagationTimeout:       &metav1.Duration{Duration: 10 * time.Minute},
+				},
+			},
+		},
+		"invalid self check configuration": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				CloudDNS: &cmacme.ACMEIssuerDNS01ProviderCloudDNS{
+					Project: "valid",
+				},
+				SelfCheck: &cmacme.ACMEChallengeSolverDNS01SelfCheck{
+					RecursiveNameservers: []string{"10.0.0.53", "https://"},
+					PropagationTimeout:   &metav1.Duration{},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("selfCheck", "recursiveNameservers").Index(0), "10.0.0.53", "must be in the format <ip address>:<port> or https://<DoH RFC 8484 server address>"),
+				field.Invalid(fldPath.Child("selfCheck", "recursiveNameservers").Index(1), "https://", "must be in the format https://<DoH RFC 8484 server address>"),
+				field.Invalid(fldPath.Child("selfCheck", "propagationTimeout"), "0s", "must be greater than zero"),
+			},
+		},
+		"valid self check perspectives": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				CloudDNS: &cmacme.ACMEIssuerDNS01ProviderCloudDNS{
+					Project: "valid",
+				},
+				SelfCheck: &cmacme.ACMEChallengeSolverDNS01SelfCheck{
+					Perspectives: []cmacme.ACMEChallengeSolverDNS01SelfCheckPerspective{
+						{Name: "google", RecursiveNameservers: []string{"8.8.8.8:53"}},
+						{Name: "cloudflare", RecursiveNameservers: []string{"https://1.1.1.1/dns-query"}},
+					},
+					Quorum: ptr.To(int32(2)),
+				},
+			},
+		},
+		"invalid self check perspectives": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				CloudDNS: &cmacme.ACMEIssuerDNS01ProviderCloudDNS{
+					Project: "valid",
+				},
+				SelfCheck: &cmacme.ACMEChallengeSolverDNS01SelfCheck{
+					Perspectives: []cmacme.ACMEChallengeSolverDNS01SelfCheckPerspective{
+						{RecursiveNameservers: []string{"8.8.8.8"}},
+						{Name: "cloudflare"},
+					},
+					Quorum: ptr.To(int32(0)),
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("selfCheck", "perspectives").Index(0).Child("recursiveNameservers").Index(0), "8.8.8.8", "must be in the format <ip address>:<port> or https://<DoH RFC 8484 server address>"),
+				field.Required(fldPath.Child("selfCheck", "perspectives").Index(1).Child("recursiveNameservers"), ""),
+				field.Required(fldPath.Child("selfCheck", "perspectives").Index(0).Child("name"), ""),
+				field.Invalid(fldPath.Child("selfCheck", "quorum"), int32(0), "must be between 1 and the number of perspectives (2)"),
+			},
+		},
+		"self check quorum without perspectives": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				CloudDNS: &cmacme.ACMEIssuerDNS01ProviderCloudDNS{
+					Project: "valid",
+				},
+				SelfCheck: &cmacme.ACMEChallengeSolverDNS01SelfCheck{
+					Quorum: ptr.To(int32(1)),
+				},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("selfCheck", "quorum"), "may only be set if perspectives are configured"),
+			},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -1710,7 +2094,29 @@ func TestValidateIssuer(t *testing.T) {
 		a         *admissionv1.AdmissionRequest
 		expectedE []*field.Error
 		expectedW []string
-	}{}
+	}{
+		"embedded DNS01 provider is forbidden for Issuers": {
+			cfg: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: cmapi.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Email:      "valid-email",
+							Server:     "valid-server",
+							PrivateKey: validSecretKeyRef,
+							Solvers: []cmacme.ACMEChallengeSolver{{
+								DNS01: &cmacme.ACMEChallengeSolverDNS01{
+									Embedded: &cmacme.ACMEIssuerDNS01ProviderEmbedded{},
+								},
+							}},
+						},
+					},
+				},
+			},
+			expectedE: []*field.Error{
+				field.Forbidden(field.NewPath("spec", "acme", "solvers").Index(0).Child("dns01", "embedded"), "the embedded DNS01 provider may only be used by ClusterIssuers"),
+			},
+		},
+	}
 
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
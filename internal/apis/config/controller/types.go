@@ -81,6 +81,26 @@ type ControllerConfiguration struct {
 	// automatically removed when the certificate resource is deleted.
 	EnableCertificateOwnerRef bool
 
+	// The default window within which the renewals of Certificates that do
+	// not set spec.renewalJitter are spread. The renewal time of each
+	// certificate is moved earlier by a stable offset between zero and the
+	// jitter. A value of 0 disables the jitter.
+	CertificateRenewalJitter time.Duration
+
+	// The maximum average number of Certificate renewals per second that the
+	// controller triggers. Renewals beyond this rate are delayed. A value of 0
+	// means that renewals are not rate limited.
+	CertificateRenewalQPS float32
+
+	// The maximum number of Certificate renewals that the controller triggers
+	// at once when renewals are rate limited.
+	CertificateRenewalBurst int
+
+	// The maximum number of issued certificates recorded in the
+	// status.history of each Certificate. The oldest entries are removed
+	// first. A value of 0 disables the issuance history.
+	CertificateHistoryLimit int
+
 	// Whether gateway API integration is enabled within cert-manager. The
 	// ExperimentalGatewayAPISupport feature gate must also be enabled (default
 	// as of 1.15).
@@ -100,6 +120,17 @@ type ControllerConfiguration struct {
 	// The maximum number of challenges that can be scheduled as 'processing' at once.
 	MaxConcurrentChallenges int
 
+	// The maximum number of challenges in a single namespace that can be
+	// scheduled as 'processing' at once. A value of 0 means that the number
+	// of challenges per namespace is only limited by MaxConcurrentChallenges.
+	MaxConcurrentChallengesPerNamespace int
+
+	// The scheduling weight of each namespace, which sets the share of the
+	// challenges scheduled as 'processing' that the namespace gets when
+	// challenges of multiple namespaces are waiting. Namespaces that are not
+	// listed have a weight of 1.
+	ChallengeNamespaceWeights map[string]int64
+
 	// The host and port that the metrics endpoint should listen on.
 	MetricsListenAddress string
 
@@ -193,6 +224,17 @@ type ACMEHTTP01Config struct {
 	// port, for example ["8.8.8.8:53","8.8.4.4:53"]
 	// Allows specifying a list of custom nameservers to perform HTTP01 checks on.
 	SolverNameservers []string
+
+	// The namespace and name of the Service, in the form <namespace>/<name>,
+	// of a shared, long-running acmesolver Deployment which serves all pending
+	// ACME HTTP01 challenges. When set, challenges solved using an Ingress are
+	// routed to this Service through a single Ingress per ingress class and
+	// ingress template instead of creating a solver pod, Service and Ingress
+	// per challenge. Challenges solved using a Gateway API HTTPRoute, or by
+	// editing an existing Ingress, are not supported by the shared solver and
+	// always use a dedicated solver pod and Service. A SharedSolverNotUsed
+	// event is recorded on such challenges.
+	SharedSolverService string
 }
 
 type ACMEDNS01Config struct {
@@ -218,4 +260,22 @@ type ACMEDNS01Config struct {
 	// token is served at the challenge URL. This should be a valid duration
 	// string, for example 180s or 1h
 	CheckRetryPeriod time.Duration
+
+	// The address, for example ":5353", on which the authoritative DNS server
+	// embedded in the controller listens for DNS requests over UDP and TCP.
+	// The embedded DNS server serves the TXT records of DNS01 challenges
+	// solved by the embedded DNS01 provider. It is disabled if not set.
+	EmbeddedServerAddress string
+
+	// The fully qualified domain name under which the embedded DNS server is
+	// reachable. It is used in the SOA and NS records served by the embedded
+	// DNS server, and must be set if the embedded DNS server is enabled.
+	EmbeddedServerNameserver string
+
+	// The DNS zones delegated to the embedded DNS server, e.g. a zone that
+	// the `_acme-challenge` records of several domains are CNAMEs to, or an
+	// `_acme-challenge` record delegated to it individually using an NS
+	// record. The embedded DNS server only serves the records of challenges
+	// of ClusterIssuers within these zones, and refuses all other queries.
+	EmbeddedServerZones []string
 }
@@ -40,8 +40,10 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/keymanager"
 	certificatesmetricscontroller "github.com/cert-manager/cert-manager/pkg/controller/certificates/metrics"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/readiness"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/renewalinfo"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/requestmanager"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/revisionmanager"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/revocation"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/trigger"
 	csracmecontroller "github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/acme"
 	csrcacontroller "github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/ca"
@@ -74,6 +76,11 @@ var (
 	defaultEnableCertificateOwnerRef = false
 	defaultEnableGatewayAPI          = false
 
+	defaultCertificateRenewalJitter         = time.Duration(0)
+	defaultCertificateRenewalQPS    float32 = 0
+	defaultCertificateRenewalBurst  int32   = 10
+	defaultCertificateHistoryLimit  int32   = 10
+
 	defaultDNS01RecursiveNameserversOnly = false
 	defaultDNS01RecursiveNameservers     = []string{}
 	defaultDNS01CheckRetryPeriod         = 10 * time.Second
@@ -81,6 +88,8 @@ var (
 	defaultNumberOfConcurrentWorkers int32 = 5
 	defaultMaxConcurrentChallenges   int32 = 60
 
+	defaultMaxConcurrentChallengesPerNamespace int32 = 0
+
 	defaultPrometheusMetricsServerAddress = "0.0.0.0:9402"
 
 	defaultHealthzServerAddress = "0.0.0.0:9403"
@@ -121,6 +130,8 @@ var (
 		requestmanager.ControllerName,
 		readiness.ControllerName,
 		revisionmanager.ControllerName,
+		renewalinfo.ControllerName,
+		revocation.ControllerName,
 	}
 
 	DefaultEnabledControllers = []string{
@@ -143,6 +154,9 @@ var (
 		requestmanager.ControllerName,
 		readiness.ControllerName,
 		revisionmanager.ControllerName,
+		// the revocation controller only removes its finalizer unless the
+		// CertificateRevocation feature gate is enabled
+		revocation.ControllerName,
 	}
 
 	ExperimentalCertificateSigningRequestControllers = []string{
@@ -208,6 +222,22 @@ func SetDefaults_ControllerConfiguration(obj *v1alpha1.ControllerConfiguration)
 		obj.EnableCertificateOwnerRef = &defaultEnableCertificateOwnerRef
 	}
 
+	if obj.CertificateRenewalJitter == nil {
+		obj.CertificateRenewalJitter = sharedv1alpha1.DurationFromTime(defaultCertificateRenewalJitter)
+	}
+
+	if obj.CertificateRenewalQPS == nil {
+		obj.CertificateRenewalQPS = &defaultCertificateRenewalQPS
+	}
+
+	if obj.CertificateRenewalBurst == nil {
+		obj.CertificateRenewalBurst = &defaultCertificateRenewalBurst
+	}
+
+	if obj.CertificateHistoryLimit == nil {
+		obj.CertificateHistoryLimit = &defaultCertificateHistoryLimit
+	}
+
 	if obj.EnableGatewayAPI == nil {
 		obj.EnableGatewayAPI = &defaultEnableGatewayAPI
 	}
@@ -224,6 +254,10 @@ func SetDefaults_ControllerConfiguration(obj *v1alpha1.ControllerConfiguration)
 		obj.MaxConcurrentChallenges = &defaultMaxConcurrentChallenges
 	}
 
+	if obj.MaxConcurrentChallengesPerNamespace == nil {
+		obj.MaxConcurrentChallengesPerNamespace = &defaultMaxConcurrentChallengesPerNamespace
+	}
+
 	if obj.MetricsListenAddress == "" {
 		obj.MetricsListenAddress = defaultPrometheusMetricsServerAddress
 	}
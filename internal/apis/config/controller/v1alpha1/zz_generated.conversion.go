@@ -100,6 +100,9 @@ func autoConvert_v1alpha1_ACMEDNS01Config_To_controller_ACMEDNS01Config(in *v1al
 	if err := sharedv1alpha1.Convert_Pointer_v1alpha1_Duration_To_time_Duration(&in.CheckRetryPeriod, &out.CheckRetryPeriod, s); err != nil {
 		return err
 	}
+	out.EmbeddedServerAddress = in.EmbeddedServerAddress
+	out.EmbeddedServerNameserver = in.EmbeddedServerNameserver
+	out.EmbeddedServerZones = *(*[]string)(unsafe.Pointer(&in.EmbeddedServerZones))
 	return nil
 }
 
@@ -116,6 +119,9 @@ func autoConvert_controller_ACMEDNS01Config_To_v1alpha1_ACMEDNS01Config(in *cont
 	if err := sharedv1alpha1.Convert_time_Duration_To_Pointer_v1alpha1_Duration(&in.CheckRetryPeriod, &out.CheckRetryPeriod, s); err != nil {
 		return err
 	}
+	out.EmbeddedServerAddress = in.EmbeddedServerAddress
+	out.EmbeddedServerNameserver = in.EmbeddedServerNameserver
+	out.EmbeddedServerZones = *(*[]string)(unsafe.Pointer(&in.EmbeddedServerZones))
 	return nil
 }
 
@@ -134,6 +140,7 @@ func autoConvert_v1alpha1_ACMEHTTP01Config_To_controller_ACMEHTTP01Config(in *v1
 		return err
 	}
 	out.SolverNameservers = *(*[]string)(unsafe.Pointer(&in.SolverNameservers))
+	out.SharedSolverService = in.SharedSolverService
 	return nil
 }
 
@@ -152,6 +159,7 @@ func autoConvert_controller_ACMEHTTP01Config_To_v1alpha1_ACMEHTTP01Config(in *co
 		return err
 	}
 	out.SolverNameservers = *(*[]string)(unsafe.Pointer(&in.SolverNameservers))
+	out.SharedSolverService = in.SharedSolverService
 	return nil
 }
 
@@ -184,6 +192,18 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_controller_ControllerConfig
 	if err := v1.Convert_Pointer_bool_To_bool(&in.EnableCertificateOwnerRef, &out.EnableCertificateOwnerRef, s); err != nil {
 		return err
 	}
+	if err := sharedv1alpha1.Convert_Pointer_v1alpha1_Duration_To_time_Duration(&in.CertificateRenewalJitter, &out.CertificateRenewalJitter, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_float32_To_float32(&in.CertificateRenewalQPS, &out.CertificateRenewalQPS, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.CertificateRenewalBurst, &out.CertificateRenewalBurst, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.CertificateHistoryLimit, &out.CertificateHistoryLimit, s); err != nil {
+		return err
+	}
 	if err := v1.Convert_Pointer_bool_To_bool(&in.EnableGatewayAPI, &out.EnableGatewayAPI, s); err != nil {
 		return err
 	}
@@ -194,6 +214,10 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_controller_ControllerConfig
 	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.MaxConcurrentChallenges, &out.MaxConcurrentChallenges, s); err != nil {
 		return err
 	}
+	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.MaxConcurrentChallengesPerNamespace, &out.MaxConcurrentChallengesPerNamespace, s); err != nil {
+		return err
+	}
+	out.ChallengeNamespaceWeights = *(*map[string]int64)(unsafe.Pointer(&in.ChallengeNamespaceWeights))
 	out.MetricsListenAddress = in.MetricsListenAddress
 	if err := sharedv1alpha1.Convert_v1alpha1_TLSConfig_To_shared_TLSConfig(&in.MetricsTLSConfig, &out.MetricsTLSConfig, s); err != nil {
 		return err
@@ -246,6 +270,18 @@ func autoConvert_controller_ControllerConfiguration_To_v1alpha1_ControllerConfig
 	if err := v1.Convert_bool_To_Pointer_bool(&in.EnableCertificateOwnerRef, &out.EnableCertificateOwnerRef, s); err != nil {
 		return err
 	}
+	if err := sharedv1alpha1.Convert_time_Duration_To_Pointer_v1alpha1_Duration(&in.CertificateRenewalJitter, &out.CertificateRenewalJitter, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_float32_To_Pointer_float32(&in.CertificateRenewalQPS, &out.CertificateRenewalQPS, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.CertificateRenewalBurst, &out.CertificateRenewalBurst, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.CertificateHistoryLimit, &out.CertificateHistoryLimit, s); err != nil {
+		return err
+	}
 	if err := v1.Convert_bool_To_Pointer_bool(&in.EnableGatewayAPI, &out.EnableGatewayAPI, s); err != nil {
 		return err
 	}
@@ -256,6 +292,10 @@ func autoConvert_controller_ControllerConfiguration_To_v1alpha1_ControllerConfig
 	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.MaxConcurrentChallenges, &out.MaxConcurrentChallenges, s); err != nil {
 		return err
 	}
+	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.MaxConcurrentChallengesPerNamespace, &out.MaxConcurrentChallengesPerNamespace, s); err != nil {
+		return err
+	}
+	out.ChallengeNamespaceWeights = *(*map[string]int64)(unsafe.Pointer(&in.ChallengeNamespaceWeights))
 	out.MetricsListenAddress = in.MetricsListenAddress
 	if err := sharedv1alpha1.Convert_shared_TLSConfig_To_v1alpha1_TLSConfig(&in.MetricsTLSConfig, &out.MetricsTLSConfig, s); err != nil {
 		return err
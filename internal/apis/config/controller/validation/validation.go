@@ -21,6 +21,7 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/miekg/dns"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	logsapi "k8s.io/component-base/logs/api/v1"
@@ -57,6 +58,32 @@ func ValidateControllerConfiguration(cfg *config.ControllerConfiguration, fldPat
 		allErrors = append(allErrors, field.Invalid(fldPath.Child("kubernetesAPIBurst"), cfg.KubernetesAPIBurst, "must be higher or equal to kubernetesAPIQPS"))
 	}
 
+	if cfg.CertificateRenewalJitter < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("certificateRenewalJitter"), cfg.CertificateRenewalJitter, "must not be negative"))
+	}
+
+	if cfg.CertificateRenewalQPS < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("certificateRenewalQPS"), cfg.CertificateRenewalQPS, "must not be negative"))
+	}
+
+	if cfg.CertificateRenewalQPS > 0 && cfg.CertificateRenewalBurst <= 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("certificateRenewalBurst"), cfg.CertificateRenewalBurst, "must be higher than 0 if certificateRenewalQPS is set"))
+	}
+
+	if cfg.CertificateHistoryLimit < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("certificateHistoryLimit"), cfg.CertificateHistoryLimit, "must not be negative"))
+	}
+
+	if cfg.MaxConcurrentChallengesPerNamespace < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("maxConcurrentChallengesPerNamespace"), cfg.MaxConcurrentChallengesPerNamespace, "must not be negative"))
+	}
+
+	for namespace, weight := range cfg.ChallengeNamespaceWeights {
+		if weight < 1 || weight > 100 {
+			allErrors = append(allErrors, field.Invalid(fldPath.Child("challengeNamespaceWeights").Key(namespace), weight, "must be between 1 and 100"))
+		}
+	}
+
 	for i, server := range cfg.ACMEHTTP01Config.SolverNameservers {
 		// ensure all servers have a port number
 		_, _, err := net.SplitHostPort(server)
@@ -65,6 +92,12 @@ func ValidateControllerConfiguration(cfg *config.ControllerConfiguration, fldPat
 		}
 	}
 
+	if svc := cfg.ACMEHTTP01Config.SharedSolverService; svc != "" {
+		if namespace, name, ok := strings.Cut(svc, "/"); !ok || namespace == "" || name == "" || strings.Contains(name, "/") {
+			allErrors = append(allErrors, field.Invalid(fldPath.Child("acmeHTTP01Config").Child("sharedSolverService"), svc, "must be in the format <namespace>/<name>"))
+		}
+	}
+
 	for i, server := range cfg.ACMEDNS01Config.RecursiveNameservers {
 		// ensure all servers follow one of the following formats:
 		// - <ip address>:<port>
@@ -81,6 +114,25 @@ func ValidateControllerConfiguration(cfg *config.ControllerConfiguration, fldPat
 		}
 	}
 
+	if addr := cfg.ACMEDNS01Config.EmbeddedServerAddress; addr != "" {
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			allErrors = append(allErrors, field.Invalid(fldPath.Child("acmeDNS01Config").Child("embeddedServerAddress"), addr, "must be in the format <host>:<port>"))
+		}
+		if cfg.ACMEDNS01Config.EmbeddedServerNameserver == "" {
+			allErrors = append(allErrors, field.Required(fldPath.Child("acmeDNS01Config").Child("embeddedServerNameserver"), "must be set if the embedded DNS server is enabled"))
+		}
+	}
+	if ns := cfg.ACMEDNS01Config.EmbeddedServerNameserver; ns != "" {
+		if _, ok := dns.IsDomainName(ns); !ok {
+			allErrors = append(allErrors, field.Invalid(fldPath.Child("acmeDNS01Config").Child("embeddedServerNameserver"), ns, "must be a valid domain name"))
+		}
+	}
+	for i, zone := range cfg.ACMEDNS01Config.EmbeddedServerZones {
+		if _, ok := dns.IsDomainName(zone); !ok {
+			allErrors = append(allErrors, field.Invalid(fldPath.Child("acmeDNS01Config").Child("embeddedServerZones").Index(i), zone, "must be a valid domain name"))
+		}
+	}
+
 	allControllersSet := sets.NewString(defaults.AllControllers...)
 	for i, controller := range cfg.Controllers {
 		if controller == "*" {
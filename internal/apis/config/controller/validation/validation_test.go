@@ -262,6 +262,165 @@ func TestValidateControllerConfiguration(t *testing.T) {
 				}
 			},
 		},
+		{
+			"with valid acme http01 shared solver service",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+				ACMEHTTP01Config: config.ACMEHTTP01Config{
+					SharedSolverService: "cert-manager/cert-manager-acmesolver",
+				},
+			},
+			nil,
+		},
+		{
+			"with invalid acme http01 shared solver service",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+				ACMEHTTP01Config: config.ACMEHTTP01Config{
+					SharedSolverService: "cert-manager-acmesolver",
+				},
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("acmeHTTP01Config.sharedSolverService"), cc.ACMEHTTP01Config.SharedSolverService, "must be in the format <namespace>/<name>"),
+				}
+			},
+		},
+		{
+			"with invalid certificate renewal configuration",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:       1,
+				KubernetesAPIQPS:         1,
+				CertificateRenewalJitter: -time.Minute,
+				CertificateRenewalQPS:    1,
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("certificateRenewalJitter"), -time.Minute, "must not be negative"),
+					field.Invalid(field.NewPath("certificateRenewalBurst"), 0, "must be higher than 0 if certificateRenewalQPS is set"),
+				}
+			},
+		},
+		{
+			"with negative certificate history limit",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:      1,
+				KubernetesAPIQPS:        1,
+				CertificateHistoryLimit: -1,
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("certificateHistoryLimit"), -1, "must not be negative"),
+				}
+			},
+		},
+		{
+			"with negative max concurrent challenges per namespace",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:                  1,
+				KubernetesAPIQPS:                    1,
+				MaxConcurrentChallengesPerNamespace: -1,
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("maxConcurrentChallengesPerNamespace"), -1, "must not be negative"),
+				}
+			},
+		},
+		{
+			"with invalid challenge namespace weight",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:        1,
+				KubernetesAPIQPS:          1,
+				ChallengeNamespaceWeights: map[string]int64{"prod": 10, "dev": 0},
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("challengeNamespaceWeights").Key("dev"), int64(0), "must be between 1 and 100"),
+				}
+			},
+		},
+		{
+			"with valid embedded dns01 server configuration",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+				ACMEDNS01Config: config.ACMEDNS01Config{
+					EmbeddedServerAddress:    ":5353",
+					EmbeddedServerNameserver: "ns.acme.example.com",
+					EmbeddedServerZones:      []string{"acme.example.com"},
+				},
+			},
+			nil,
+		},
+		{
+			"with invalid embedded dns01 server configuration",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+				ACMEDNS01Config: config.ACMEDNS01Config{
+					EmbeddedServerAddress: "5353",
+					EmbeddedServerZones:   []string{"acme..example.com"},
+				},
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("acmeDNS01Config.embeddedServerAddress"), "5353", "must be in the format <host>:<port>"),
+					field.Required(field.NewPath("acmeDNS01Config.embeddedServerNameserver"), "must be set if the embedded DNS server is enabled"),
+					field.Invalid(field.NewPath("acmeDNS01Config.embeddedServerZones[0]"), "acme..example.com", "must be a valid domain name"),
+				}
+			},
+		},
 		{
 			"with valid acme dns recursive nameservers",
 			&config.ControllerConfiguration{
@@ -33,6 +33,11 @@ func (in *ACMEDNS01Config) DeepCopyInto(out *ACMEDNS01Config) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.EmbeddedServerZones != nil {
+		in, out := &in.EmbeddedServerZones, &out.EmbeddedServerZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -82,6 +87,13 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ChallengeNamespaceWeights != nil {
+		in, out := &in.ChallengeNamespaceWeights, &out.ChallengeNamespaceWeights
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	in.MetricsTLSConfig.DeepCopyInto(&out.MetricsTLSConfig)
 	in.Logging.DeepCopyInto(&out.Logging)
 	if in.FeatureGates != nil {
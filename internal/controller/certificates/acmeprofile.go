@@ -0,0 +1,76 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"context"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// IssuerForCertificate returns the cert-manager Issuer or ClusterIssuer
+// referenced by the given Certificate.
+// It returns nil if helper is nil, if the Certificate references an external
+// issuer or if the issuer cannot be found. A missing issuer is reported by the
+// issuing controllers, as the Certificate cannot be issued until it exists.
+func IssuerForCertificate(ctx context.Context, helper issuer.Helper, crt *cmapi.Certificate) cmapi.GenericIssuer {
+	if helper == nil {
+		return nil
+	}
+	if group := crt.Spec.IssuerRef.Group; group != "" && group != certmanager.GroupName {
+		return nil
+	}
+
+	iss, err := helper.GetGenericIssuer(crt.Spec.IssuerRef, crt.Namespace)
+	if err != nil {
+		logf.FromContext(ctx).V(logf.DebugLevel).Info("Failed to look up issuer", "error", err)
+		return nil
+	}
+	return iss
+}
+
+// ACMEProfile returns the ACME certificate profile to request for a
+// Certificate with the given spec. The Certificate's own profile takes
+// precedence over the profile of its ACME issuer. iss may be nil.
+func ACMEProfile(spec cmapi.CertificateSpec, iss cmapi.GenericIssuer) string {
+	if spec.ACME != nil && spec.ACME.Profile != "" {
+		return spec.ACME.Profile
+	}
+	if iss == nil || iss.GetSpec().ACME == nil {
+		return ""
+	}
+	return iss.GetSpec().ACME.Profile
+}
+
+// ACMEProfileViolations returns a list of violations if the ACME profile
+// recorded on the given CertificateRequest does not match the profile that
+// would be requested for the given Certificate spec and issuer.
+// If the issuer is not known, only a profile set on the Certificate itself is
+// compared.
+func ACMEProfileViolations(req *cmapi.CertificateRequest, spec cmapi.CertificateSpec, iss cmapi.GenericIssuer) []string {
+	if iss == nil && (spec.ACME == nil || spec.ACME.Profile == "") {
+		return nil
+	}
+	if req.Annotations[cmacme.ACMEProfileAnnotationKey] != ACMEProfile(spec, iss) {
+		return []string{"spec.acme.profile"}
+	}
+	return nil
+}
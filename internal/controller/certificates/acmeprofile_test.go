@@ -0,0 +1,89 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestACMEProfileViolations(t *testing.T) {
+	issuer := func(profile string) cmapi.GenericIssuer {
+		return &cmapi.Issuer{Spec: cmapi.IssuerSpec{IssuerConfig: cmapi.IssuerConfig{
+			ACME: &cmacme.ACMEIssuer{Profile: profile},
+		}}}
+	}
+
+	tests := map[string]struct {
+		annotations map[string]string
+		acme        *cmapi.CertificateACMEOptions
+		issuer      cmapi.GenericIssuer
+		violations  []string
+	}{
+		"should match if no profile is requested": {},
+		"should match if the requested profile is unchanged": {
+			annotations: map[string]string{cmacme.ACMEProfileAnnotationKey: "shortlived"},
+			acme:        &cmapi.CertificateACMEOptions{Profile: "shortlived"},
+		},
+		"should not match if the profile has changed": {
+			annotations: map[string]string{cmacme.ACMEProfileAnnotationKey: "classic"},
+			acme:        &cmapi.CertificateACMEOptions{Profile: "shortlived"},
+			violations:  []string{"spec.acme.profile"},
+		},
+		"should not match if a profile has been set": {
+			acme:       &cmapi.CertificateACMEOptions{Profile: "shortlived"},
+			violations: []string{"spec.acme.profile"},
+		},
+		"should not match if the profile has been removed and the issuer has no profile": {
+			annotations: map[string]string{cmacme.ACMEProfileAnnotationKey: "shortlived"},
+			issuer:      issuer(""),
+			violations:  []string{"spec.acme.profile"},
+		},
+		"should match if the profile has been removed but the issuer is not known": {
+			annotations: map[string]string{cmacme.ACMEProfileAnnotationKey: "shortlived"},
+		},
+		"should match if the issuer profile is unchanged": {
+			annotations: map[string]string{cmacme.ACMEProfileAnnotationKey: "classic"},
+			issuer:      issuer("classic"),
+		},
+		"should not match if the issuer profile has changed": {
+			annotations: map[string]string{cmacme.ACMEProfileAnnotationKey: "classic"},
+			issuer:      issuer("shortlived"),
+			violations:  []string{"spec.acme.profile"},
+		},
+		"should prefer the Certificate profile over the issuer profile": {
+			annotations: map[string]string{cmacme.ACMEProfileAnnotationKey: "shortlived"},
+			acme:        &cmapi.CertificateACMEOptions{Profile: "shortlived"},
+			issuer:      issuer("classic"),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			violations := ACMEProfileViolations(
+				&cmapi.CertificateRequest{ObjectMeta: metav1.ObjectMeta{Annotations: test.annotations}},
+				cmapi.CertificateSpec{ACME: test.acme},
+				test.issuer,
+			)
+			assert.Equal(t, test.violations, violations)
+		})
+	}
+}
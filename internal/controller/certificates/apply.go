@@ -54,18 +54,16 @@ func Apply(ctx context.Context, cl cmclient.Interface, fieldManager string, crt
 // object is dropped; expect for the name, namespace, and status object. The
 // given fieldManager is will be used as the FieldManager in the Patch call.
 // Always sets Force Patch to true.
-func ApplyStatus(ctx context.Context, cl cmclient.Interface, fieldManager string, crt *cmapi.Certificate) error {
+func ApplyStatus(ctx context.Context, cl cmclient.Interface, fieldManager string, crt *cmapi.Certificate) (*cmapi.Certificate, error) {
 	crtData, err := serializeApplyStatus(crt)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = cl.CertmanagerV1().Certificates(crt.Namespace).Patch(
+	return cl.CertmanagerV1().Certificates(crt.Namespace).Patch(
 		ctx, crt.Name, apitypes.ApplyPatchType, crtData,
 		metav1.PatchOptions{Force: ptr.To(true), FieldManager: fieldManager}, "status",
 	)
-
-	return err
 }
 
 // serializeApply converts the given Certificate object in JSON.
@@ -0,0 +1,99 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// IssuanceForCertificateRequest returns a record of the issuance of the
+// certificate signed for the given CertificateRequest, which is stored in the
+// Certificate's Secret at issuanceTime.
+func IssuanceForCertificateRequest(req *cmapi.CertificateRequest, revision int, issuanceTime time.Time) (*cmapi.CertificateIssuance, error) {
+	cert, err := pki.DecodeX509CertificateBytes(req.Status.Certificate)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprint := sha256.Sum256(cert.Raw)
+	return &cmapi.CertificateIssuance{
+		Revision:           revision,
+		CertificateRequest: req.Name,
+		IssuanceTime:       metav1.NewTime(issuanceTime.Truncate(time.Second)),
+		SerialNumber:       cert.SerialNumber.Text(16),
+		SHA256Fingerprint:  hex.EncodeToString(fingerprint[:]),
+		Issuer:             cert.Issuer.String(),
+		KeyAlgorithm:       keyAlgorithmForCertificate(cert),
+	}, nil
+}
+
+// AppendIssuance appends issuance to the given issuance history, removing the
+// oldest issuances if the history grows beyond limit. A limit of 0 or less
+// disables the history. Issuances of certificates that are already recorded
+// in the history are not appended again, so that an issuance can be recorded
+// more than once without duplicating it.
+func AppendIssuance(history []cmapi.CertificateIssuance, issuance cmapi.CertificateIssuance, limit int) []cmapi.CertificateIssuance {
+	if limit <= 0 {
+		return nil
+	}
+	if !HistoryContainsFingerprint(history, issuance.SHA256Fingerprint) {
+		history = append(history, issuance)
+	}
+	if len(history) > limit {
+		history = history[len(history)-limit:]
+	}
+	return history
+}
+
+// HistoryContainsCertificate returns true if the issuance of the given
+// certificate is recorded in the issuance history.
+func HistoryContainsCertificate(history []cmapi.CertificateIssuance, cert *x509.Certificate) bool {
+	fingerprint := sha256.Sum256(cert.Raw)
+	return HistoryContainsFingerprint(history, hex.EncodeToString(fingerprint[:]))
+}
+
+// HistoryContainsFingerprint returns true if an issuance of a certificate
+// with the given SHA-256 fingerprint is recorded in the issuance history.
+func HistoryContainsFingerprint(history []cmapi.CertificateIssuance, fingerprint string) bool {
+	for _, issuance := range history {
+		if issuance.SHA256Fingerprint == fingerprint {
+			return true
+		}
+	}
+	return false
+}
+
+func keyAlgorithmForCertificate(cert *x509.Certificate) cmapi.PrivateKeyAlgorithm {
+	switch cert.PublicKeyAlgorithm {
+	case x509.RSA:
+		return cmapi.RSAKeyAlgorithm
+	case x509.ECDSA:
+		return cmapi.ECDSAKeyAlgorithm
+	case x509.Ed25519:
+		return cmapi.Ed25519KeyAlgorithm
+	default:
+		return ""
+	}
+}
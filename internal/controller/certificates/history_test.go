@@ -0,0 +1,136 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestIssuanceForCertificateRequest(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 500, time.UTC)
+	bundle := testcrypto.MustCreateCryptoBundle(t, gen.Certificate("test",
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateKeyAlgorithm(cmapi.ECDSAKeyAlgorithm),
+	), fakeclock.NewFakeClock(now))
+
+	issuance, err := IssuanceForCertificateRequest(bundle.CertificateRequestReady, 3, now)
+	require.NoError(t, err)
+	assert.Equal(t, &cmapi.CertificateIssuance{
+		Revision:           3,
+		CertificateRequest: bundle.CertificateRequestReady.Name,
+		IssuanceTime:       metav1.NewTime(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)),
+		SerialNumber:       bundle.Cert.SerialNumber.Text(16),
+		SHA256Fingerprint:  fmt.Sprintf("%x", sha256.Sum256(bundle.Cert.Raw)),
+		Issuer:             bundle.Cert.Issuer.String(),
+		KeyAlgorithm:       cmapi.ECDSAKeyAlgorithm,
+	}, issuance)
+
+	_, err = IssuanceForCertificateRequest(gen.CertificateRequest("test"), 3, now)
+	assert.Error(t, err)
+}
+
+func TestAppendIssuance(t *testing.T) {
+	const limit = 3
+
+	issuances := func(first, last int) []cmapi.CertificateIssuance {
+		var history []cmapi.CertificateIssuance
+		for revision := first; revision <= last; revision++ {
+			history = append(history, cmapi.CertificateIssuance{
+				Revision:          revision,
+				SHA256Fingerprint: fmt.Sprintf("%d", revision),
+			})
+		}
+		return history
+	}
+
+	tests := map[string]struct {
+		history []cmapi.CertificateIssuance
+		next    cmapi.CertificateIssuance
+		limit   int
+		want    []cmapi.CertificateIssuance
+	}{
+		"empty history": {
+			next:  issuances(1, 1)[0],
+			limit: limit,
+			want:  issuances(1, 1),
+		},
+		"history below the limit": {
+			history: issuances(1, limit-1),
+			next:    issuances(limit, limit)[0],
+			limit:   limit,
+			want:    issuances(1, limit),
+		},
+		"history at the limit drops the oldest issuance": {
+			history: issuances(1, limit),
+			next:    issuances(limit+1, limit+1)[0],
+			limit:   limit,
+			want:    issuances(2, limit+1),
+		},
+		"history above a lowered limit drops the oldest issuances": {
+			history: issuances(1, limit+2),
+			next:    issuances(limit+3, limit+3)[0],
+			limit:   limit,
+			want:    issuances(4, limit+3),
+		},
+		"issuance that is already recorded is not appended again": {
+			history: issuances(1, 2),
+			next:    issuances(2, 2)[0],
+			limit:   limit,
+			want:    issuances(1, 2),
+		},
+		"limit of 0 disables the history": {
+			history: issuances(1, 2),
+			next:    issuances(3, 3)[0],
+			limit:   0,
+			want:    nil,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.want, AppendIssuance(test.history, test.next, test.limit))
+		})
+	}
+}
+
+func TestHistoryContainsCertificate(t *testing.T) {
+	bundle := testcrypto.MustCreateCryptoBundle(t, gen.Certificate("test",
+		gen.SetCertificateDNSNames("example.com"),
+	), fakeclock.NewFakeClock(time.Now()))
+
+	history := []cmapi.CertificateIssuance{
+		{Revision: 1, SHA256Fingerprint: "other"},
+	}
+	assert.False(t, HistoryContainsCertificate(history, bundle.Cert))
+
+	history = append(history, cmapi.CertificateIssuance{
+		Revision:          2,
+		SHA256Fingerprint: fmt.Sprintf("%x", sha256.Sum256(bundle.Cert.Raw)),
+	})
+	assert.True(t, HistoryContainsCertificate(history, bundle.Cert))
+}
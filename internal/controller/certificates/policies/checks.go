@@ -96,6 +96,21 @@ func SecretPrivateKeyMismatchesSpec(input Input) (string, string, bool) {
 	return "", "", false
 }
 
+// CurrentPrivateKeyExceedsMaxAge returns a policy function that checks whether
+// the private key in the Secret is older than the Certificate's
+// spec.privateKey.rotationMaxAge, in which case the certificate must be
+// re-issued with a new private key.
+func CurrentPrivateKeyExceedsMaxAge(c clock.Clock) Func {
+	return func(input Input) (string, string, bool) {
+		rotationTime := internalcertificates.PrivateKeyRotationTime(input.Certificate, input.Secret)
+		if rotationTime == nil || c.Now().Before(rotationTime.Time) {
+			return "", "", false
+		}
+		return PrivateKeyMaxAgeExceeded, fmt.Sprintf("Issuing certificate with a new private key as the existing private key was created at %s and is older than the rotation max age of %s",
+			internalcertificates.CurrentPrivateKeyCreationTime(input.Certificate, input.Secret).Format(time.RFC3339), input.Certificate.Spec.PrivateKey.RotationMaxAge.Duration), true
+	}
+}
+
 // SecretKeystoreFormatMismatch - When the keystore is not defined, the keystore
 // related fields are removed from the secret.
 // When one or more key stores are defined,  the
@@ -228,6 +243,7 @@ func CurrentCertificateRequestMismatchesSpec(input Input) (string, string, bool)
 		// the existing certificate stored in the Secret may still be valid/up to date.
 		return "", "", false
 	}
+	violations = append(violations, internalcertificates.ACMEProfileViolations(input.CurrentRevisionRequest, input.Certificate.Spec, input.Issuer)...)
 	if len(violations) > 0 {
 		return RequestChanged, fmt.Sprintf("Fields on existing CertificateRequest resource not up to date: %v", violations), true
 	}
@@ -255,7 +271,9 @@ func currentSecretValidForSpec(input Input) (string, string, bool) {
 // CurrentCertificateNearingExpiry returns a policy function that can be used to
 // check whether an X.509 cert currently issued for a Certificate should be
 // renewed.
-func CurrentCertificateNearingExpiry(c clock.Clock) Func {
+// The renewal time is spread using the Certificate's renewal jitter, which
+// defaults to the given defaultRenewalJitter.
+func CurrentCertificateNearingExpiry(c clock.Clock, defaultRenewalJitter time.Duration) Func {
 	return func(input Input) (string, string, bool) {
 		x509Cert, err := pki.DecodeX509CertificateBytes(input.Secret.Data[corev1.TLSCertKey])
 		if err != nil {
@@ -267,10 +285,10 @@ func CurrentCertificateNearingExpiry(c clock.Clock) Func {
 		// called policy functions that check that input.Secret and
 		// input.Secret.Data exists (SecretDoesNotExist and SecretIsMissingData).
 
-		notBefore := metav1.NewTime(x509Cert.NotBefore)
-		notAfter := metav1.NewTime(x509Cert.NotAfter)
-		crt := input.Certificate
-		renewalTime := pki.RenewalTime(notBefore.Time, notAfter.Time, crt.Spec.RenewBefore, crt.Spec.RenewBeforePercentage)
+		// An invalid renewal window is rejected by the webhook. Should one get
+		// through anyway, renew outside of the window rather than letting the
+		// certificate expire.
+		renewalTime, overridden, _ := internalcertificates.RenewalTime(input.Certificate, x509Cert, input.RenewalWindow, defaultRenewalJitter, c.Now(), pki.RenewalTime)
 
 		renewIn := renewalTime.Time.Sub(c.Now())
 		if renewIn > 0 {
@@ -278,10 +296,58 @@ func CurrentCertificateNearingExpiry(c clock.Clock) Func {
 			return "", "", false
 		}
 
+		if overridden {
+			return Renewing, fmt.Sprintf("Renewing certificate outside of its maintenance windows as it expires at %s", x509Cert.NotAfter.Format(time.RFC3339)), true
+		}
 		return Renewing, fmt.Sprintf("Renewing certificate as renewal was scheduled at %s", input.Certificate.Status.RenewalTime), true
 	}
 }
 
+// CurrentCertificateRenewalInfoSuggestsRenewal returns a policy function that
+// checks whether the ACME Renewal Information (RFC 9773) stored on the
+// Certificate's status suggests that the certificate in the Secret should be
+// renewed now.
+// A suggested renewal window that has already ended indicates that the ACME
+// server wants the certificate to be replaced as soon as possible, e.g.
+// because it is going to be revoked as part of a mass revocation event.
+func CurrentCertificateRenewalInfoSuggestsRenewal(c clock.Clock) Func {
+	return func(input Input) (string, string, bool) {
+		info := input.Certificate.Status.RenewalInfo
+		if info == nil {
+			return "", "", false
+		}
+
+		x509Cert, err := pki.DecodeX509CertificateBytes(input.Secret.Data[corev1.TLSCertKey])
+		if err != nil {
+			return InvalidCertificate, fmt.Sprintf("Issuing certificate as Secret contains an invalid certificate: %v", err), true
+		}
+
+		renewalTime := internalcertificates.RenewalInfoRenewalTime(info, x509Cert)
+		if renewalTime == nil {
+			// renewal info is stale and does not apply to the current certificate
+			return "", "", false
+		}
+
+		now := c.Now()
+		if renewalTime.Time.After(now) {
+			// renewal time is in future, no need to renew
+			return "", "", false
+		}
+
+		var message string
+		if info.SuggestedWindowEnd.Time.Before(now) {
+			message = fmt.Sprintf("Renewing certificate as the renewal window suggested by the ACME server ended at %s, the certificate may be revoked soon", info.SuggestedWindowEnd.Time.Format(time.RFC3339))
+		} else {
+			message = fmt.Sprintf("Renewing certificate as renewal was scheduled at %s within the renewal window suggested by the ACME server", renewalTime.Time.Format(time.RFC3339))
+		}
+		if info.ExplanationURL != "" {
+			message += fmt.Sprintf(" (see %s)", info.ExplanationURL)
+		}
+
+		return RenewalInfoSuggestsRenewal, message, true
+	}
+}
+
 // CurrentCertificateHasExpired is used exclusively to check if the current
 // issued certificate has actually expired rather than just nearing expiry.
 func CurrentCertificateHasExpired(c clock.Clock) Func {
@@ -437,10 +503,12 @@ func SecretManagedLabelsAndAnnotationsManagedFieldsMismatch(fieldManager string)
 
 		// Ignore the CertificateName and IssuerRef annotations as these cannot be set by the postIssuance controller.
 		managedAnnotations.Delete(
-			cmapi.CertificateNameKey,       // SecretCertificateNameAnnotationMismatch checks the value
-			cmapi.IssuerNameAnnotationKey,  // SecretIssuerAnnotationsMismatch checks the value
-			cmapi.IssuerKindAnnotationKey,  // SecretIssuerAnnotationsMismatch checks the value
-			cmapi.IssuerGroupAnnotationKey, // SecretIssuerAnnotationsMismatch checks the value
+			cmapi.CertificateNameKey,                            // SecretCertificateNameAnnotationMismatch checks the value
+			cmapi.IssuerNameAnnotationKey,                       // SecretIssuerAnnotationsMismatch checks the value
+			cmapi.IssuerKindAnnotationKey,                       // SecretIssuerAnnotationsMismatch checks the value
+			cmapi.IssuerGroupAnnotationKey,                      // SecretIssuerAnnotationsMismatch checks the value
+			cmapi.PreviousCertificateRetainedUntilAnnotationKey, // SecretPreviousCertificateExpired checks the value
+			cmapi.PrivateKeyCreationTimeAnnotationKey,           // Set on issuance and kept by the postIssuance controller
 		)
 
 		// Remove the non cert-manager labels from the managed labels so we can compare
@@ -691,6 +759,30 @@ func SecretAdditionalOutputFormatsManagedFieldsMismatch(fieldManager string) Fun
 	}
 }
 
+// SecretPreviousCertificateExpired returns a policy function that checks
+// whether the previous certificate and private key kept in the Secret should
+// be removed, either because their grace period has ended or because the
+// Certificate no longer sets spec.retainPrevious.
+func SecretPreviousCertificateExpired(c clock.Clock) Func {
+	return func(input Input) (string, string, bool) {
+		value, ok := input.Secret.Annotations[cmapi.PreviousCertificateRetainedUntilAnnotationKey]
+		if !ok {
+			return "", "", false
+		}
+		if input.Certificate.Spec.RetainPrevious == nil {
+			return PreviousCertificateExpired, "Certificate no longer retains the previous certificate and private key", true
+		}
+		retainedUntil, ok := internalcertificates.PreviousCertificateRetainedUntil(input.Secret)
+		if !ok {
+			return PreviousCertificateExpired, fmt.Sprintf("Secret has an invalid %s annotation %q", cmapi.PreviousCertificateRetainedUntilAnnotationKey, value), true
+		}
+		if !c.Now().Before(retainedUntil) {
+			return PreviousCertificateExpired, fmt.Sprintf("Grace period of the previous certificate and private key ended at %s", value), true
+		}
+		return "", "", false
+	}
+}
+
 // SecretOwnerReferenceManagedFieldMismatch validates that the Secret has an
 // owner reference to the Certificate if enabled. Returns true (violation) if:
 // * the Secret doesn't have an owner reference and is expecting one
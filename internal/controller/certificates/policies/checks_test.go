@@ -18,6 +18,7 @@ package policies
 
 import (
 	"encoding/pem"
+	"math/big"
 	"testing"
 	"time"
 
@@ -28,11 +29,13 @@ import (
 	fakeclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
 )
 
 // Runs a full set of tests against the trigger 'policy chain' once it is
@@ -48,6 +51,8 @@ func Test_NewTriggerPolicyChain(t *testing.T) {
 		certificate *cmapi.Certificate
 		request     *cmapi.CertificateRequest
 		secret      *corev1.Secret
+		issuer      cmapi.GenericIssuer
+		window      *cmapi.CertificateRenewalWindow
 
 		// expected outputs
 		reason, message string
@@ -311,6 +316,52 @@ func Test_NewTriggerPolicyChain(t *testing.T) {
 			message: "Fields on existing CertificateRequest resource not up to date: [spec.commonName]",
 			reissue: true,
 		},
+		"trigger issuance when the ACME profile of the issuer has changed": {
+			certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+				CommonName: "example.com",
+				IssuerRef: cmmeta.ObjectReference{
+					Name:  "testissuer",
+					Kind:  "Issuer",
+					Group: "cert-manager.io",
+				},
+			}},
+			issuer: &cmapi.Issuer{Spec: cmapi.IssuerSpec{IssuerConfig: cmapi.IssuerConfig{
+				ACME: &cmacme.ACMEIssuer{Profile: "shortlived"},
+			}}},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "something",
+					Annotations: map[string]string{
+						cmapi.IssuerNameAnnotationKey:  "testissuer",
+						cmapi.IssuerKindAnnotationKey:  "Issuer",
+						cmapi.IssuerGroupAnnotationKey: "cert-manager.io",
+					},
+				},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+					corev1.TLSCertKey: testcrypto.MustCreateCert(t, staticFixedPrivateKey,
+						&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+					),
+				},
+			},
+			request: &cmapi.CertificateRequest{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+					cmacme.ACMEProfileAnnotationKey: "classic",
+				}},
+				Spec: cmapi.CertificateRequestSpec{
+					IssuerRef: cmmeta.ObjectReference{
+						Name:  "testissuer",
+						Kind:  "Issuer",
+						Group: "cert-manager.io",
+					},
+					Request: testcrypto.MustGenerateCSRImpl(t, staticFixedPrivateKey, &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+						CommonName: "example.com",
+					}}),
+				},
+			},
+			reason:  RequestChanged,
+			message: "Fields on existing CertificateRequest resource not up to date: [spec.acme.profile]",
+			reissue: true,
+		},
 		"do nothing if CertificateRequest matches spec": {
 			certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
 				CommonName: "example.com",
@@ -476,6 +527,204 @@ func Test_NewTriggerPolicyChain(t *testing.T) {
 			message: "Renewing certificate as renewal was scheduled at 0000-12-31 23:59:00 +0000 UTC",
 			reissue: true,
 		},
+		"does not trigger renewal if renewalTime is in the past but outside of a maintenance window": {
+			certificate: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					CommonName: "example.com",
+					IssuerRef: cmmeta.ObjectReference{
+						Name:  "testissuer",
+						Kind:  "IssuerKind",
+						Group: "group.example.com",
+					},
+					RenewBefore: &metav1.Duration{Duration: time.Hour * 25},
+				},
+				Status: cmapi.CertificateStatus{
+					RenewalTime: &metav1.Time{Time: clock.Now().Add(-1 * time.Hour)},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "something",
+					Annotations: map[string]string{
+						cmapi.IssuerNameAnnotationKey:  "testissuer",
+						cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+						cmapi.IssuerGroupAnnotationKey: "group.example.com",
+					},
+				},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+					corev1.TLSCertKey: testcrypto.MustCreateCertWithNotBeforeAfter(t, staticFixedPrivateKey,
+						&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+						clock.Now().Add(time.Hour*-24),
+						// expires in 24 hours time
+						clock.Now().Add(time.Hour*24),
+					),
+				},
+			},
+			window: &cmapi.CertificateRenewalWindow{
+				Schedule: "0 2 * * *",
+				Duration: metav1.Duration{Duration: time.Hour},
+			},
+		},
+		"trigger renewal if renewalTime is in the past and within a maintenance window": {
+			certificate: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					CommonName: "example.com",
+					IssuerRef: cmmeta.ObjectReference{
+						Name:  "testissuer",
+						Kind:  "IssuerKind",
+						Group: "group.example.com",
+					},
+					RenewBefore: &metav1.Duration{Duration: time.Hour * 25},
+				},
+				Status: cmapi.CertificateStatus{
+					RenewalTime: &metav1.Time{Time: clock.Now().Add(-1 * time.Hour)},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "something",
+					Annotations: map[string]string{
+						cmapi.IssuerNameAnnotationKey:  "testissuer",
+						cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+						cmapi.IssuerGroupAnnotationKey: "group.example.com",
+					},
+				},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+					corev1.TLSCertKey: testcrypto.MustCreateCertWithNotBeforeAfter(t, staticFixedPrivateKey,
+						&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+						clock.Now().Add(time.Hour*-24),
+						// expires in 24 hours time
+						clock.Now().Add(time.Hour*24),
+					),
+				},
+			},
+			window: &cmapi.CertificateRenewalWindow{
+				Schedule: "0 23 * * *",
+				Duration: metav1.Duration{Duration: time.Hour * 2},
+			},
+			reason:  Renewing,
+			message: "Renewing certificate as renewal was scheduled at 0000-12-31 23:00:00 +0000 UTC",
+			reissue: true,
+		},
+		"trigger renewal outside of a maintenance window if the certificate is close to expiry": {
+			certificate: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					CommonName: "example.com",
+					IssuerRef: cmmeta.ObjectReference{
+						Name:  "testissuer",
+						Kind:  "IssuerKind",
+						Group: "group.example.com",
+					},
+					RenewBefore: &metav1.Duration{Duration: time.Hour * 25},
+				},
+				Status: cmapi.CertificateStatus{
+					RenewalTime: &metav1.Time{Time: clock.Now().Add(-1 * time.Hour)},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "something",
+					Annotations: map[string]string{
+						cmapi.IssuerNameAnnotationKey:  "testissuer",
+						cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+						cmapi.IssuerGroupAnnotationKey: "group.example.com",
+					},
+				},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+					corev1.TLSCertKey: testcrypto.MustCreateCertWithNotBeforeAfter(t, staticFixedPrivateKey,
+						&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+						clock.Now().Add(time.Hour*-24),
+						// expires in 24 hours time
+						clock.Now().Add(time.Hour*24),
+					),
+				},
+			},
+			window: &cmapi.CertificateRenewalWindow{
+				Schedule:             "0 2 * * *",
+				Duration:             metav1.Duration{Duration: time.Hour},
+				OverrideBeforeExpiry: &metav1.Duration{Duration: time.Hour * 25},
+			},
+			reason:  Renewing,
+			message: "Renewing certificate outside of its maintenance windows as it expires at 0001-01-02T00:00:00Z",
+			reissue: true,
+		},
+		"does not trigger issuance if the private key is younger than the rotation max age": {
+			certificate: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					CommonName: "example.com",
+					IssuerRef: cmmeta.ObjectReference{
+						Name:  "testissuer",
+						Kind:  "IssuerKind",
+						Group: "group.example.com",
+					},
+					RenewBefore: &metav1.Duration{Duration: time.Minute * 1},
+					PrivateKey: &cmapi.CertificatePrivateKey{
+						RotationMaxAge: &metav1.Duration{Duration: time.Hour},
+					},
+				},
+				Status: cmapi.CertificateStatus{
+					PrivateKeyCreationTime: &metav1.Time{Time: clock.Now().Add(-30 * time.Minute)},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "something",
+					Annotations: map[string]string{
+						cmapi.IssuerNameAnnotationKey:  "testissuer",
+						cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+						cmapi.IssuerGroupAnnotationKey: "group.example.com",
+					},
+				},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+					corev1.TLSCertKey: testcrypto.MustCreateCertWithNotBeforeAfter(t, staticFixedPrivateKey,
+						&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+						clock.Now(),
+						// expires in 30 minutes time
+						clock.Now().Add(time.Minute*30),
+					),
+				},
+			},
+		},
+		"trigger issuance if the private key is older than the rotation max age": {
+			certificate: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					CommonName: "example.com",
+					IssuerRef: cmmeta.ObjectReference{
+						Name:  "testissuer",
+						Kind:  "IssuerKind",
+						Group: "group.example.com",
+					},
+					RenewBefore: &metav1.Duration{Duration: time.Minute * 1},
+					PrivateKey: &cmapi.CertificatePrivateKey{
+						RotationMaxAge: &metav1.Duration{Duration: time.Hour},
+					},
+				},
+				Status: cmapi.CertificateStatus{
+					PrivateKeyCreationTime: &metav1.Time{Time: clock.Now().Add(-2 * time.Hour)},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "something",
+					Annotations: map[string]string{
+						cmapi.IssuerNameAnnotationKey:  "testissuer",
+						cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+						cmapi.IssuerGroupAnnotationKey: "group.example.com",
+					},
+				},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+					corev1.TLSCertKey: testcrypto.MustCreateCertWithNotBeforeAfter(t, staticFixedPrivateKey,
+						&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+						clock.Now(),
+						// expires in 30 minutes time
+						clock.Now().Add(time.Minute*30),
+					),
+				},
+			},
+			reason:  PrivateKeyMaxAgeExceeded,
+			message: "Issuing certificate with a new private key as the existing private key was created at 0000-12-31T22:00:00Z and is older than the rotation max age of 1h0m0s",
+			reissue: true,
+		},
 		"does not trigger renewal if the x509 cert has been re-issued, but Certificate's renewal time has not been updated yet": {
 			certificate: &cmapi.Certificate{
 				Spec: cmapi.CertificateSpec{
@@ -545,13 +794,15 @@ func Test_NewTriggerPolicyChain(t *testing.T) {
 			},
 		},
 	}
-	policyChain := NewTriggerPolicyChain(clock)
+	policyChain := NewTriggerPolicyChain(clock, 0)
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			reason, message, reissue := policyChain.Evaluate(Input{
 				Certificate:            test.certificate,
 				CurrentRevisionRequest: test.request,
 				Secret:                 test.secret,
+				Issuer:                 test.issuer,
+				RenewalWindow:          test.window,
 			})
 
 			if test.reason != reason {
@@ -2315,3 +2566,148 @@ func Test_SecretCertificateNameAnnotationsMismatch(t *testing.T) {
 		})
 	}
 }
+
+func Test_CurrentCertificateRenewalInfoSuggestsRenewal(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := fakeclock.NewFakeClock(now)
+
+	pk := testcrypto.MustCreatePEMPrivateKey(t)
+	signer, err := pki.DecodePrivateKeyBytes(pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template, err := pki.CertificateTemplateFromCertificate(&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	template.SerialNumber = big.NewInt(1234)
+	template.AuthorityKeyId = []byte{1, 2, 3, 4}
+	certPEM, x509Cert, err := pki.SignCertificate(template, template, signer.Public(), signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certID, err := acmeapi.ARICertID(x509Cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret := &corev1.Secret{Data: map[string][]byte{corev1.TLSCertKey: certPEM}}
+	renewalInfo := func(certID string, start, end time.Time, explanationURL string) *cmapi.CertificateRenewalInfo {
+		return &cmapi.CertificateRenewalInfo{
+			CertID:               certID,
+			SuggestedWindowStart: metav1.NewTime(start),
+			SuggestedWindowEnd:   metav1.NewTime(end),
+			ExplanationURL:       explanationURL,
+		}
+	}
+
+	tests := map[string]struct {
+		renewalInfo *cmapi.CertificateRenewalInfo
+
+		reason, message string
+		reissue         bool
+	}{
+		"do nothing if there is no renewal info": {},
+		"do nothing if the renewal info is for a different certificate": {
+			renewalInfo: renewalInfo("AQIDBA.AQ", now.Add(-2*time.Hour), now.Add(-time.Hour), ""),
+		},
+		"do nothing if the suggested window is in the future": {
+			renewalInfo: renewalInfo(certID, now.Add(time.Hour), now.Add(2*time.Hour), ""),
+		},
+		"trigger issuance if the suggested window has ended": {
+			renewalInfo: renewalInfo(certID, now.Add(-2*time.Hour), now.Add(-time.Hour), "https://example.com/incident"),
+			reason:      RenewalInfoSuggestsRenewal,
+			message:     "Renewing certificate as the renewal window suggested by the ACME server ended at 2024-06-01T11:00:00Z, the certificate may be revoked soon (see https://example.com/incident)",
+			reissue:     true,
+		},
+		"trigger issuance if the renewal time within the suggested window has passed": {
+			// a window of a single nanosecond always results in a renewal time at the window start
+			renewalInfo: renewalInfo(certID, now.Add(-time.Nanosecond), now, ""),
+			reason:      RenewalInfoSuggestsRenewal,
+			message:     "Renewing certificate as renewal was scheduled at 2024-06-01T11:59:59Z within the renewal window suggested by the ACME server",
+			reissue:     true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := &cmapi.Certificate{Status: cmapi.CertificateStatus{RenewalInfo: test.renewalInfo}}
+			reason, message, reissue := CurrentCertificateRenewalInfoSuggestsRenewal(clock)(Input{
+				Certificate: crt,
+				Secret:      secret,
+			})
+			assert.Equal(t, test.reason, reason)
+			assert.Equal(t, test.message, message)
+			assert.Equal(t, test.reissue, reissue)
+		})
+	}
+}
+
+func Test_SecretPreviousCertificateExpired(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := fakeclock.NewFakeClock(now)
+
+	retainingCert := gen.Certificate("test-certificate", gen.SetCertificateRetainPrevious(metav1.Duration{Duration: time.Hour}))
+	secretRetainedUntil := func(retainedUntil string) *corev1.Secret {
+		return gen.Secret("test-secret", gen.SetSecretAnnotations(map[string]string{
+			cmapi.PreviousCertificateRetainedUntilAnnotationKey: retainedUntil,
+		}))
+	}
+
+	tests := map[string]struct {
+		input Input
+
+		expReason    string
+		expMessage   string
+		expViolation bool
+	}{
+		"Secret without a previous certificate should return false": {
+			input: Input{
+				Certificate: retainingCert,
+				Secret:      &corev1.Secret{},
+			},
+		},
+		"previous certificate within its grace period should return false": {
+			input: Input{
+				Certificate: retainingCert,
+				Secret:      secretRetainedUntil("2024-06-01T12:30:00Z"),
+			},
+		},
+		"previous certificate after its grace period should return true": {
+			input: Input{
+				Certificate: retainingCert,
+				Secret:      secretRetainedUntil("2024-06-01T12:00:00Z"),
+			},
+			expReason:    PreviousCertificateExpired,
+			expMessage:   "Grace period of the previous certificate and private key ended at 2024-06-01T12:00:00Z",
+			expViolation: true,
+		},
+		"previous certificate with an invalid grace period should return true": {
+			input: Input{
+				Certificate: retainingCert,
+				Secret:      secretRetainedUntil("tomorrow"),
+			},
+			expReason:    PreviousCertificateExpired,
+			expMessage:   `Secret has an invalid cert-manager.io/previous-certificate-retained-until annotation "tomorrow"`,
+			expViolation: true,
+		},
+		"previous certificate of a Certificate that no longer retains it should return true": {
+			input: Input{
+				Certificate: gen.Certificate("test-certificate"),
+				Secret:      secretRetainedUntil("2024-06-01T12:30:00Z"),
+			},
+			expReason:    PreviousCertificateExpired,
+			expMessage:   "Certificate no longer retains the previous certificate and private key",
+			expViolation: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotReason, gotMessage, gotViolation := SecretPreviousCertificateExpired(clock)(test.input)
+			assert.Equal(t, test.expReason, gotReason)
+			assert.Equal(t, test.expMessage, gotMessage)
+			assert.Equal(t, test.expViolation, gotViolation)
+		})
+	}
+}
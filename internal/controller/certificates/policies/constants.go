@@ -36,6 +36,14 @@ const (
 	// SecretMismatch is a policy violation reason for a scenario where Secret's
 	// private key does not match spec.
 	SecretMismatch string = "SecretMismatch"
+	// PrivateKeyMaxAgeExceeded is a policy violation reason for a scenario
+	// where the Secret's private key is older than the Certificate's
+	// spec.privateKey.rotationMaxAge.
+	PrivateKeyMaxAgeExceeded string = "PrivateKeyMaxAgeExceeded"
+	// PreviousCertificateExpired is a policy violation reason for a scenario
+	// where the Secret keeps a previous certificate and private key that are
+	// no longer retained.
+	PreviousCertificateExpired string = "PreviousCertificateExpired"
 	// IncorrectIssuer is a policy violation reason for a scenario where
 	// Certificate has been issued by incorrect Issuer.
 	IncorrectIssuer string = "IncorrectIssuer"
@@ -50,6 +58,10 @@ const (
 	// Renewing is a policy violation reason for a scenario where
 	// Certificate's renewal time is now or in past.
 	Renewing string = "Renewing"
+	// RenewalInfoSuggestsRenewal is a policy violation reason for a scenario
+	// where the ACME Renewal Information (RFC 9773) retrieved from the ACME
+	// server that issued the Certificate suggests renewing it now.
+	RenewalInfoSuggestsRenewal string = "RenewalInfoSuggestsRenewal"
 	// Expired is a policy violation reason for a scenario where Certificate has
 	// expired.
 	Expired string = "Expired"
@@ -214,10 +214,12 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	"github.com/cert-manager/cert-manager/pkg/util/predicate"
 )
@@ -227,6 +229,11 @@ import (
 type Gatherer struct {
 	CertificateRequestLister cmlisters.CertificateRequestLister
 	SecretLister             internalinformers.SecretLister
+
+	// IssuerHelper is used to look up the Certificate's issuer, which provides
+	// the default renewal window and ACME profile of the Certificate.
+	// If nil, only the Certificate's own renewal window and profile are used.
+	IssuerHelper issuer.Helper
 }
 
 // DataForCertificate returns the secret as well as the "current" and "next"
@@ -312,10 +319,18 @@ func (g *Gatherer) DataForCertificate(ctx context.Context, crt *cmapi.Certificat
 		log.V(logf.DebugLevel).Info("Found no CertificateRequest resources owned by this Certificate for the next revision", "revision", nextCRRevision)
 	}
 
+	iss := internalcertificates.IssuerForCertificate(ctx, g.IssuerHelper, crt)
+	renewalWindow := crt.Spec.RenewalWindow
+	if renewalWindow == nil && iss != nil {
+		renewalWindow = iss.GetSpec().RenewalWindow
+	}
+
 	return Input{
 		Certificate:            crt,
 		Secret:                 secret,
 		CurrentRevisionRequest: curCR,
 		NextRevisionRequest:    nextCR,
+		Issuer:                 iss,
+		RenewalWindow:          renewalWindow,
 	}, nil
 }
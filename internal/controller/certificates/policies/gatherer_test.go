@@ -26,13 +26,16 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 	fakeclock "k8s.io/utils/clock/testing"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
 )
@@ -45,12 +48,16 @@ func TestDataForCertificate(t *testing.T) {
 		)
 	}
 
+	certWindow := cmapi.CertificateRenewalWindow{Schedule: "0 2 * * sat", Duration: metav1.Duration{Duration: time.Hour}}
+	issuerWindow := cmapi.CertificateRenewalWindow{Schedule: "0 3 * * sun", Duration: metav1.Duration{Duration: time.Hour}}
+
 	tests := map[string]struct {
 		builder    *testpkg.Builder
 		givenCert  *cmapi.Certificate
 		wantCurCR  *cmapi.CertificateRequest
 		wantNextCR *cmapi.CertificateRequest
 		wantSecret *corev1.Secret
+		wantWindow *cmapi.CertificateRenewalWindow
 		wantErr    string
 	}{
 		"when no secret is found, the returned secret is nil": {
@@ -61,6 +68,50 @@ func TestDataForCertificate(t *testing.T) {
 			builder:    &testpkg.Builder{},
 			wantSecret: nil,
 		},
+		"when the cert has a renewal window, it takes precedence over the issuer's": {
+			givenCert: gen.Certificate("cert-1", gen.SetCertificateNamespace("ns-1"),
+				gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "issuer-1", Kind: "Issuer"}),
+				gen.SetCertificateRenewalWindow(certWindow),
+			),
+			builder: &testpkg.Builder{CertManagerObjects: []runtime.Object{
+				gen.Issuer("issuer-1", gen.SetIssuerNamespace("ns-1"), gen.SetIssuerRenewalWindow(issuerWindow)),
+			}},
+			wantWindow: &certWindow,
+		},
+		"when the cert has no renewal window, the Issuer's renewal window is returned": {
+			givenCert: gen.Certificate("cert-1", gen.SetCertificateNamespace("ns-1"),
+				gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "issuer-1", Kind: "Issuer"}),
+			),
+			builder: &testpkg.Builder{CertManagerObjects: []runtime.Object{
+				gen.Issuer("issuer-1", gen.SetIssuerNamespace("ns-1"), gen.SetIssuerRenewalWindow(issuerWindow)),
+			}},
+			wantWindow: &issuerWindow,
+		},
+		"when the cert has no renewal window, the ClusterIssuer's renewal window is returned": {
+			givenCert: gen.Certificate("cert-1", gen.SetCertificateNamespace("ns-1"),
+				gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "issuer-1", Kind: "ClusterIssuer"}),
+			),
+			builder: &testpkg.Builder{CertManagerObjects: []runtime.Object{
+				gen.ClusterIssuer("issuer-1", gen.SetIssuerRenewalWindow(issuerWindow)),
+			}},
+			wantWindow: &issuerWindow,
+		},
+		"when the issuer does not exist, no renewal window is returned": {
+			givenCert: gen.Certificate("cert-1", gen.SetCertificateNamespace("ns-1"),
+				gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "issuer-1", Kind: "Issuer"}),
+			),
+			builder:    &testpkg.Builder{},
+			wantWindow: nil,
+		},
+		"when the issuer is an external issuer, no renewal window is returned": {
+			givenCert: gen.Certificate("cert-1", gen.SetCertificateNamespace("ns-1"),
+				gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "issuer-1", Kind: "Issuer", Group: "example.com"}),
+			),
+			builder: &testpkg.Builder{CertManagerObjects: []runtime.Object{
+				gen.Issuer("issuer-1", gen.SetIssuerNamespace("ns-1"), gen.SetIssuerRenewalWindow(issuerWindow)),
+			}},
+			wantWindow: nil,
+		},
 		"when neither current nor next CRs exist, the returned cur and next CRs should be nil": {
 			givenCert: gen.Certificate("cert-1", gen.SetCertificateNamespace("default-unit-test-ns"),
 				gen.SetCertificateSecretName("secret-1"),
@@ -169,6 +220,12 @@ func TestDataForCertificate(t *testing.T) {
 			if _, err := test.builder.KubeSharedInformerFactory.Secrets().Informer().AddEventHandler(noop); err != nil {
 				t.Fatalf("failed to add event handler to Secret informer: %v", err)
 			}
+			if _, err := test.builder.SharedInformerFactory.Certmanager().V1().Issuers().Informer().AddEventHandler(noop); err != nil {
+				t.Fatalf("failed to add event handler to Issuer informer: %v", err)
+			}
+			if _, err := test.builder.SharedInformerFactory.Certmanager().V1().ClusterIssuers().Informer().AddEventHandler(noop); err != nil {
+				t.Fatalf("failed to add event handler to ClusterIssuer informer: %v", err)
+			}
 
 			// Even though we are only relying on listers in this unit test
 			// and do not use the informer event handlers, we still need to
@@ -213,6 +270,10 @@ func TestDataForCertificate(t *testing.T) {
 			g := &Gatherer{
 				CertificateRequestLister: test.builder.SharedInformerFactory.Certmanager().V1().CertificateRequests().Lister(),
 				SecretLister:             test.builder.KubeSharedInformerFactory.Secrets().Lister(),
+				IssuerHelper: issuer.NewHelper(
+					test.builder.SharedInformerFactory.Certmanager().V1().Issuers().Lister(),
+					test.builder.SharedInformerFactory.Certmanager().V1().ClusterIssuers().Lister(),
+				),
 			}
 
 			ctx := logf.NewContext(context.Background(), logf.WithResource(log, test.givenCert))
@@ -227,6 +288,7 @@ func TestDataForCertificate(t *testing.T) {
 				assert.Equal(t, test.wantCurCR, got.CurrentRevisionRequest)
 				assert.Equal(t, test.wantNextCR, got.NextRevisionRequest)
 				assert.Equal(t, test.wantSecret, got.Secret)
+				assert.Equal(t, test.wantWindow, got.RenewalWindow)
 			}
 		})
 	}
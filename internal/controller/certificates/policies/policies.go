@@ -18,10 +18,14 @@ limitations under the License.
 package policies
 
 import (
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/clock"
 
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 )
 
 type Input struct {
@@ -40,6 +44,16 @@ type Input struct {
 	// Take a look at the gatherer package's documentation to see more about why
 	// we care about the "next" certificate request.
 	NextRevisionRequest *cmapi.CertificateRequest
+
+	// Issuer is the cert-manager Issuer or ClusterIssuer referenced by the
+	// certificate. It is nil if the certificate references an external issuer
+	// or if the issuer could not be found.
+	Issuer cmapi.GenericIssuer
+
+	// RenewalWindow is the maintenance window in which the certificate may be
+	// renewed, taken from the Certificate or otherwise from its issuer.
+	// If nil, the certificate may be renewed at any time.
+	RenewalWindow *cmapi.CertificateRenewalWindow
 }
 
 // A Func evaluates the given input data and decides whether a check has passed
@@ -65,8 +79,10 @@ func (c Chain) Evaluate(input Input) (string, string, bool) {
 
 // NewTriggerPolicyChain includes trigger policy checks, which if return true,
 // should cause a Certificate to be marked for issuance.
-func NewTriggerPolicyChain(c clock.Clock) Chain {
-	return Chain{
+// The defaultRenewalJitter is used to spread the renewals of Certificates that
+// do not set spec.renewalJitter.
+func NewTriggerPolicyChain(c clock.Clock, defaultRenewalJitter time.Duration) Chain {
+	chain := Chain{
 		SecretDoesNotExist,     // Make sure the Secret exists
 		SecretIsMissingData,    // Make sure the Secret has the required keys set
 		SecretPublicKeysDiffer, // Make sure the PrivateKey and PublicKey match in the Secret
@@ -74,11 +90,20 @@ func NewTriggerPolicyChain(c clock.Clock) Chain {
 		SecretIssuerAnnotationsMismatch,          // Make sure the Secret's IssuerRef annotations match the Certificate spec
 		SecretCertificateNameAnnotationsMismatch, // Make sure the Secret's CertificateName annotation matches the Certificate's name
 
-		SecretPrivateKeyMismatchesSpec,                      // Make sure the PrivateKey Type and Size match the Certificate spec
-		SecretPublicKeyDiffersFromCurrentCertificateRequest, // Make sure the Secret's PublicKey matches the current CertificateRequest
-		CurrentCertificateRequestMismatchesSpec,             // Make sure the current CertificateRequest matches the Certificate spec
-		CurrentCertificateNearingExpiry(c),                  // Make sure the Certificate in the Secret is not nearing expiry
+		SecretPrivateKeyMismatchesSpec,                           // Make sure the PrivateKey Type and Size match the Certificate spec
+		CurrentPrivateKeyExceedsMaxAge(c),                        // Make sure the PrivateKey is not older than the Certificate's rotation max age
+		SecretPublicKeyDiffersFromCurrentCertificateRequest,      // Make sure the Secret's PublicKey matches the current CertificateRequest
+		CurrentCertificateRequestMismatchesSpec,                  // Make sure the current CertificateRequest matches the Certificate spec
+		CurrentCertificateNearingExpiry(c, defaultRenewalJitter), // Make sure the Certificate in the Secret is not nearing expiry
+	}
+
+	if utilfeature.DefaultFeatureGate.Enabled(feature.ACMERenewalInfo) {
+		chain = append(chain,
+			CurrentCertificateRenewalInfoSuggestsRenewal(c), // Make sure the ACME server does not want the Certificate in the Secret to be renewed
+		)
 	}
+
+	return chain
 }
 
 // NewReadinessPolicyChain includes readiness policy checks, which if return
@@ -102,7 +127,7 @@ func NewReadinessPolicyChain(c clock.Clock) Chain {
 // NewSecretPostIssuancePolicyChain includes policy checks that are to be
 // performed _after_ issuance has been successful, testing for the presence and
 // correctness of metadata and output formats of Certificate's Secrets.
-func NewSecretPostIssuancePolicyChain(ownerRefEnabled bool, fieldManager string) Chain {
+func NewSecretPostIssuancePolicyChain(c clock.Clock, ownerRefEnabled bool, fieldManager string) Chain {
 	return Chain{
 		SecretBaseLabelsMismatch,                                             // Make sure the managed labels have the correct values
 		SecretCertificateDetailsAnnotationsMismatch,                          // Make sure the managed certificate details annotations have the correct values
@@ -115,6 +140,8 @@ func NewSecretPostIssuancePolicyChain(ownerRefEnabled bool, fieldManager string)
 		SecretOwnerReferenceManagedFieldMismatch(ownerRefEnabled, fieldManager),
 
 		SecretKeystoreFormatMismatch,
+
+		SecretPreviousCertificateExpired(c), // Make sure the previous certificate and private key are removed once no longer retained
 	}
 }
 
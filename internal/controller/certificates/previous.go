@@ -0,0 +1,88 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"bytes"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// PreviousCertificate is a previously issued certificate and its private key,
+// kept in a Certificate's Secret during a rollover.
+type PreviousCertificate struct {
+	Certificate, PrivateKey []byte
+	RetainedUntil           time.Time
+}
+
+// PreviousCertificateForSecret returns the previous certificate and private
+// key that should be kept in the given Secret once it is updated with
+// certificate.
+// If certificate differs from the certificate currently stored in the Secret,
+// the certificate and private key currently stored become the previous ones
+// and are kept for spec.retainPrevious.gracePeriod. Otherwise, the previous
+// certificate and private key already stored in the Secret are kept until
+// their grace period ends.
+// It returns nil if the Certificate does not retain previous certificates, or
+// there is no previous certificate to keep.
+func PreviousCertificateForSecret(crt *cmapi.Certificate, secret *corev1.Secret, certificate []byte, now time.Time) *PreviousCertificate {
+	if crt.Spec.RetainPrevious == nil || secret == nil || secret.Data == nil {
+		return nil
+	}
+
+	currentCert, currentKey := secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey]
+	if len(currentCert) > 0 && len(currentKey) > 0 && !bytes.Equal(currentCert, certificate) {
+		return &PreviousCertificate{
+			Certificate:   currentCert,
+			PrivateKey:    currentKey,
+			RetainedUntil: now.Add(crt.Spec.RetainPrevious.GracePeriod.Duration).Truncate(time.Second),
+		}
+	}
+
+	retainedUntil, ok := PreviousCertificateRetainedUntil(secret)
+	if !ok || !now.Before(retainedUntil) {
+		return nil
+	}
+	previousCert, previousKey := secret.Data[cmapi.PreviousTLSCertKey], secret.Data[cmapi.PreviousTLSPrivateKeyKey]
+	if len(previousCert) == 0 || len(previousKey) == 0 {
+		return nil
+	}
+	return &PreviousCertificate{
+		Certificate:   previousCert,
+		PrivateKey:    previousKey,
+		RetainedUntil: retainedUntil,
+	}
+}
+
+// PreviousCertificateRetainedUntil returns the time until which the previous
+// certificate and private key are kept in the given Secret. It returns false
+// if the Secret does not keep a previous certificate, or the time recorded on
+// the Secret cannot be parsed.
+func PreviousCertificateRetainedUntil(secret *corev1.Secret) (time.Time, bool) {
+	value, ok := secret.Annotations[cmapi.PreviousCertificateRetainedUntilAnnotationKey]
+	if !ok {
+		return time.Time{}, false
+	}
+	retainedUntil, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return retainedUntil, true
+}
@@ -0,0 +1,103 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestPreviousCertificateForSecret(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 500, time.UTC)
+	gracePeriod := metav1.Duration{Duration: 24 * time.Hour}
+
+	retainingCert := gen.Certificate("test", gen.SetCertificateRetainPrevious(gracePeriod))
+	secret := gen.Secret("test-secret", gen.SetSecretData(map[string][]byte{
+		corev1.TLSCertKey:       []byte("current-crt"),
+		corev1.TLSPrivateKeyKey: []byte("current-key"),
+	}))
+	secretWithPrevious := func(retainedUntil string) *corev1.Secret {
+		return gen.SecretFrom(secret,
+			gen.SetSecretAnnotations(map[string]string{cmapi.PreviousCertificateRetainedUntilAnnotationKey: retainedUntil}),
+			gen.SetSecretData(map[string][]byte{
+				corev1.TLSCertKey:              []byte("current-crt"),
+				corev1.TLSPrivateKeyKey:        []byte("current-key"),
+				cmapi.PreviousTLSCertKey:       []byte("previous-crt"),
+				cmapi.PreviousTLSPrivateKeyKey: []byte("previous-key"),
+			}),
+		)
+	}
+
+	tests := map[string]struct {
+		crt         *cmapi.Certificate
+		secret      *corev1.Secret
+		certificate []byte
+		want        *PreviousCertificate
+	}{
+		"Certificate does not retain previous certificates": {
+			crt:         gen.Certificate("test"),
+			secret:      secret,
+			certificate: []byte("new-crt"),
+		},
+		"Secret does not exist": {
+			crt:         retainingCert,
+			certificate: []byte("new-crt"),
+		},
+		"new certificate keeps the current certificate and private key": {
+			crt:         retainingCert,
+			secret:      secretWithPrevious("2024-06-01T13:00:00Z"),
+			certificate: []byte("new-crt"),
+			want: &PreviousCertificate{
+				Certificate:   []byte("current-crt"),
+				PrivateKey:    []byte("current-key"),
+				RetainedUntil: time.Date(2024, 6, 2, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		"same certificate keeps the previous certificate within its grace period": {
+			crt:         retainingCert,
+			secret:      secretWithPrevious("2024-06-01T13:00:00Z"),
+			certificate: []byte("current-crt"),
+			want: &PreviousCertificate{
+				Certificate:   []byte("previous-crt"),
+				PrivateKey:    []byte("previous-key"),
+				RetainedUntil: time.Date(2024, 6, 1, 13, 0, 0, 0, time.UTC),
+			},
+		},
+		"same certificate drops the previous certificate after its grace period": {
+			crt:         retainingCert,
+			secret:      secretWithPrevious("2024-06-01T12:00:00Z"),
+			certificate: []byte("current-crt"),
+		},
+		"same certificate drops the previous certificate with an invalid grace period": {
+			crt:         retainingCert,
+			secret:      secretWithPrevious("tomorrow"),
+			certificate: []byte("current-crt"),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.want, PreviousCertificateForSecret(test.crt, test.secret, test.certificate, now))
+		})
+	}
+}
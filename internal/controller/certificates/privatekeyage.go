@@ -0,0 +1,85 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"crypto"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// PrivateKeyCreationTime returns the creation time of the private key pk,
+// which is about to be stored in the given Secret together with a newly
+// issued certificate.
+// If the Secret already contains pk, the private key is being reused and its
+// recorded creation time (see CurrentPrivateKeyCreationTime) is kept. If none
+// is recorded, e.g. because the private key was generated outside of
+// cert-manager, the notBefore time of the certificate in the Secret is used
+// instead. Otherwise pk is a new private key, created now.
+func PrivateKeyCreationTime(crt *cmapi.Certificate, secret *corev1.Secret, pk crypto.Signer, now time.Time) *metav1.Time {
+	if secret != nil && secret.Data != nil {
+		existing, err := pki.DecodePrivateKeyBytes(secret.Data[corev1.TLSPrivateKeyKey])
+		if err == nil {
+			if equal, err := pki.PublicKeysEqual(existing.Public(), pk.Public()); err == nil && equal {
+				if created := CurrentPrivateKeyCreationTime(crt, secret); created != nil {
+					return created
+				}
+				if cert, err := pki.DecodeX509CertificateBytes(secret.Data[corev1.TLSCertKey]); err == nil {
+					return &metav1.Time{Time: cert.NotBefore}
+				}
+			}
+		}
+	}
+	return &metav1.Time{Time: now.Truncate(time.Second)}
+}
+
+// CurrentPrivateKeyCreationTime returns the creation time of the private key
+// stored in the Certificate's Secret, as recorded by the private key creation
+// time annotation on the Secret. Secrets written before the annotation was
+// introduced fall back to the creation time on the Certificate's status.
+// It returns nil if the creation time is unknown.
+func CurrentPrivateKeyCreationTime(crt *cmapi.Certificate, secret *corev1.Secret) *metav1.Time {
+	if secret != nil {
+		if value, ok := secret.Annotations[cmapi.PrivateKeyCreationTimeAnnotationKey]; ok {
+			if created, err := time.Parse(time.RFC3339, value); err == nil {
+				return &metav1.Time{Time: created}
+			}
+		}
+	}
+	return crt.Status.PrivateKeyCreationTime
+}
+
+// PrivateKeyRotationTime returns the time at which the private key stored in
+// the Certificate's Secret becomes older than spec.privateKey.rotationMaxAge
+// and must be rotated. It returns nil if the Certificate does not restrict
+// the age of its private key, or the age of the private key is unknown.
+func PrivateKeyRotationTime(crt *cmapi.Certificate, secret *corev1.Secret) *metav1.Time {
+	privateKey := crt.Spec.PrivateKey
+	if privateKey == nil || privateKey.RotationMaxAge == nil || privateKey.RotationPolicy == cmapi.RotationPolicyAlways {
+		return nil
+	}
+	created := CurrentPrivateKeyCreationTime(crt, secret)
+	if created == nil {
+		return nil
+	}
+	return &metav1.Time{Time: created.Add(privateKey.RotationMaxAge.Duration)}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"crypto"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestPrivateKeyCreationTime(t *testing.T) {
+	mustDecodeKey := func(pkPEM []byte) crypto.Signer {
+		pk, err := pki.DecodePrivateKeyBytes(pkPEM)
+		require.NoError(t, err)
+		return pk
+	}
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 500, time.UTC)
+	notBefore := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	created := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	baseCert := gen.Certificate("test", gen.SetCertificateDNSNames("example.com"))
+	oldKeyPEM := testcrypto.MustCreatePEMPrivateKey(t)
+	oldKey := mustDecodeKey(oldKeyPEM)
+	newKey := mustDecodeKey(testcrypto.MustCreatePEMPrivateKey(t))
+	secret := gen.Secret("test-secret", gen.SetSecretData(map[string][]byte{
+		corev1.TLSPrivateKeyKey: oldKeyPEM,
+		corev1.TLSCertKey:       testcrypto.MustCreateCertWithNotBeforeAfter(t, oldKeyPEM, baseCert, notBefore, notBefore.Add(90*24*time.Hour)),
+	}))
+
+	tests := map[string]struct {
+		crt    *cmapi.Certificate
+		secret *corev1.Secret
+		pk     crypto.Signer
+		want   *metav1.Time
+	}{
+		"new private key without an existing Secret": {
+			crt:  baseCert,
+			pk:   newKey,
+			want: &metav1.Time{Time: now.Truncate(time.Second)},
+		},
+		"new private key replacing the private key in the Secret": {
+			crt:    gen.CertificateFrom(baseCert, gen.SetCertificatePrivateKeyCreationTime(created)),
+			secret: secret,
+			pk:     newKey,
+			want:   &metav1.Time{Time: now.Truncate(time.Second)},
+		},
+		"reused private key keeps its recorded creation time": {
+			crt:    gen.CertificateFrom(baseCert, gen.SetCertificatePrivateKeyCreationTime(created)),
+			secret: secret,
+			pk:     oldKey,
+			want:   &created,
+		},
+		"reused private key keeps the creation time recorded on the Secret": {
+			crt: gen.CertificateFrom(baseCert, gen.SetCertificatePrivateKeyCreationTime(metav1.NewTime(notBefore))),
+			secret: gen.SecretFrom(secret, gen.SetSecretAnnotations(map[string]string{
+				cmapi.PrivateKeyCreationTimeAnnotationKey: created.UTC().Format(time.RFC3339),
+			})),
+			pk:   oldKey,
+			want: &created,
+		},
+		"reused private key without a recorded creation time uses the certificate's notBefore": {
+			crt:    baseCert,
+			secret: secret,
+			pk:     oldKey,
+			want:   &metav1.Time{Time: notBefore},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := PrivateKeyCreationTime(test.crt, test.secret, test.pk, now)
+			assert.True(t, test.want.Equal(got), "expected %v, got %v", test.want, got)
+		})
+	}
+}
+
+func TestPrivateKeyRotationTime(t *testing.T) {
+	created := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	maxAge := &metav1.Duration{Duration: 90 * 24 * time.Hour}
+
+	tests := map[string]struct {
+		crt    *cmapi.Certificate
+		secret *corev1.Secret
+		want   *metav1.Time
+	}{
+		"no rotation max age": {
+			crt: gen.Certificate("test", gen.SetCertificatePrivateKeyCreationTime(created)),
+		},
+		"unknown private key creation time": {
+			crt: gen.Certificate("test", gen.SetCertificateKeyRotationMaxAge(maxAge)),
+		},
+		"rotation policy Always": {
+			crt: gen.Certificate("test",
+				gen.SetCertificateKeyRotationMaxAge(maxAge),
+				gen.SetCertificatePrivateKeyCreationTime(created),
+				func(crt *cmapi.Certificate) { crt.Spec.PrivateKey.RotationPolicy = cmapi.RotationPolicyAlways },
+			),
+		},
+		"private key is rotated once it is older than the rotation max age": {
+			crt: gen.Certificate("test",
+				gen.SetCertificateKeyRotationMaxAge(maxAge),
+				gen.SetCertificatePrivateKeyCreationTime(created),
+			),
+			want: &metav1.Time{Time: time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)},
+		},
+		"creation time recorded on the Secret takes precedence over the status": {
+			crt: gen.Certificate("test",
+				gen.SetCertificateKeyRotationMaxAge(maxAge),
+				gen.SetCertificatePrivateKeyCreationTime(created),
+			),
+			secret: gen.Secret("test-secret", gen.SetSecretAnnotations(map[string]string{
+				cmapi.PrivateKeyCreationTimeAnnotationKey: "2024-02-01T00:00:00Z",
+			})),
+			want: &metav1.Time{Time: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		"invalid creation time on the Secret falls back to the status": {
+			crt: gen.Certificate("test",
+				gen.SetCertificateKeyRotationMaxAge(maxAge),
+				gen.SetCertificatePrivateKeyCreationTime(created),
+			),
+			secret: gen.Secret("test-secret", gen.SetSecretAnnotations(map[string]string{
+				cmapi.PrivateKeyCreationTimeAnnotationKey: "invalid",
+			})),
+			want: &metav1.Time{Time: time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.want, PrivateKeyRotationTime(test.crt, test.secret))
+		})
+	}
+}
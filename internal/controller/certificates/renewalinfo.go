@@ -0,0 +1,62 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"crypto/x509"
+	"hash/fnv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
+)
+
+// RenewalInfoRenewalTime returns the time at which the given certificate
+// should be renewed according to the ACME Renewal Information (RFC 9773)
+// stored on a Certificate's status.
+// It returns nil if there is no renewal information or if the renewal
+// information was retrieved for a different certificate.
+//
+// RFC 9773 asks clients to pick a random time within the suggested window.
+// To make sure that the renewal time remains stable across reconciles, the
+// time is picked deterministically based on the certificate's ARI identifier.
+// A window that lies in the past always results in a renewal time in the past,
+// meaning that the certificate should be renewed immediately.
+func RenewalInfoRenewalTime(info *cmapi.CertificateRenewalInfo, cert *x509.Certificate) *metav1.Time {
+	if info == nil || cert == nil {
+		return nil
+	}
+
+	certID, err := acmeapi.ARICertID(cert)
+	if err != nil || certID != info.CertID {
+		return nil
+	}
+
+	start, end := info.SuggestedWindowStart.Time, info.SuggestedWindowEnd.Time
+	window := end.Sub(start)
+	if window <= 0 {
+		return &metav1.Time{Time: start}
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(certID))
+	offset := time.Duration(h.Sum64() % uint64(window))
+
+	return &metav1.Time{Time: start.Add(offset)}
+}
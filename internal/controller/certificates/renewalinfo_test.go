@@ -0,0 +1,91 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"crypto/x509"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestRenewalInfoRenewalTime(t *testing.T) {
+	cert := &x509.Certificate{
+		AuthorityKeyId: []byte{0x69, 0x88, 0x5b, 0x6b, 0x87, 0x46, 0x40, 0x41, 0xe1, 0xb3, 0x7b, 0x84, 0x7b, 0xa0, 0xae, 0x2c, 0xde, 0x01, 0xc8, 0xd4},
+		SerialNumber:   big.NewInt(0x87654321),
+	}
+	const certID = "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE"
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(48 * time.Hour)
+
+	tests := map[string]struct {
+		info *cmapi.CertificateRenewalInfo
+		cert *x509.Certificate
+
+		expectNil bool
+	}{
+		"no renewal info": {
+			info:      nil,
+			cert:      cert,
+			expectNil: true,
+		},
+		"no certificate": {
+			info:      &cmapi.CertificateRenewalInfo{CertID: certID},
+			cert:      nil,
+			expectNil: true,
+		},
+		"renewal info for a different certificate": {
+			info: &cmapi.CertificateRenewalInfo{
+				CertID:               "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AQ",
+				SuggestedWindowStart: metav1.NewTime(start),
+				SuggestedWindowEnd:   metav1.NewTime(end),
+			},
+			cert:      cert,
+			expectNil: true,
+		},
+		"renewal info for the current certificate": {
+			info: &cmapi.CertificateRenewalInfo{
+				CertID:               certID,
+				SuggestedWindowStart: metav1.NewTime(start),
+				SuggestedWindowEnd:   metav1.NewTime(end),
+			},
+			cert: cert,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			renewalTime := RenewalInfoRenewalTime(test.info, test.cert)
+			if test.expectNil {
+				assert.Nil(t, renewalTime)
+				return
+			}
+
+			if assert.NotNil(t, renewalTime) {
+				assert.False(t, renewalTime.Time.Before(start), "renewal time %s is before the window start %s", renewalTime, start)
+				assert.True(t, renewalTime.Time.Before(end), "renewal time %s is not before the window end %s", renewalTime, end)
+				assert.Equal(t, renewalTime, RenewalInfoRenewalTime(test.info, test.cert), "renewal time should be stable")
+			}
+		})
+	}
+}
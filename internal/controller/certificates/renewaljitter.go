@@ -0,0 +1,63 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"hash/fnv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// RenewalJitter returns the window within which the renewal of the given
+// Certificate is spread, which is its spec.renewalJitter if set, or the given
+// controller default otherwise.
+func RenewalJitter(crt *cmapi.Certificate, defaultJitter time.Duration) time.Duration {
+	if crt.Spec.RenewalJitter != nil {
+		return crt.Spec.RenewalJitter.Duration
+	}
+	return defaultJitter
+}
+
+// JitterRenewalTime moves the renewal time of the certificate issued for the
+// given Certificate earlier by an offset between zero and the Certificate's
+// renewal jitter, so that Certificates which would otherwise be renewed at
+// the same time are renewed at different times.
+//
+// To make sure that the renewal time remains stable across reconciles, the
+// offset is picked deterministically based on the Certificate's namespace and
+// name. The offset is a whole number of seconds, as the renewal time is stored
+// truncated to the second, and the renewal time is never moved before the
+// certificate's notBefore time.
+func JitterRenewalTime(crt *cmapi.Certificate, renewalTime *metav1.Time, notBefore time.Time, defaultJitter time.Duration) *metav1.Time {
+	seconds := int64(RenewalJitter(crt, defaultJitter) / time.Second)
+	if renewalTime == nil || seconds <= 0 {
+		return renewalTime
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(crt.Namespace + "/" + crt.Name))
+	offset := time.Duration(h.Sum64()%uint64(seconds)) * time.Second
+
+	jittered := renewalTime.Add(-offset)
+	if jittered.Before(notBefore) {
+		jittered = notBefore.Truncate(time.Second)
+	}
+	return &metav1.Time{Time: jittered}
+}
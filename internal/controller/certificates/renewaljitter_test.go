@@ -0,0 +1,77 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestJitterRenewalTime(t *testing.T) {
+	notBefore := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	renewalTime := metav1.NewTime(notBefore.Add(60 * 24 * time.Hour))
+
+	crt := func(name string, jitter *metav1.Duration) *cmapi.Certificate {
+		return &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec:       cmapi.CertificateSpec{RenewalJitter: jitter},
+		}
+	}
+
+	t.Run("no jitter", func(t *testing.T) {
+		assert.Equal(t, &renewalTime, JitterRenewalTime(crt("test", nil), &renewalTime, notBefore, 0))
+		assert.Equal(t, &renewalTime, JitterRenewalTime(crt("test", &metav1.Duration{}), &renewalTime, notBefore, time.Hour))
+	})
+
+	t.Run("no renewal time", func(t *testing.T) {
+		assert.Nil(t, JitterRenewalTime(crt("test", nil), nil, notBefore, time.Hour))
+	})
+
+	t.Run("renewal time is moved earlier within the jitter", func(t *testing.T) {
+		distinct := map[time.Time]bool{}
+		for i := 0; i < 100; i++ {
+			c := crt(fmt.Sprintf("test-%d", i), nil)
+			rt := JitterRenewalTime(c, &renewalTime, notBefore, time.Hour)
+			assert.False(t, rt.After(renewalTime.Time), "renewal time must not be moved later")
+			assert.True(t, rt.After(renewalTime.Add(-time.Hour)), "renewal time must be moved by less than the jitter")
+			assert.Equal(t, rt.Truncate(time.Second), rt.Time, "renewal time must be truncated to the second")
+			// The renewal time must be stable across calls.
+			assert.Equal(t, rt, JitterRenewalTime(c, &renewalTime, notBefore, time.Hour))
+			distinct[rt.Time] = true
+		}
+		assert.Greater(t, len(distinct), 50, "renewal times should be spread")
+	})
+
+	t.Run("the Certificate's jitter takes precedence over the default", func(t *testing.T) {
+		rt := JitterRenewalTime(crt("test", &metav1.Duration{Duration: time.Minute}), &renewalTime, notBefore, 24*time.Hour)
+		assert.True(t, rt.After(renewalTime.Add(-time.Minute)))
+	})
+
+	t.Run("renewal time is not moved before notBefore", func(t *testing.T) {
+		early := metav1.NewTime(notBefore.Add(time.Second))
+		for i := 0; i < 10; i++ {
+			rt := JitterRenewalTime(crt(fmt.Sprintf("test-%d", i), nil), &early, notBefore, time.Hour)
+			assert.False(t, rt.Time.Before(notBefore))
+		}
+	})
+}
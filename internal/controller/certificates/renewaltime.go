@@ -0,0 +1,46 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"crypto/x509"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// RenewalTime returns the time at which the given X.509 certificate issued
+// for the Certificate should be renewed. The renewal time calculated by
+// renewalTimeFunc is spread using the Certificate's renewal jitter (see
+// JitterRenewalTime), and then moved into the given renewal window (see
+// WindowRenewalTime). overridden is true if the renewal time was moved outside
+// of the window so that the certificate does not expire.
+//
+// If the renewal window is invalid, the renewal time outside of the window is
+// returned together with the error.
+func RenewalTime(crt *cmapi.Certificate, x509cert *x509.Certificate, window *cmapi.CertificateRenewalWindow, defaultJitter time.Duration, now time.Time, renewalTimeFunc pki.RenewalTimeFunc) (renewalTime *metav1.Time, overridden bool, err error) {
+	renewalTime = renewalTimeFunc(x509cert.NotBefore, x509cert.NotAfter, crt.Spec.RenewBefore, crt.Spec.RenewBeforePercentage)
+	renewalTime = JitterRenewalTime(crt, renewalTime, x509cert.NotBefore, defaultJitter)
+	windowed, overridden, err := WindowRenewalTime(window, renewalTime, x509cert.NotAfter, now)
+	if err != nil {
+		return renewalTime, false, err
+	}
+	return windowed, overridden, nil
+}
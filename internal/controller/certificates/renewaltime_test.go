@@ -0,0 +1,87 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+func TestRenewalTime(t *testing.T) {
+	notBefore := time.Date(2024, 2, 3, 12, 0, 0, 0, time.UTC)
+	notAfter := notBefore.Add(90 * 24 * time.Hour)
+	x509cert := &x509.Certificate{NotBefore: notBefore, NotAfter: notAfter}
+	// Renew 30 days before expiry, on 2024-04-03 (a Wednesday).
+	crt := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"},
+		Spec:       cmapi.CertificateSpec{RenewBefore: &metav1.Duration{Duration: 30 * 24 * time.Hour}},
+	}
+	renewalTime := notAfter.Add(-30 * 24 * time.Hour)
+	now := notBefore.Add(time.Hour)
+
+	tests := map[string]struct {
+		window         *cmapi.CertificateRenewalWindow
+		jitter         time.Duration
+		want           *metav1.Time
+		wantOverridden bool
+		wantErr        bool
+	}{
+		"renewal time without jitter or window": {
+			want: &metav1.Time{Time: renewalTime},
+		},
+		"renewal time is moved earlier by the jitter": {
+			jitter: time.Hour,
+			want:   JitterRenewalTime(crt, &metav1.Time{Time: renewalTime}, notBefore, time.Hour),
+		},
+		"renewal time is moved into the window": {
+			window: &cmapi.CertificateRenewalWindow{
+				Schedule: "0 2 * * sat",
+				Duration: metav1.Duration{Duration: 4 * time.Hour},
+			},
+			want: &metav1.Time{Time: time.Date(2024, 4, 6, 2, 0, 0, 0, time.UTC)},
+		},
+		"renewal time is overridden if no window opens before expiry": {
+			window: &cmapi.CertificateRenewalWindow{
+				Schedule: "0 2 29 2 *",
+				Duration: metav1.Duration{Duration: 4 * time.Hour},
+			},
+			want:           &metav1.Time{Time: renewalTime.Add(15 * 24 * time.Hour)},
+			wantOverridden: true,
+		},
+		"renewal time outside of the window is returned if the window is invalid": {
+			window:  &cmapi.CertificateRenewalWindow{Schedule: "invalid"},
+			want:    &metav1.Time{Time: renewalTime},
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, overridden, err := RenewalTime(crt, x509cert, test.window, test.jitter, now, pki.RenewalTime)
+			assert.Equal(t, test.wantErr, err != nil, "unexpected error: %v", err)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantOverridden, overridden)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cert-manager/cert-manager/internal/cron"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// WindowRenewalTime moves the renewal time of a certificate into the given
+// maintenance window. The returned renewal time is the renewal time itself if
+// it falls within a maintenance window, or else the start of the first
+// maintenance window after the renewal time (or after now, if the renewal time
+// has already passed and its window was missed).
+//
+// If no maintenance window starts before the safety override time, the
+// returned renewal time is the override time and overridden is true. The
+// override time is the window's overrideBeforeExpiry before the certificate's
+// notAfter time or, if unset, halfway between the renewal time and notAfter.
+func WindowRenewalTime(window *cmapi.CertificateRenewalWindow, renewalTime *metav1.Time, notAfter, now time.Time) (rt *metav1.Time, overridden bool, err error) {
+	if window == nil || renewalTime == nil {
+		return renewalTime, false, nil
+	}
+
+	schedule, err := cron.Parse(window.Schedule)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid renewal window schedule: %w", err)
+	}
+	loc := time.UTC
+	if window.TimeZone != "" {
+		if loc, err = time.LoadLocation(window.TimeZone); err != nil {
+			return nil, false, fmt.Errorf("invalid renewal window time zone: %w", err)
+		}
+	}
+
+	nominal := renewalTime.Time
+	override := nominal.Add(notAfter.Sub(nominal) / 2)
+	if window.OverrideBeforeExpiry != nil {
+		override = notAfter.Add(-window.OverrideBeforeExpiry.Duration)
+	}
+	override = override.Truncate(time.Second)
+	if override.Before(nominal) {
+		override = nominal
+	}
+
+	from := nominal
+	if now.After(from) {
+		from = now
+	}
+	// Any window that started less than its duration before `from` is still
+	// open at `from`.
+	start := schedule.Next(from.Add(-window.Duration.Duration).In(loc))
+	if start.IsZero() || !start.Before(override) {
+		return &metav1.Time{Time: override}, true, nil
+	}
+	if start.Before(nominal) {
+		return renewalTime, false, nil
+	}
+	return &metav1.Time{Time: start.UTC()}, false, nil
+}
@@ -0,0 +1,145 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestWindowRenewalTime(t *testing.T) {
+	// 2024-03-04 is a Monday.
+	renewalTime := time.Date(2024, 3, 4, 12, 0, 0, 0, time.UTC)
+	notAfter := renewalTime.Add(30 * 24 * time.Hour)
+	saturdayNight := &cmapi.CertificateRenewalWindow{
+		Schedule: "0 2 * * sat",
+		Duration: metav1.Duration{Duration: 4 * time.Hour},
+	}
+
+	tests := map[string]struct {
+		window         *cmapi.CertificateRenewalWindow
+		renewalTime    *metav1.Time
+		now            time.Time
+		want           *metav1.Time
+		wantOverridden bool
+		wantErr        bool
+	}{
+		"no window": {
+			renewalTime: &metav1.Time{Time: renewalTime},
+			now:         renewalTime.Add(-time.Hour),
+			want:        &metav1.Time{Time: renewalTime},
+		},
+		"no renewal time": {
+			window: saturdayNight,
+			now:    renewalTime,
+		},
+		"renewal time is moved to the next window": {
+			window:      saturdayNight,
+			renewalTime: &metav1.Time{Time: renewalTime},
+			now:         renewalTime.Add(-time.Hour),
+			want:        &metav1.Time{Time: time.Date(2024, 3, 9, 2, 0, 0, 0, time.UTC)},
+		},
+		"renewal time within an open window is kept": {
+			window:      saturdayNight,
+			renewalTime: &metav1.Time{Time: time.Date(2024, 3, 9, 3, 0, 0, 0, time.UTC)},
+			now:         renewalTime,
+			want:        &metav1.Time{Time: time.Date(2024, 3, 9, 3, 0, 0, 0, time.UTC)},
+		},
+		"missed window moves the renewal time to the next window": {
+			window:      saturdayNight,
+			renewalTime: &metav1.Time{Time: renewalTime},
+			now:         time.Date(2024, 3, 9, 6, 30, 0, 0, time.UTC),
+			want:        &metav1.Time{Time: time.Date(2024, 3, 16, 2, 0, 0, 0, time.UTC)},
+		},
+		"window is evaluated in its time zone": {
+			window: &cmapi.CertificateRenewalWindow{
+				Schedule: "0 2 * * sat",
+				Duration: metav1.Duration{Duration: 4 * time.Hour},
+				TimeZone: "Europe/Amsterdam",
+			},
+			renewalTime: &metav1.Time{Time: renewalTime},
+			now:         renewalTime.Add(-time.Hour),
+			want:        &metav1.Time{Time: time.Date(2024, 3, 9, 1, 0, 0, 0, time.UTC)},
+		},
+		"override when no window starts before expiry": {
+			window: &cmapi.CertificateRenewalWindow{
+				Schedule: "0 2 1 jan *",
+				Duration: metav1.Duration{Duration: time.Hour},
+			},
+			renewalTime:    &metav1.Time{Time: renewalTime},
+			now:            renewalTime.Add(-time.Hour),
+			want:           &metav1.Time{Time: renewalTime.Add(15 * 24 * time.Hour)},
+			wantOverridden: true,
+		},
+		"override before expiry": {
+			window: &cmapi.CertificateRenewalWindow{
+				Schedule:             "0 2 1 jan *",
+				Duration:             metav1.Duration{Duration: time.Hour},
+				OverrideBeforeExpiry: &metav1.Duration{Duration: 24 * time.Hour},
+			},
+			renewalTime:    &metav1.Time{Time: renewalTime},
+			now:            renewalTime.Add(-time.Hour),
+			want:           &metav1.Time{Time: notAfter.Add(-24 * time.Hour)},
+			wantOverridden: true,
+		},
+		"override is never before the renewal time": {
+			window: &cmapi.CertificateRenewalWindow{
+				Schedule:             "0 2 * * sat",
+				Duration:             metav1.Duration{Duration: time.Hour},
+				OverrideBeforeExpiry: &metav1.Duration{Duration: 60 * 24 * time.Hour},
+			},
+			renewalTime:    &metav1.Time{Time: renewalTime},
+			now:            renewalTime.Add(-time.Hour),
+			want:           &metav1.Time{Time: renewalTime},
+			wantOverridden: true,
+		},
+		"invalid schedule": {
+			window: &cmapi.CertificateRenewalWindow{
+				Schedule: "not a schedule",
+				Duration: metav1.Duration{Duration: time.Hour},
+			},
+			renewalTime: &metav1.Time{Time: renewalTime},
+			wantErr:     true,
+		},
+		"invalid time zone": {
+			window: &cmapi.CertificateRenewalWindow{
+				Schedule: "0 2 * * sat",
+				Duration: metav1.Duration{Duration: time.Hour},
+				TimeZone: "Not/AZone",
+			},
+			renewalTime: &metav1.Time{Time: renewalTime},
+			wantErr:     true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, overridden, err := WindowRenewalTime(test.window, test.renewalTime, notAfter, test.now)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantOverridden, overridden)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"crypto"
+	"crypto/x509"
+
+	corev1 "k8s.io/api/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// PendingRevocationForSecret returns a pending revocation, with the given
+// reason, of the certificate stored in the given Secret.
+// It returns nil if the Secret does not contain a valid certificate or if the
+// certificate was not issued by cert-manager, which is determined by the
+// presence of the issuer name annotation on the Secret.
+func PendingRevocationForSecret(secret *corev1.Secret, reason cmapi.CertificateRevocationReason) (*cmapi.CertificateRevocation, *x509.Certificate) {
+	if secret == nil || secret.Annotations[cmapi.IssuerNameAnnotationKey] == "" {
+		return nil, nil
+	}
+
+	certPEM := secret.Data[corev1.TLSCertKey]
+	cert, err := pki.DecodeX509CertificateBytes(certPEM)
+	if err != nil {
+		return nil, nil
+	}
+
+	return &cmapi.CertificateRevocation{
+		SerialNumber: cert.SerialNumber.Text(16),
+		IssuerRef: cmmeta.ObjectReference{
+			Name:  secret.Annotations[cmapi.IssuerNameAnnotationKey],
+			Kind:  secret.Annotations[cmapi.IssuerKindAnnotationKey],
+			Group: secret.Annotations[cmapi.IssuerGroupAnnotationKey],
+		},
+		Reason:      reason,
+		State:       cmapi.RevocationStatePending,
+		Certificate: certPEM,
+	}, cert
+}
+
+// SupersededRevocation returns a pending revocation of the certificate stored
+// in the given Secret if the Certificate's revocation policy asks for it to
+// be revoked once it is superseded by a certificate for the private key pk.
+// It returns nil if the certificate does not need to be revoked.
+func SupersededRevocation(crt *cmapi.Certificate, secret *corev1.Secret, pk crypto.Signer) *cmapi.CertificateRevocation {
+	if crt.Spec.RevocationPolicy == nil || !crt.Spec.RevocationPolicy.OnKeyRotation {
+		return nil
+	}
+
+	revocation, cert := PendingRevocationForSecret(secret, cmapi.RevocationReasonSuperseded)
+	if revocation == nil {
+		return nil
+	}
+
+	// certificates which are renewed using the same private key are not
+	// revoked, as the key has not been replaced
+	if matches, err := pki.PublicKeysEqual(cert.PublicKey, pk.Public()); err != nil || matches {
+		return nil
+	}
+
+	if HasRevocation(crt.Status.Revocations, revocation.SerialNumber) {
+		return nil
+	}
+
+	return revocation
+}
+
+// HasRevocation returns true if the given list of revocations contains a
+// revocation of the certificate with the given serial number.
+func HasRevocation(revocations []cmapi.CertificateRevocation, serialNumber string) bool {
+	for _, revocation := range revocations {
+		if revocation.SerialNumber == serialNumber {
+			return true
+		}
+	}
+	return false
+}
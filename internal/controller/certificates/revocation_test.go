@@ -0,0 +1,124 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"crypto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestSupersededRevocation(t *testing.T) {
+	mustDecodeKey := func(pkPEM []byte) crypto.Signer {
+		pk, err := pki.DecodePrivateKeyBytes(pkPEM)
+		require.NoError(t, err)
+		return pk
+	}
+
+	baseCert := gen.Certificate("test",
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateSecretName("test-secret"),
+	)
+	oldKeyPEM := testcrypto.MustCreatePEMPrivateKey(t)
+	oldKey := mustDecodeKey(oldKeyPEM)
+	newKey := mustDecodeKey(testcrypto.MustCreatePEMPrivateKey(t))
+	certPEM := testcrypto.MustCreateCert(t, oldKeyPEM, baseCert)
+	cert, err := pki.DecodeX509CertificateBytes(certPEM)
+	require.NoError(t, err)
+	serialNumber := cert.SerialNumber.Text(16)
+
+	secret := gen.Secret("test-secret",
+		gen.SetSecretAnnotations(map[string]string{
+			cmapi.IssuerNameAnnotationKey:  "ca-issuer",
+			cmapi.IssuerKindAnnotationKey:  "ClusterIssuer",
+			cmapi.IssuerGroupAnnotationKey: "cert-manager.io",
+		}),
+		gen.SetSecretData(map[string][]byte{corev1.TLSCertKey: certPEM}),
+	)
+	onKeyRotation := func(crt *cmapi.Certificate) {
+		crt.Spec.RevocationPolicy = &cmapi.CertificateRevocationPolicy{OnKeyRotation: true}
+	}
+
+	tests := map[string]struct {
+		crt    *cmapi.Certificate
+		secret *corev1.Secret
+		pk     crypto.Signer
+
+		expectRevocation bool
+	}{
+		"no revocation policy": {
+			crt:    baseCert,
+			secret: secret,
+			pk:     newKey,
+		},
+		"no existing Secret": {
+			crt: gen.CertificateFrom(baseCert, onKeyRotation),
+			pk:  newKey,
+		},
+		"certificate not issued by cert-manager": {
+			crt: gen.CertificateFrom(baseCert, onKeyRotation),
+			secret: gen.SecretFrom(secret, func(s *corev1.Secret) {
+				s.Annotations = nil
+			}),
+			pk: newKey,
+		},
+		"private key has not been rotated": {
+			crt:    gen.CertificateFrom(baseCert, onKeyRotation),
+			secret: secret,
+			pk:     oldKey,
+		},
+		"certificate has already been recorded": {
+			crt: gen.CertificateFrom(baseCert, onKeyRotation, func(crt *cmapi.Certificate) {
+				crt.Status.Revocations = []cmapi.CertificateRevocation{{SerialNumber: serialNumber}}
+			}),
+			secret: secret,
+			pk:     newKey,
+		},
+		"private key has been rotated": {
+			crt:              gen.CertificateFrom(baseCert, onKeyRotation),
+			secret:           secret,
+			pk:               newKey,
+			expectRevocation: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			revocation := SupersededRevocation(test.crt, test.secret, test.pk)
+			if !test.expectRevocation {
+				assert.Nil(t, revocation)
+				return
+			}
+
+			require.NotNil(t, revocation)
+			assert.Equal(t, serialNumber, revocation.SerialNumber)
+			assert.Equal(t, "ca-issuer", revocation.IssuerRef.Name)
+			assert.Equal(t, "ClusterIssuer", revocation.IssuerRef.Kind)
+			assert.Equal(t, "cert-manager.io", revocation.IssuerRef.Group)
+			assert.Equal(t, cmapi.RevocationReasonSuperseded, revocation.Reason)
+			assert.Equal(t, cmapi.RevocationStatePending, revocation.State)
+			assert.Equal(t, certPEM, revocation.Certificate)
+		})
+	}
+}
@@ -44,7 +44,8 @@ const (
 	// Owner: N/A
 	// Alpha: v0.7.2
 	//
-	// ValidateCAA enables CAA checking when issuing certificates
+	// ValidateCAA enables CAA checking when issuing certificates, including
+	// the RFC 8657 accounturi and validationmethods parameters.
 	ValidateCAA featuregate.Feature = "ValidateCAA"
 
 	// Owner: N/A
@@ -137,6 +138,35 @@ const (
 	// Certificate resources.
 	// Github Issue: https://github.com/cert-manager/cert-manager/issues/6393
 	OtherNames featuregate.Feature = "OtherNames"
+
+	// Owner: N/A
+	// Alpha: v1.16
+	//
+	// ACMERenewalInfo enables the certificates-renewal-info controller, which
+	// retrieves ACME Renewal Information (ARI, RFC 9773) for Certificates
+	// issued by ACME Issuers. The suggested renewal window is taken into
+	// account when calculating the renewal time of a Certificate and new
+	// ACME orders indicate which certificate they are replacing.
+	ACMERenewalInfo featuregate.Feature = "ACMERenewalInfo"
+
+	// Owner: N/A
+	// Alpha: v1.16
+	//
+	// CertificateRevocation enables the revocation of the certificates issued
+	// for a Certificate, as configured by its `spec.revocationPolicy` or
+	// requested using the `cert-manager.io/revoke` annotation.
+	// When disabled, the certificates-revocation controller only removes its
+	// finalizer from Certificates.
+	CertificateRevocation featuregate.Feature = "CertificateRevocation"
+
+	// Owner: N/A
+	// Alpha: v1.16
+	//
+	// ACMEAuthorizationCache enables a cache of the ACME authorizations that
+	// are known to be valid for each ACME account and identifier. New Orders
+	// that the ACME server attaches a cached authorization to use it instead
+	// of fetching it from the ACME server again.
+	ACMEAuthorizationCache featuregate.Feature = "ACMEAuthorizationCache"
 )
 
 func init() {
@@ -160,4 +190,7 @@ var defaultCertManagerFeatureGates = map[featuregate.Feature]featuregate.Feature
 	UseCertificateRequestBasicConstraints:            {Default: false, PreRelease: featuregate.Alpha},
 	NameConstraints:                                  {Default: false, PreRelease: featuregate.Alpha},
 	OtherNames:                                       {Default: false, PreRelease: featuregate.Alpha},
+	ACMERenewalInfo:                                  {Default: false, PreRelease: featuregate.Alpha},
+	CertificateRevocation:                            {Default: false, PreRelease: featuregate.Alpha},
+	ACMEAuthorizationCache:                           {Default: false, PreRelease: featuregate.Alpha},
 }
@@ -0,0 +1,141 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := map[string]struct {
+		expr    string
+		wantErr bool
+	}{
+		"every minute":               {expr: "* * * * *"},
+		"lists, ranges and steps":    {expr: "0,30 1-5/2 */2 1-12 mon-fri"},
+		"names are case insensitive": {expr: "0 0 * JAN Sun"},
+		"sunday as 7":                {expr: "0 0 * * 7"},
+		"value with step":            {expr: "5/15 * * * *"},
+		"too few fields":             {expr: "* * * *", wantErr: true},
+		"too many fields":            {expr: "* * * * * *", wantErr: true},
+		"minute out of range":        {expr: "60 * * * *", wantErr: true},
+		"day of month zero":          {expr: "0 0 0 * *", wantErr: true},
+		"reversed range":             {expr: "0 5-1 * * *", wantErr: true},
+		"zero step":                  {expr: "*/0 * * * *", wantErr: true},
+		"unknown name":               {expr: "0 0 * foo *", wantErr: true},
+		"empty":                      {expr: "", wantErr: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(test.expr)
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	date := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := map[string]struct {
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		"every minute moves to the next minute": {
+			expr: "* * * * *",
+			from: date(2024, 1, 1, 10, 0).Add(30 * time.Second),
+			want: date(2024, 1, 1, 10, 1),
+		},
+		"next activation is strictly after the time": {
+			expr: "0 2 * * *",
+			from: date(2024, 1, 1, 2, 0),
+			want: date(2024, 1, 2, 2, 0),
+		},
+		"later the same day": {
+			expr: "30 22 * * *",
+			from: date(2024, 1, 1, 10, 0),
+			want: date(2024, 1, 1, 22, 30),
+		},
+		"day of week": {
+			// 2024-01-01 is a Monday.
+			expr: "0 1 * * sat",
+			from: date(2024, 1, 1, 10, 0),
+			want: date(2024, 1, 6, 1, 0),
+		},
+		"sunday as 7": {
+			expr: "0 0 * * 7",
+			from: date(2024, 1, 1, 0, 0),
+			want: date(2024, 1, 7, 0, 0),
+		},
+		"day of month or day of week": {
+			expr: "0 0 15 * sat",
+			from: date(2024, 1, 7, 0, 0),
+			want: date(2024, 1, 13, 0, 0),
+		},
+		"day of month and starred day of week": {
+			expr: "0 0 15 * *",
+			from: date(2024, 1, 7, 0, 0),
+			want: date(2024, 1, 15, 0, 0),
+		},
+		"across a year boundary": {
+			expr: "0 0 1 jan *",
+			from: date(2024, 6, 1, 0, 0),
+			want: date(2025, 1, 1, 0, 0),
+		},
+		"leap day": {
+			expr: "0 0 29 2 *",
+			from: date(2024, 3, 1, 0, 0),
+			want: date(2028, 2, 29, 0, 0),
+		},
+		"never activates": {
+			expr: "0 0 30 2 *",
+			from: date(2024, 1, 1, 0, 0),
+			want: time.Time{},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s, err := Parse(test.expr)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, s.Next(test.from))
+		})
+	}
+}
+
+func TestNextLocation(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Amsterdam")
+	require.NoError(t, err)
+
+	s, err := Parse("0 2 * * *")
+	require.NoError(t, err)
+
+	next := s.Next(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC), next)
+
+	next = s.Next(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).In(loc))
+	assert.True(t, next.Equal(time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)), "got %s", next)
+}
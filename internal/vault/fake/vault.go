@@ -18,6 +18,7 @@ limitations under the License.
 package fake
 
 import (
+	"math/big"
 	"time"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
@@ -28,6 +29,7 @@ import (
 type Vault struct {
 	NewFn                           func(string, internalinformers.SecretLister, cmapi.GenericIssuer) (*Vault, error)
 	SignFn                          func([]byte, time.Duration) ([]byte, []byte, error)
+	RevokeFn                        func(*big.Int) error
 	IsVaultInitializedAndUnsealedFn func() error
 }
 
@@ -37,6 +39,9 @@ func New() *Vault {
 		SignFn: func([]byte, time.Duration) ([]byte, []byte, error) {
 			return nil, nil, nil
 		},
+		RevokeFn: func(*big.Int) error {
+			return nil
+		},
 		IsVaultInitializedAndUnsealedFn: func() error {
 			return nil
 		},
@@ -54,6 +59,19 @@ func (v *Vault) Sign(csrPEM []byte, duration time.Duration) ([]byte, []byte, err
 	return v.SignFn(csrPEM, duration)
 }
 
+// Revoke implements `vault.Interface`.
+func (v *Vault) Revoke(serialNumber *big.Int) error {
+	return v.RevokeFn(serialNumber)
+}
+
+// WithRevoke sets the fake Vault's Revoke function.
+func (v *Vault) WithRevoke(err error) *Vault {
+	v.RevokeFn = func(*big.Int) error {
+		return err
+	}
+	return v
+}
+
 // WithSign sets the fake Vault's Sign function.
 func (v *Vault) WithSign(certPEM, caPEM []byte, err error) *Vault {
 	v.SignFn = func([]byte, time.Duration) ([]byte, []byte, error) {
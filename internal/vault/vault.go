@@ -22,6 +22,7 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"math/big"
 	"net/http"
 	"path"
 	"path/filepath"
@@ -53,6 +54,7 @@ type ClientBuilder func(ctx context.Context, namespace string, _ func(ns string)
 // Vault's certificate.
 type Interface interface {
 	Sign(csrPEM []byte, duration time.Duration) (certPEM []byte, caPEM []byte, err error)
+	Revoke(serialNumber *big.Int) error
 	IsVaultInitializedAndUnsealed() error
 }
 
@@ -182,6 +184,51 @@ func (v *Vault) Sign(csrPEM []byte, duration time.Duration) (cert []byte, ca []b
 	return extractCertificatesFromVaultCertificateSecret(&vaultResult)
 }
 
+// Revoke revokes the certificate with the given serial number using the
+// `revoke` endpoint of the PKI secrets engine that the issuer's path points
+// to.
+func (v *Vault) Revoke(serialNumber *big.Int) error {
+	mount, err := pkiMountPath(v.issuer.GetSpec().Vault.Path)
+	if err != nil {
+		return err
+	}
+
+	parameters := map[string]string{
+		"serial_number": certutil.GetHexFormatted(serialNumber.Bytes(), ":"),
+	}
+
+	request := v.client.NewRequest("POST", path.Join("/v1", mount, "revoke"))
+	if err := request.SetJSONBody(parameters); err != nil {
+		return fmt.Errorf("failed to build vault request: %s", err)
+	}
+
+	resp, err := v.client.RawRequest(request)
+	if err != nil {
+		return fmt.Errorf("failed to revoke certificate by vault: %s", err)
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// pkiMountPath returns the mount path of the PKI secrets engine given the
+// path of a Vault issuer, which points to one of the signing endpoints of the
+// secrets engine, e.g. `pki/sign/my-role` or `pki/issuer/default/sign/my-role`.
+func pkiMountPath(vaultPath string) (string, error) {
+	segments := strings.Split(strings.Trim(vaultPath, "/"), "/")
+	for i := len(segments) - 1; i > 0; i-- {
+		switch segments[i] {
+		case "sign", "issue", "sign-verbatim":
+			mount := segments[:i]
+			if len(mount) > 2 && mount[len(mount)-2] == "issuer" {
+				mount = mount[:len(mount)-2]
+			}
+			return strings.Join(mount, "/"), nil
+		}
+	}
+	return "", fmt.Errorf("unable to determine the PKI secrets engine mount path from path %q", vaultPath)
+}
+
 func (v *Vault) setToken(ctx context.Context, client Client) error {
 	// IMPORTANT: Because of backwards compatibility with older versions that
 	// incorrectly allowed multiple authentication methods to be specified at
@@ -25,6 +25,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -1761,3 +1762,82 @@ func TestSignIntegration(t *testing.T) {
 	require.NotEmpty(t, certPEM)
 	require.NotEmpty(t, caPEM)
 }
+
+func TestPKIMountPath(t *testing.T) {
+	tests := map[string]struct {
+		path      string
+		expected  string
+		expectErr bool
+	}{
+		"sign path":                   {path: "pki/sign/my-role", expected: "pki"},
+		"issue path with leading /":   {path: "/pki/issue/my-role", expected: "pki"},
+		"sign-verbatim path":          {path: "pki/sign-verbatim", expected: "pki"},
+		"nested mount":                {path: "team/pki/sign/my-role", expected: "team/pki"},
+		"sign path with issuer":       {path: "pki/issuer/my-issuer/sign/my-role", expected: "pki"},
+		"path without sign operation": {path: "pki/roles/my-role", expectErr: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mount, err := pkiMountPath(test.path)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, mount)
+		})
+	}
+}
+
+func TestRevokeIntegration(t *testing.T) {
+	const (
+		vaultToken = "token1"
+		vaultPath  = "my_pki_mount/sign/my-role-name"
+	)
+
+	var gotSerialNumber string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/my_pki_mount/revoke", func(response http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodPost, request.Method)
+		assert.Equal(t, vaultToken, request.Header.Get("X-Vault-Token"))
+		var body map[string]string
+		require.NoError(t, jsonutil.DecodeJSONFromReader(request.Body, &body))
+		gotSerialNumber = body["serial_number"]
+		_, err := response.Write([]byte(`{"data":{"revocation_time":1}}`))
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	v, err := New(
+		context.TODO(),
+		"k8s-ns1",
+		func(ns string) CreateToken { return nil },
+		listers.FakeSecretListerFrom(listers.NewFakeSecretLister(),
+			listers.SetFakeSecretNamespaceListerGet(
+				&corev1.Secret{
+					Data: map[string][]byte{
+						"key1": []byte(vaultToken),
+					},
+				}, nil),
+		),
+		gen.Issuer("issuer1",
+			gen.SetIssuerNamespace("k8s-ns1"),
+			gen.SetIssuerVault(cmapi.VaultIssuer{
+				Server: server.URL,
+				Path:   vaultPath,
+				Auth: cmapi.VaultAuth{
+					TokenSecretRef: &cmmeta.SecretKeySelector{
+						LocalObjectReference: cmmeta.LocalObjectReference{
+							Name: "secret1",
+						},
+						Key: "key1",
+					},
+				},
+			}),
+		))
+	require.NoError(t, err)
+
+	require.NoError(t, v.Revoke(big.NewInt(0x1a2b3c)))
+	assert.Equal(t, "1a:2b:3c", gotSerialNumber)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package accounts
 
 import (
+	"crypto"
 	"crypto/rsa"
 	"crypto/sha256"
 	"crypto/x509"
@@ -25,20 +26,36 @@ import (
 	"net/http"
 	"sync"
 
+	"k8s.io/utils/clock"
+
 	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
+	"github.com/cert-manager/cert-manager/pkg/acme/client/middleware"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 )
 
 // ErrNotFound is returned by GetClient if there is no ACME client registered.
 var ErrNotFound = errors.New("ACME client for issuer not initialised/available")
 
+// ClientID returns the ID under which the ACME client for the given ACME
+// server of an issuer is stored in the registry.
+// The client for the issuer's primary server is stored using the issuer's UID,
+// clients for the issuer's fallback servers use the UID suffixed with the
+// server URL.
+// An empty server refers to the issuer's primary server.
+func ClientID(uid string, config *cmacme.ACMEIssuer, server string) string {
+	if server == "" || config == nil || server == config.Server {
+		return uid
+	}
+	return uid + "/" + server
+}
+
 // A registry provides a means to store and access ACME clients using an issuer
 // objects UID.
 // This is used as a shared cache of ACME clients across various controllers.
 type Registry interface {
 	// AddClient will ensure the registry has a stored ACME client for the Issuer
 	// object with the given UID, configuration and private key.
-	AddClient(httpClient *http.Client, uid string, config cmacme.ACMEIssuer, privateKey *rsa.PrivateKey, userAgent string)
+	AddClient(httpClient *http.Client, uid string, config cmacme.ACMEIssuer, privateKey crypto.Signer, userAgent string)
 
 	// RemoveClient will remove a registered client using the UID of the Issuer
 	// resource that constructed it.
@@ -46,7 +63,7 @@ type Registry interface {
 
 	// IsKeyCheckSumCached checks if the private key checksum is cached with registered client.
 	// If not cached, the account is re-verified for the private key.
-	IsKeyCheckSumCached(lastPrivateKeyHash string, privateKey *rsa.PrivateKey) bool
+	IsKeyCheckSumCached(lastPrivateKeyHash string, privateKey crypto.Signer) bool
 
 	Getter
 }
@@ -67,8 +84,15 @@ type Getter interface {
 
 // NewDefaultRegistry returns a new default instantiation of a client registry.
 func NewDefaultRegistry() Registry {
+	return NewRegistry(middleware.NewRateLimits(clock.RealClock{}, nil))
+}
+
+// NewRegistry returns a new client registry whose clients keep track of rate
+// limits reported by ACME servers using the given RateLimits.
+func NewRegistry(rateLimits *middleware.RateLimits) Registry {
 	return &registry{
-		clients: make(map[string]clientWithMeta),
+		clients:    make(map[string]clientWithMeta),
+		rateLimits: rateLimits,
 	}
 }
 
@@ -78,6 +102,9 @@ type registry struct {
 
 	// a map of an issuer's 'uid' to an ACME client with metadata
 	clients map[string]clientWithMeta
+
+	// rate limits reported by ACME servers, shared by all clients
+	rateLimits *middleware.RateLimits
 }
 
 // stableOptions contains data about an ACME client that can be used to compare
@@ -88,7 +115,6 @@ type stableOptions struct {
 	skipVerifyTLS bool
 	issuerUID     string
 	publicKey     string
-	exponent      int
 	caBundle      string
 	keyChecksum   [sha256.Size]byte
 }
@@ -97,22 +123,45 @@ func (c stableOptions) equalTo(c2 stableOptions) bool {
 	return c == c2
 }
 
-func newStableOptions(uid string, config cmacme.ACMEIssuer, privateKey *rsa.PrivateKey) stableOptions {
-	// Encoding a big.Int cannot fail
-	publicNBytes, _ := privateKey.PublicKey.N.GobEncode()
-	checksum := sha256.Sum256(x509.MarshalPKCS1PrivateKey(privateKey))
+func newStableOptions(uid string, config cmacme.ACMEIssuer, privateKey crypto.Signer) stableOptions {
+	// The key type is validated before a client is added to the registry, so
+	// encoding the key cannot fail here.
+	publicKeyBytes, _ := x509.MarshalPKIXPublicKey(privateKey.Public())
+	privateKeyBytes, _ := marshalPrivateKey(privateKey)
+	checksum := sha256.Sum256(privateKeyBytes)
 
 	return stableOptions{
 		serverURL:     config.Server,
 		skipVerifyTLS: config.SkipTLSVerify,
 		issuerUID:     uid,
-		publicKey:     string(publicNBytes),
-		exponent:      privateKey.PublicKey.E,
+		publicKey:     string(publicKeyBytes),
 		caBundle:      string(config.CABundle),
 		keyChecksum:   checksum,
 	}
 }
 
+// marshalPrivateKey returns the DER encoding of the given private key.
+// RSA keys are encoded using PKCS#1 so that checksums of existing RSA account
+// keys remain stable; all other key types are encoded using PKCS#8.
+func marshalPrivateKey(privateKey crypto.Signer) ([]byte, error) {
+	if rsaKey, ok := privateKey.(*rsa.PrivateKey); ok {
+		return x509.MarshalPKCS1PrivateKey(rsaKey), nil
+	}
+	return x509.MarshalPKCS8PrivateKey(privateKey)
+}
+
+// PrivateKeyChecksum returns the base64 encoded SHA-256 checksum of the given
+// ACME account private key, as stored in the LastPrivateKeyHash field of the
+// ACME issuer status.
+func PrivateKeyChecksum(privateKey crypto.Signer) (string, error) {
+	privateKeyBytes, err := marshalPrivateKey(privateKey)
+	if err != nil {
+		return "", err
+	}
+	checksum := sha256.Sum256(privateKeyBytes)
+	return base64.StdEncoding.EncodeToString(checksum[:]), nil
+}
+
 // clientWithMeta wraps an ACME client with additional metadata used to
 // identify the options used to instantiate the client.
 type clientWithMeta struct {
@@ -123,7 +172,7 @@ type clientWithMeta struct {
 
 // AddClient will ensure the registry has a stored ACME client for the Issuer
 // object with the given UID, configuration and private key.
-func (r *registry) AddClient(httpClient *http.Client, uid string, config cmacme.ACMEIssuer, privateKey *rsa.PrivateKey, userAgent string) {
+func (r *registry) AddClient(httpClient *http.Client, uid string, config cmacme.ACMEIssuer, privateKey crypto.Signer, userAgent string) {
 	// ensure the client is up to date for the current configuration
 	r.ensureClient(httpClient, uid, config, privateKey, userAgent)
 }
@@ -133,7 +182,7 @@ func (r *registry) AddClient(httpClient *http.Client, uid string, config cmacme.
 // the client will NOT be mutated or replaced, allowing this method to be called
 // even if the client does not need replacing/updating without causing issues for
 // consumers of the registry.
-func (r *registry) ensureClient(httpClient *http.Client, uid string, config cmacme.ACMEIssuer, privateKey *rsa.PrivateKey, userAgent string) {
+func (r *registry) ensureClient(httpClient *http.Client, uid string, config cmacme.ACMEIssuer, privateKey crypto.Signer, userAgent string) {
 	// acquire a read-write lock even if we hit the fast-path where the client
 	// is already present to avoid having to RLock, RUnlock and Lock again,
 	// which could itself cause a race
@@ -149,7 +198,7 @@ func (r *registry) ensureClient(httpClient *http.Client, uid string, config cmac
 	// create a new client if one is not registered or if the
 	// 'metadata' does not match
 	r.clients[uid] = clientWithMeta{
-		Interface:     NewClient(httpClient, config, privateKey, userAgent),
+		Interface:     middleware.NewRateLimiter(NewClient(httpClient, config, privateKey, userAgent), r.rateLimits, config.Server, privateKey),
 		stableOptions: newOpts,
 	}
 }
@@ -196,19 +245,15 @@ func (r *registry) ListClients() map[string]acmecl.Interface {
 // IsKeyCheckSumCached returns true when there is no difference in private key checksum.
 // This can be used to identify if the private key has changed for the existing
 // registered client.
-func (r *registry) IsKeyCheckSumCached(lastPrivateKeyHash string, privateKey *rsa.PrivateKey) bool {
+func (r *registry) IsKeyCheckSumCached(lastPrivateKeyHash string, privateKey crypto.Signer) bool {
 	r.lock.RLock()
 	defer r.lock.RUnlock()
 
 	if privateKey != nil && lastPrivateKeyHash != "" {
-		privateKeyBytes := x509.MarshalPKCS1PrivateKey(privateKey)
-		checksum := sha256.Sum256(privateKeyBytes)
-		checksumString := base64.StdEncoding.EncodeToString(checksum[:])
-
-		if lastPrivateKeyHash == checksumString {
+		checksumString, err := PrivateKeyChecksum(privateKey)
+		if err == nil && lastPrivateKeyHash == checksumString {
 			return true
 		}
-
 	}
 
 	// Either there is no entry found in client cache for uid
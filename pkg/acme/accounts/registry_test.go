@@ -17,6 +17,7 @@ limitations under the License.
 package accounts
 
 import (
+	"crypto"
 	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
@@ -181,3 +182,75 @@ func TestRegistry_AddClient_UpdatesClientPKChecksum(t *testing.T) {
 		t.Fatal("checksum reported same for different keys")
 	}
 }
+
+func TestRegistry_AddClient_NonRSAKeys(t *testing.T) {
+	ecPK, err := pki.GenerateECPrivateKey(pki.ECCurve384)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edPK, err := pki.GenerateEd25519PrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, pk := range map[string]crypto.Signer{"ecdsa": ecPK, "ed25519": edPK} {
+		t.Run(name, func(t *testing.T) {
+			r := NewDefaultRegistry()
+			r.AddClient(http.DefaultClient, "abc", cmacme.ACMEIssuer{}, pk, "cert-manager-test")
+			if _, err := r.GetClient("abc"); err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			pkBytes, err := x509.MarshalPKCS8PrivateKey(pk)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pkChecksum := sha256.Sum256(pkBytes)
+			pkChecksumString := base64.StdEncoding.EncodeToString(pkChecksum[:])
+
+			checksum, err := PrivateKeyChecksum(pk)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if checksum != pkChecksumString {
+				t.Errorf("expected checksum %q but got %q", pkChecksumString, checksum)
+			}
+			if !r.IsKeyCheckSumCached(pkChecksumString, pk) {
+				t.Fatal("checksum failed for same key")
+			}
+		})
+	}
+}
+
+func TestClientID(t *testing.T) {
+	config := cmacme.ACMEIssuer{
+		Server: "https://primary.example.com/directory",
+		FallbackServers: []cmacme.ACMEFallbackServer{
+			{Server: "https://fallback.example.com/directory"},
+		},
+	}
+
+	tests := map[string]struct {
+		server string
+		want   string
+	}{
+		"empty server refers to the primary server": {
+			want: "abc",
+		},
+		"primary server": {
+			server: "https://primary.example.com/directory",
+			want:   "abc",
+		},
+		"fallback server": {
+			server: "https://fallback.example.com/directory",
+			want:   "abc/https://fallback.example.com/directory",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := ClientID("abc", &config, test.server); got != test.want {
+				t.Errorf("expected client ID %q but got %q", test.want, got)
+			}
+		})
+	}
+}
@@ -18,9 +18,10 @@ package client
 
 import (
 	"context"
+	"crypto"
 	"fmt"
 
-	"golang.org/x/crypto/acme"
+	"github.com/cert-manager/cert-manager/third_party/forked/acme"
 )
 
 // TODO: expand this out one day to be backed by the pebble wfe package
@@ -44,6 +45,10 @@ type FakeACME struct {
 	FakeDNS01ChallengeRecord    func(token string) (string, error)
 	FakeDiscover                func(ctx context.Context) (acme.Directory, error)
 	FakeUpdateReg               func(ctx context.Context, a *acme.Account) (*acme.Account, error)
+	FakeGetRenewalInfo          func(ctx context.Context, certID string) (*acme.RenewalInfo, error)
+	FakeRevokeCert              func(ctx context.Context, key crypto.Signer, cert []byte, reason acme.CRLReasonCode) error
+	FakeAccountKeyRollover      func(ctx context.Context, newKey crypto.Signer) error
+	FakeDeactivateReg           func(ctx context.Context) error
 }
 
 var _ Interface = &FakeACME{}
@@ -161,3 +166,32 @@ func (f *FakeACME) ListCertAlternates(ctx context.Context, url string) ([]string
 	}
 	return nil, fmt.Errorf("ListCertAlternates not implemented")
 }
+
+func (f *FakeACME) GetRenewalInfo(ctx context.Context, certID string) (*acme.RenewalInfo, error) {
+	if f.FakeGetRenewalInfo != nil {
+		return f.FakeGetRenewalInfo(ctx, certID)
+	}
+	// Behave like an ACME server without ARI support by default.
+	return nil, acme.ErrRenewalInfoUnsupported
+}
+
+func (f *FakeACME) RevokeCert(ctx context.Context, key crypto.Signer, cert []byte, reason acme.CRLReasonCode) error {
+	if f.FakeRevokeCert != nil {
+		return f.FakeRevokeCert(ctx, key, cert, reason)
+	}
+	return fmt.Errorf("RevokeCert not implemented")
+}
+
+func (f *FakeACME) AccountKeyRollover(ctx context.Context, newKey crypto.Signer) error {
+	if f.FakeAccountKeyRollover != nil {
+		return f.FakeAccountKeyRollover(ctx, newKey)
+	}
+	return fmt.Errorf("AccountKeyRollover not implemented")
+}
+
+func (f *FakeACME) DeactivateReg(ctx context.Context) error {
+	if f.FakeDeactivateReg != nil {
+		return f.FakeDeactivateReg(ctx)
+	}
+	return fmt.Errorf("DeactivateReg not implemented")
+}
@@ -18,17 +18,19 @@ package client
 
 import (
 	"context"
-
-	"golang.org/x/crypto/acme"
+	"crypto"
 
 	acmeutil "github.com/cert-manager/cert-manager/pkg/acme/util"
+	"github.com/cert-manager/cert-manager/third_party/forked/acme"
 )
 
 // Interface is an Automatic Certificate Management Environment (ACME) client
 // implementing an Order-based flow.
 //
 // For more information see https://pkg.go.dev/golang.org/x/crypto/acme#Client
-// and RFC 8555 (https://tools.ietf.org/html/rfc8555).
+// (of which third_party/forked/acme is a fork), RFC 8555
+// (https://tools.ietf.org/html/rfc8555) and RFC 9773
+// (https://www.rfc-editor.org/rfc/rfc9773).
 type Interface interface {
 	AuthorizeOrder(ctx context.Context, id []acme.AuthzID, opt ...acme.OrderOption) (*acme.Order, error)
 	GetOrder(ctx context.Context, url string) (*acme.Order, error)
@@ -57,6 +59,24 @@ type Interface interface {
 	DNS01ChallengeRecord(token string) (string, error)
 	Discover(ctx context.Context) (acme.Directory, error)
 	UpdateReg(ctx context.Context, a *acme.Account) (*acme.Account, error)
+	// GetRenewalInfo will be called to retrieve the ACME Renewal Information
+	// (RFC 9773) for an issued certificate, identified by its ARI
+	// certificate ID.
+	// acme.ErrRenewalInfoUnsupported is returned if the ACME server does
+	// not support ARI.
+	GetRenewalInfo(ctx context.Context, certID string) (*acme.RenewalInfo, error)
+	// RevokeCert will be called to revoke a certificate, provided in DER
+	// format. If key is nil, the request is signed using the account key.
+	RevokeCert(ctx context.Context, key crypto.Signer, cert []byte, reason acme.CRLReasonCode) error
+	// AccountKeyRollover will be called to replace the account key with
+	// newKey (RFC 8555 section 7.3.5). Subsequent requests made by the
+	// client are signed using newKey.
+	AccountKeyRollover(ctx context.Context, newKey crypto.Signer) error
+	// DeactivateReg will be called to deactivate the ACME account when its
+	// Issuer is deleted (RFC 8555 section 7.3.6).
+	// acme.ErrNoAccount is returned if the account does not exist or has
+	// already been deactivated.
+	DeactivateReg(ctx context.Context) error
 }
 
 var _ Interface = &acme.Client{
@@ -18,12 +18,13 @@ package middleware
 
 import (
 	"context"
+	"crypto"
 
 	"github.com/go-logr/logr"
-	"golang.org/x/crypto/acme"
 
 	"github.com/cert-manager/cert-manager/pkg/acme/client"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/third_party/forked/acme"
 )
 
 func NewLogger(baseCl client.Interface) client.Interface {
@@ -136,3 +137,27 @@ func (l *Logger) UpdateReg(ctx context.Context, a *acme.Account) (*acme.Account,
 
 	return l.baseCl.UpdateReg(ctx, a)
 }
+
+func (l *Logger) GetRenewalInfo(ctx context.Context, certID string) (*acme.RenewalInfo, error) {
+	l.log.V(logf.TraceLevel).Info("Calling GetRenewalInfo")
+
+	return l.baseCl.GetRenewalInfo(ctx, certID)
+}
+
+func (l *Logger) RevokeCert(ctx context.Context, key crypto.Signer, cert []byte, reason acme.CRLReasonCode) error {
+	l.log.V(logf.TraceLevel).Info("Calling RevokeCert")
+
+	return l.baseCl.RevokeCert(ctx, key, cert, reason)
+}
+
+func (l *Logger) AccountKeyRollover(ctx context.Context, newKey crypto.Signer) error {
+	l.log.V(logf.TraceLevel).Info("Calling AccountKeyRollover")
+
+	return l.baseCl.AccountKeyRollover(ctx, newKey)
+}
+
+func (l *Logger) DeactivateReg(ctx context.Context) error {
+	l.log.V(logf.TraceLevel).Info("Calling DeactivateReg")
+
+	return l.baseCl.DeactivateReg(ctx)
+}
@@ -0,0 +1,262 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/publicsuffix"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+
+	"github.com/cert-manager/cert-manager/pkg/acme/client"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+	"github.com/cert-manager/cert-manager/third_party/forked/acme"
+)
+
+const (
+	// rateLimitedProblemType is the ACME problem type returned by ACME servers
+	// if a request exceeds one of their rate limits.
+	rateLimitedProblemType = "urn:ietf:params:acme:error:rateLimited"
+
+	// defaultRateLimitRetryAfter is the duration for which requests are
+	// deferred if the ACME server did not specify when a rate limit is reset.
+	defaultRateLimitRetryAfter = 15 * time.Minute
+)
+
+// RateLimits keeps track of the rate limits that ACME servers reported for
+// ACME accounts, both for the account as a whole and for individual
+// registered domains.
+// A single RateLimits should be shared by all ACME clients, so that issuers
+// using the same ACME account also share its rate limits.
+type RateLimits struct {
+	clock   clock.Clock
+	metrics *metrics.Metrics
+
+	lock sync.Mutex
+	// a map of each rate limited account and registered domain to the time
+	// at which the rate limit is reset
+	limits map[rateLimitKey]time.Time
+}
+
+// rateLimitKey identifies a rate limit of an ACME account.
+// An empty registeredDomain refers to a rate limit for the whole account.
+type rateLimitKey struct {
+	host             string
+	account          string
+	registeredDomain string
+}
+
+// NewRateLimits returns a new RateLimits. If metrics is not nil, the times at
+// which rate limits are reset are exposed as a Prometheus gauge.
+func NewRateLimits(clock clock.Clock, metrics *metrics.Metrics) *RateLimits {
+	return &RateLimits{
+		clock:   clock,
+		metrics: metrics,
+		limits:  make(map[rateLimitKey]time.Time),
+	}
+}
+
+// check returns a rateLimited ACME error if any of the given keys is
+// currently rate limited. Rate limits that have been reset are forgotten.
+func (l *RateLimits) check(keys ...rateLimitKey) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.clock.Now()
+	for key, reset := range l.limits {
+		if !now.Before(reset) {
+			l.forget(key)
+		}
+	}
+
+	var limited rateLimitKey
+	var until time.Time
+	for _, key := range keys {
+		if reset, ok := l.limits[key]; ok && reset.After(until) {
+			limited, until = key, reset
+		}
+	}
+	if until.IsZero() {
+		return nil
+	}
+
+	scope := "the ACME account"
+	if limited.registeredDomain != "" {
+		scope = fmt.Sprintf("registered domain %q", limited.registeredDomain)
+	}
+	return &acme.Error{
+		StatusCode:  http.StatusTooManyRequests,
+		ProblemType: rateLimitedProblemType,
+		Detail:      fmt.Sprintf("request not sent as the ACME server rate limited %s until %s", scope, until.UTC().Format(time.RFC3339)),
+		Header:      retryAfterHeader(until.Sub(now)),
+	}
+}
+
+// record records a rate limit for the given keys if err is a rateLimited ACME
+// error. If the ACME server did not specify when the rate limit is reset, a
+// default is assumed and added to the error's Retry-After header.
+func (l *RateLimits) record(err error, keys ...rateLimitKey) {
+	retryAfter, ok := acme.RateLimit(err)
+	if !ok {
+		return
+	}
+	if retryAfter <= 0 {
+		retryAfter = defaultRateLimitRetryAfter
+		if acmeErr, ok := err.(*acme.Error); ok {
+			if acmeErr.Header == nil {
+				acmeErr.Header = http.Header{}
+			}
+			acmeErr.Header.Set("Retry-After", retryAfterHeader(retryAfter).Get("Retry-After"))
+		}
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	reset := l.clock.Now().Add(retryAfter)
+	for _, key := range keys {
+		if existing, ok := l.limits[key]; ok && existing.After(reset) {
+			continue
+		}
+		l.limits[key] = reset
+		if l.metrics != nil {
+			l.metrics.UpdateACMERateLimitResetTime(reset, key.host, key.account, key.registeredDomain)
+		}
+	}
+}
+
+// forget removes the rate limit for the given key. The lock must be held by
+// the caller.
+func (l *RateLimits) forget(key rateLimitKey) {
+	delete(l.limits, key)
+	if l.metrics != nil {
+		l.metrics.RemoveACMERateLimitResetTime(key.host, key.account, key.registeredDomain)
+	}
+}
+
+func retryAfterHeader(d time.Duration) http.Header {
+	seconds := int(math.Ceil(d.Seconds()))
+	return http.Header{"Retry-After": []string{strconv.Itoa(seconds)}}
+}
+
+// NewRateLimiter returns an ACME client that defers requests which would
+// exceed a rate limit previously reported by the ACME server for the account
+// of the given private key.
+func NewRateLimiter(baseCl client.Interface, limits *RateLimits, server string, privateKey crypto.Signer) client.Interface {
+	host := server
+	if u, err := url.Parse(server); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	// The key type is validated before a client is constructed, so the
+	// thumbprint can always be computed.
+	account, _ := acme.JWKThumbprint(privateKey.Public())
+
+	return &RateLimiter{
+		Interface: baseCl,
+		limits:    limits,
+		host:      host,
+		account:   account,
+	}
+}
+
+// RateLimiter is a middleware for an ACME client that keeps track of the
+// rate limits reported by the ACME server.
+// Requests to create orders for a registered domain, or to finalize orders
+// and accept challenges using an account, that is currently rate limited
+// fail immediately with a rateLimited ACME error, without being sent to the
+// ACME server.
+type RateLimiter struct {
+	client.Interface
+
+	limits  *RateLimits
+	host    string
+	account string
+}
+
+var _ client.Interface = &RateLimiter{}
+
+func (r *RateLimiter) AuthorizeOrder(ctx context.Context, id []acme.AuthzID, opt ...acme.OrderOption) (*acme.Order, error) {
+	var keys []rateLimitKey
+	for _, domain := range registeredDomains(id) {
+		keys = append(keys, r.key(domain))
+	}
+	if err := r.limits.check(append(keys, r.key(""))...); err != nil {
+		return nil, err
+	}
+
+	order, err := r.Interface.AuthorizeOrder(ctx, id, opt...)
+	// Most rate limits reported when creating an order apply to the
+	// identifiers of the order, rather than to the whole account.
+	r.limits.record(err, keys...)
+	return order, err
+}
+
+func (r *RateLimiter) CreateOrderCert(ctx context.Context, finalizeURL string, csr []byte, bundle bool) ([][]byte, string, error) {
+	if err := r.limits.check(r.key("")); err != nil {
+		return nil, "", err
+	}
+
+	der, certURL, err := r.Interface.CreateOrderCert(ctx, finalizeURL, csr, bundle)
+	r.limits.record(err, r.key(""))
+	return der, certURL, err
+}
+
+func (r *RateLimiter) Accept(ctx context.Context, chal *acme.Challenge) (*acme.Challenge, error) {
+	if err := r.limits.check(r.key("")); err != nil {
+		return nil, err
+	}
+
+	chal, err := r.Interface.Accept(ctx, chal)
+	r.limits.record(err, r.key(""))
+	return chal, err
+}
+
+func (r *RateLimiter) key(registeredDomain string) rateLimitKey {
+	return rateLimitKey{
+		host:             r.host,
+		account:          r.account,
+		registeredDomain: registeredDomain,
+	}
+}
+
+// registeredDomains returns the registered domains, i.e. the public suffix
+// plus one label, of the given identifiers. IP address identifiers are
+// returned as is.
+func registeredDomains(ids []acme.AuthzID) []string {
+	domains := sets.New[string]()
+	for _, id := range ids {
+		name := strings.ToLower(id.Value)
+		if id.Type == "dns" {
+			name = strings.TrimPrefix(name, "*.")
+			if domain, err := publicsuffix.EffectiveTLDPlusOne(name); err == nil {
+				name = domain
+			}
+		}
+		domains.Insert(name)
+	}
+	return sets.List(domains)
+}
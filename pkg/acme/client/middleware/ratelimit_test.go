@@ -0,0 +1,117 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net/http"
+	"testing"
+	"time"
+
+	fakeclock "k8s.io/utils/clock/testing"
+
+	"github.com/cert-manager/cert-manager/pkg/acme/client"
+	"github.com/cert-manager/cert-manager/third_party/forked/acme"
+)
+
+func TestRateLimiter(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rateLimited := func() error {
+		return &acme.Error{
+			StatusCode:  http.StatusTooManyRequests,
+			ProblemType: rateLimitedProblemType,
+		}
+	}
+
+	clock := fakeclock.NewFakeClock(time.Now())
+	var calls int
+	cl := NewRateLimiter(&client.FakeACME{
+		FakeAuthorizeOrder: func(_ context.Context, id []acme.AuthzID, _ ...acme.OrderOption) (*acme.Order, error) {
+			calls++
+			if id[0].Value == "www.example.com" {
+				return nil, rateLimited()
+			}
+			return &acme.Order{}, nil
+		},
+		FakeCreateOrderCert: func(context.Context, string, []byte, bool) ([][]byte, string, error) {
+			calls++
+			return nil, "", rateLimited()
+		},
+	}, NewRateLimits(clock, nil), "https://acme.example.com/directory", privateKey)
+
+	ctx := context.Background()
+	_, err = cl.AuthorizeOrder(ctx, acme.DomainIDs("www.example.com"))
+	if retryAfter, ok := acme.RateLimit(err); !ok || retryAfter != defaultRateLimitRetryAfter {
+		t.Fatalf("expected rateLimited error with the default Retry-After, got: %v (retry after %s)", err, retryAfter)
+	}
+
+	// other names of the same registered domain are deferred without
+	// sending a request to the ACME server
+	_, err = cl.AuthorizeOrder(ctx, acme.DomainIDs("*.example.com", "other.org"))
+	if _, ok := acme.RateLimit(err); !ok {
+		t.Errorf("expected rateLimited error for the same registered domain, got: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 request to the ACME server, got %d", calls)
+	}
+
+	// other registered domains are not affected
+	if _, err := cl.AuthorizeOrder(ctx, acme.DomainIDs("other.org")); err != nil {
+		t.Errorf("expected no error for another registered domain, got: %v", err)
+	}
+
+	// rate limits for the whole account apply to all orders
+	if _, _, err := cl.CreateOrderCert(ctx, "https://acme.example.com/finalize", nil, false); err == nil {
+		t.Fatal("expected an error finalizing the order")
+	}
+	_, err = cl.AuthorizeOrder(ctx, acme.DomainIDs("other.org"))
+	if _, ok := acme.RateLimit(err); !ok {
+		t.Errorf("expected rateLimited error once the account is rate limited, got: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 requests to the ACME server, got %d", calls)
+	}
+
+	// requests are sent again once the rate limits are reset
+	clock.Step(defaultRateLimitRetryAfter)
+	if _, err := cl.AuthorizeOrder(ctx, acme.DomainIDs("other.org")); err != nil {
+		t.Errorf("expected no error once the rate limit is reset, got: %v", err)
+	}
+	if calls != 4 {
+		t.Errorf("expected 4 requests to the ACME server, got %d", calls)
+	}
+}
+
+func TestRegisteredDomains(t *testing.T) {
+	ids := append(acme.DomainIDs("www.example.com", "*.Example.com", "foo.bar.co.uk"), acme.IPIDs("10.0.0.1")...)
+	got := registeredDomains(ids)
+	want := []string{"10.0.0.1", "bar.co.uk", "example.com"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	}
+}
@@ -17,10 +17,21 @@ limitations under the License.
 package acme
 
 import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"sync"
+
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
 )
 
 // IsFinalState will return true if the given ACME State is a 'final' state.
@@ -42,6 +53,84 @@ func IsFailureState(s cmacme.State) bool {
 	return false
 }
 
+// AccountURI returns the URI of the issuer's ACME account that is registered
+// with the given ACME server. An empty server refers to the server specified
+// in spec.acme.server.
+func AccountURI(issuer cmapi.GenericIssuer, server string) string {
+	status := issuer.GetStatus().ACME
+	if status == nil {
+		return ""
+	}
+	if server == "" || server == issuer.GetSpec().ACME.Server {
+		return status.URI
+	}
+	for _, account := range status.FallbackAccounts {
+		if account.Server == server {
+			return account.URI
+		}
+	}
+	return ""
+}
+
+// ServerForCertificate returns the ACME server that the given certificate was
+// issued by, as recorded in the status of the Order among the given Orders
+// that the certificate was issued for. It returns false if the certificate was
+// not issued for any of the Orders.
+func ServerForCertificate(orders []*cmacme.Order, cert *x509.Certificate) (string, bool) {
+	for _, o := range orders {
+		if o.Status.Server == "" || len(o.Status.Certificate) == 0 {
+			continue
+		}
+		block, _ := pem.Decode(o.Status.Certificate)
+		if block != nil && bytes.Equal(block.Bytes, cert.Raw) {
+			return o.Status.Server, true
+		}
+	}
+	return "", false
+}
+
+// CheckPerspectives runs the given self check from each of the named network
+// perspectives concurrently and records the results in the status of the
+// challenge. It returns an error unless the check passed from at least quorum
+// perspectives, or from all of them if quorum is nil.
+func CheckPerspectives(ctx context.Context, ch *cmacme.Challenge, names []string, quorum *int32, check func(ctx context.Context, i int) error) error {
+	errs := make([]error, len(names))
+	var wg sync.WaitGroup
+	for i := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = check(ctx, i)
+		}()
+	}
+	wg.Wait()
+
+	required := len(names)
+	if quorum != nil {
+		required = int(*quorum)
+	}
+
+	passed := 0
+	var failures []string
+	results := make([]cmacme.ChallengeSelfCheckPerspective, len(names))
+	for i, name := range names {
+		results[i] = cmacme.ChallengeSelfCheckPerspective{Name: name, Passed: errs[i] == nil}
+		if errs[i] != nil {
+			results[i].Reason = errs[i].Error()
+			failures = append(failures, fmt.Sprintf("%s: %v", name, errs[i]))
+			continue
+		}
+		passed++
+	}
+	ch.Status.SelfCheckPerspectives = results
+
+	if passed < required {
+		return fmt.Errorf("self check passed from %d of %d perspectives, but %d are required: %s",
+			passed, len(names), required, strings.Join(failures, "; "))
+	}
+	return nil
+}
+
 // PrivateKeySelector will default the SecretKeySelector with a default secret key
 // if one is not already specified.
 func PrivateKeySelector(sel cmmeta.SecretKeySelector) cmmeta.SecretKeySelector {
@@ -50,3 +139,16 @@ func PrivateKeySelector(sel cmmeta.SecretKeySelector) cmmeta.SecretKeySelector {
 	}
 	return sel
 }
+
+// ValidateProfile returns an error if the given ACME certificate profile is
+// not advertised in the metadata of the ACME server's directory.
+func ValidateProfile(dir acmeapi.Directory, profile string) error {
+	if _, ok := dir.Profiles[profile]; ok {
+		return nil
+	}
+	if len(dir.Profiles) == 0 {
+		return fmt.Errorf("the ACME server does not support certificate profiles, but profile %q was requested", profile)
+	}
+	return fmt.Errorf("profile %q is not offered by the ACME server, supported profiles are: %s",
+		profile, strings.Join(sets.List(sets.KeySet(dir.Profiles)), ", "))
+}
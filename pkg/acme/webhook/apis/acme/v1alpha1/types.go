@@ -55,7 +55,9 @@ type ChallengeRequest struct {
 	Action ChallengeAction `json:"action"`
 
 	// Type is the type of ACME challenge.
-	// Only dns-01 is currently supported.
+	// Only dns-01 and dns-account-01 are currently supported.
+	// The TXT record of both challenge types must be presented at the
+	// ResolvedFQDN.
 	Type string `json:"type"`
 
 	// DNSName is the name of the domain that is actually being validated, as
@@ -81,7 +83,9 @@ type ChallengeRequest struct {
 	// ResolvedFQDN is the fully-qualified domain name that should be
 	// updated/presented after resolving all CNAMEs.
 	// This should be honoured when using the DNS01 solver type.
-	// This will be of the form '_acme-challenge.example.com.'.
+	// This will be of the form '_acme-challenge.example.com.' for dns-01
+	// challenges, and '_<account label>._acme-challenge.example.com.' for
+	// dns-account-01 challenges.
 	// +optional
 	ResolvedFQDN string `json:"resolvedFQDN,omitempty"`
 
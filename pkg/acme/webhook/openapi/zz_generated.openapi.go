@@ -182,7 +182,7 @@ func schema_webhook_apis_acme_v1alpha1_ChallengeRequest(ref common.ReferenceCall
 					},
 					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of ACME challenge. Only dns-01 is currently supported.",
+							Description: "Type is the type of ACME challenge. Only dns-01 and dns-account-01 are currently supported. The TXT record of both challenge types must be presented at the ResolvedFQDN.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -214,7 +214,7 @@ func schema_webhook_apis_acme_v1alpha1_ChallengeRequest(ref common.ReferenceCall
 					},
 					"resolvedFQDN": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResolvedFQDN is the fully-qualified domain name that should be updated/presented after resolving all CNAMEs. This should be honoured when using the DNS01 solver type. This will be of the form '_acme-challenge.example.com.'.",
+							Description: "ResolvedFQDN is the fully-qualified domain name that should be updated/presented after resolving all CNAMEs. This should be honoured when using the DNS01 solver type. This will be of the form '_acme-challenge.example.com.' for dns-01 challenges, and '_<account label>._acme-challenge.example.com.' for dns-account-01 challenges.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
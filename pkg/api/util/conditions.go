@@ -285,12 +285,13 @@ func CertificateRequestHasCondition(cr *cmapi.CertificateRequest, c cmapi.Certif
 }
 
 // This returns the status reason of a CertificateRequest. The order of reason
-// hierarchy is 'Failed' -> 'Ready' -> 'Pending' -> ”
+// hierarchy is 'Failed' -> 'Ready' -> 'Pending' -> 'RateLimited' -> ”
 func CertificateRequestReadyReason(cr *cmapi.CertificateRequest) string {
 	for _, reason := range []string{
 		cmapi.CertificateRequestReasonFailed,
 		cmapi.CertificateRequestReasonIssued,
 		cmapi.CertificateRequestReasonPending,
+		cmapi.CertificateRequestReasonRateLimited,
 		cmapi.CertificateRequestReasonDenied,
 	} {
 		for _, con := range cr.Status.Conditions {
@@ -49,6 +49,33 @@ const (
 	// SolverIdentificationLabelKey is added to the labels of a Pod serving an ACME challenge.
 	// Its value will be the "true" if the Pod is an HTTP-01 solver.
 	SolverIdentificationLabelKey = "acme.cert-manager.io/http01-solver"
+
+	// ACMEReplacesAnnotationKey is added to CertificateRequest and Order
+	// resources to indicate which previously issued certificate a new ACME
+	// order is intended to replace.
+	// Its value is the ACME Renewal Information (ARI, RFC 9773) certificate
+	// identifier of the certificate being replaced, which is sent to the ACME
+	// server as the `replaces` field of the new order.
+	ACMEReplacesAnnotationKey = "acme.cert-manager.io/replaces"
+
+	// ACMEProfileAnnotationKey is added to CertificateRequest resources to
+	// record the ACME certificate profile requested by the Certificate's
+	// `spec.acme.profile` field, or otherwise by the `profile` field of its
+	// ACME Issuer at the time the request was created.
+	// If set, it takes precedence over the profile configured on the ACME
+	// Issuer.
+	ACMEProfileAnnotationKey = "acme.cert-manager.io/profile"
+
+	// ACMEChallengeSchedulingWeightAnnotationKey can be set on Certificate or
+	// Order resources to set the weight of their Challenges when the
+	// challenge scheduler orders the Challenges of a namespace.
+	// Its value must be an integer between 1 and 100; Challenges with a
+	// higher weight are scheduled before the other Challenges of their
+	// namespace. It does not change the share of the available challenge
+	// slots given to the namespace, which is set by the controller's
+	// --challenge-namespace-weights flag.
+	// Challenges without (or with an invalid) weight have a weight of 1.
+	ACMEChallengeSchedulingWeightAnnotationKey = "acme.cert-manager.io/challenge-scheduling-weight"
 )
 
 const (
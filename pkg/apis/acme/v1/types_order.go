@@ -82,6 +82,12 @@ type OrderSpec struct {
 	// this is set on order creation as pe the ACME spec.
 	// +optional
 	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Profile is the name of the ACME certificate profile to request when
+	// creating the order, as defined in draft-ietf-acme-profiles.
+	// If not set, the ACME server's default profile is used.
+	// +optional
+	Profile string `json:"profile,omitempty"`
 }
 
 type OrderStatus struct {
@@ -97,6 +103,28 @@ type OrderStatus struct {
 	// +optional
 	FinalizeURL string `json:"finalizeURL,omitempty"`
 
+	// Server is the URL of the ACME server 'directory' endpoint that the
+	// order was submitted to.
+	// This is either the `server` or one of the `fallbackServers` of the
+	// ACME Issuer.
+	// +optional
+	Server string `json:"server,omitempty"`
+
+	// FailedFinalizeAttempts is the number of times finalizing the order
+	// failed because of an error on the ACME server.
+	// If the ACME Issuer has fallback servers configured, the Order is
+	// deleted after repeated failures so that a new order for the same
+	// CertificateRequest is submitted to another ACME server. If no other
+	// server is available, the Order is marked as failed instead.
+	// +optional
+	FailedFinalizeAttempts int `json:"failedFinalizeAttempts,omitempty"`
+
+	// RateLimitedUntil is set if the ACME server rate limited requests for
+	// this order. Processing of the order is deferred until this time, after
+	// which the field is cleared.
+	// +optional
+	RateLimitedUntil *metav1.Time `json:"rateLimitedUntil,omitempty"`
+
 	// Authorizations contains data returned from the ACME server on what
 	// authorizations must be completed in order to validate the DNS names
 	// specified on the Order.
@@ -156,12 +184,34 @@ type ACMEAuthorization struct {
 	// +optional
 	InitialState State `json:"initialState,omitempty"`
 
+	// Expires is the time at which the authorization expires, as reported by
+	// the ACME server.
+	// +optional
+	Expires *metav1.Time `json:"expires,omitempty"`
+
+	// Cached will be true if the authorization was known to be valid from
+	// cert-manager's cache of the authorizations of the ACME account, so
+	// that it did not have to be fetched from the ACME server.
+	// This is only the case if the `ACMEAuthorizationCache` feature gate is
+	// enabled.
+	// +optional
+	Cached bool `json:"cached,omitempty"`
+
 	// Challenges specifies the challenge types offered by the ACME server.
 	// One of these challenge types will be selected when validating the DNS
 	// name and an appropriate Challenge resource will be created to perform
 	// the ACME challenge process.
 	// +optional
 	Challenges []ACMEChallenge `json:"challenges,omitempty"`
+
+	// AbandonedSolvers records the challenge solvers that were used to solve
+	// this authorization, but abandoned as their challenge did not pass its
+	// self check within the solver's selfCheckTimeout.
+	// Matching solvers are used in order of preference, so the solver used
+	// next is the one following the abandoned solvers.
+	// +optional
+	// +listType=atomic
+	AbandonedSolvers []ACMEAbandonedSolver `json:"abandonedSolvers,omitempty"`
 }
 
 // Challenge specifies a challenge offered by the ACME server for an Order.
@@ -184,6 +234,19 @@ type ACMEChallenge struct {
 	Type string `json:"type"`
 }
 
+// ACMEAbandonedSolver records a challenge solver which was abandoned as its
+// challenge did not pass its self check in time.
+type ACMEAbandonedSolver struct {
+	// Type of the challenge that was abandoned.
+	Type ACMEChallengeType `json:"type"`
+
+	// DNSName of the challenge that was abandoned.
+	DNSName string `json:"dnsName"`
+
+	// AbandonedTime is the time at which the solver was abandoned.
+	AbandonedTime metav1.Time `json:"abandonedTime"`
+}
+
 // State represents the state of an ACME resource, such as an Order.
 // The possible options here map to the corresponding values in the
 // ACME specification.
@@ -130,6 +130,43 @@ const (
 	// Annotation key used to set the PrivateKeyRotationPolicy for a Certificate.
 	// If unset a policy `Never` will be used.
 	PrivateKeyRotationPolicyAnnotationKey = "cert-manager.io/private-key-rotation-policy"
+
+	// Annotation key on a Certificate's Secret recording the time, in RFC3339
+	// format, until which the previous certificate and private key are kept
+	// in the Secret.
+	PreviousCertificateRetainedUntilAnnotationKey = "cert-manager.io/previous-certificate-retained-until"
+
+	// Annotation key on a Certificate's Secret recording the time, in RFC3339
+	// format, at which the private key stored in the Secret was created.
+	// It is managed by the issuing controller and used to rotate the private
+	// key once it is older than the Certificate's
+	// spec.privateKey.rotationMaxAge.
+	PrivateKeyCreationTimeAnnotationKey = "cert-manager.io/private-key-creation-time"
+
+	// Annotation key that can be added to a Certificate to request that its
+	// current certificate is revoked, for example because its private key has
+	// been compromised. The value is the reason for the revocation and must be
+	// one of `Unspecified`, `KeyCompromise`, `AffiliationChanged`,
+	// `Superseded` or `CessationOfOperation`; if empty, `Unspecified` is used.
+	// The annotation is removed once the revocation has been recorded in the
+	// Certificate's `status.revocations`. A new certificate is not issued
+	// automatically.
+	// Only honoured when the CertificateRevocation feature gate is enabled.
+	RevokeCertificateAnnotationKey = "cert-manager.io/revoke"
+)
+
+const (
+	// CertificateRevocationFinalizer is added to Certificates whose
+	// revocation policy asks for the current certificate to be revoked when
+	// the Certificate is deleted. It is removed once the revocation has been
+	// attempted.
+	CertificateRevocationFinalizer = "cert-manager.io/certificate-revocation"
+
+	// IssuerCleanupFinalizer is added to Issuers and ClusterIssuers whose
+	// configuration asks for external state, such as an ACME account, to be
+	// cleaned up when the issuer is deleted. It is removed once the cleanup
+	// has completed.
+	IssuerCleanupFinalizer = "cert-manager.io/issuer-cleanup"
 )
 
 const (
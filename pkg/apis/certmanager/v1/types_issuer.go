@@ -91,6 +91,12 @@ type IssuerList struct {
 // configuration required for the issuer.
 type IssuerSpec struct {
 	IssuerConfig `json:",inline"`
+
+	// RenewalWindow restricts the renewal of the certificates of all
+	// Certificates that reference this issuer, and do not define their own
+	// renewal window, to recurring maintenance windows.
+	// +optional
+	RenewalWindow *CertificateRenewalWindow `json:"renewalWindow,omitempty"`
 }
 
 // The configuration for the issuer.
@@ -370,6 +376,16 @@ type CAIssuer struct {
 	// As an example, such a URL might be "http://ca.domain.com/ca.crt".
 	// +optional
 	IssuingCertificateURLs []string `json:"issuingCertificateURLs,omitempty"`
+
+	// CRLSecretName is the name of a Secret in which cert-manager maintains a
+	// Certificate Revocation List (CRL), signed by the CA, listing the
+	// certificates revoked by this Issuer.
+	// The PEM encoded CRL is stored in the `ca.crl` key of the Secret and
+	// can be served at one of the `crlDistributionPoints`.
+	// The Secret is created in the same namespace as the CA Secret.
+	// Revoking certificates issued by this Issuer fails if not set.
+	// +optional
+	CRLSecretName string `json:"crlSecretName,omitempty"`
 }
 
 // IssuerStatus contains status information about an Issuer
@@ -44,6 +44,11 @@ func (in *ACMEDNS01Config) DeepCopyInto(out *ACMEDNS01Config) {
 		*out = new(sharedv1alpha1.Duration)
 		**out = **in
 	}
+	if in.EmbeddedServerZones != nil {
+		in, out := &in.EmbeddedServerZones, &out.EmbeddedServerZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -118,6 +123,26 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.CertificateRenewalJitter != nil {
+		in, out := &in.CertificateRenewalJitter, &out.CertificateRenewalJitter
+		*out = new(sharedv1alpha1.Duration)
+		**out = **in
+	}
+	if in.CertificateRenewalQPS != nil {
+		in, out := &in.CertificateRenewalQPS, &out.CertificateRenewalQPS
+		*out = new(float32)
+		**out = **in
+	}
+	if in.CertificateRenewalBurst != nil {
+		in, out := &in.CertificateRenewalBurst, &out.CertificateRenewalBurst
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CertificateHistoryLimit != nil {
+		in, out := &in.CertificateHistoryLimit, &out.CertificateHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
 	if in.EnableGatewayAPI != nil {
 		in, out := &in.EnableGatewayAPI, &out.EnableGatewayAPI
 		*out = new(bool)
@@ -138,6 +163,18 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.MaxConcurrentChallengesPerNamespace != nil {
+		in, out := &in.MaxConcurrentChallengesPerNamespace, &out.MaxConcurrentChallengesPerNamespace
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ChallengeNamespaceWeights != nil {
+		in, out := &in.ChallengeNamespaceWeights, &out.ChallengeNamespaceWeights
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	in.MetricsTLSConfig.DeepCopyInto(&out.MetricsTLSConfig)
 	if in.EnablePprof != nil {
 		in, out := &in.EnablePprof, &out.EnablePprof
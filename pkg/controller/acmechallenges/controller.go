@@ -28,6 +28,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
@@ -57,8 +58,9 @@ type controller struct {
 	// ACME challenge solvers are instantiated once at the time of controller
 	// construction.
 	// This also allows for easy mocking of the different challenge mechanisms.
-	dnsSolver  solver
-	httpSolver solver
+	dnsSolver     solver
+	httpSolver    solver
+	tlsALPNSolver solver
 	// scheduler marks challenges as Processing=true if they can be scheduled
 	// for processing. This job runs periodically every N seconds, so it cannot
 	// be constructed as a traditional controller.
@@ -74,6 +76,9 @@ type controller struct {
 	// logger to be used by this controller
 	log logr.Logger
 
+	// clock is used to record the time at which a challenge was presented
+	clock clock.Clock
+
 	dns01Nameservers []string
 
 	DNS01CheckRetryPeriod time.Duration
@@ -86,6 +91,7 @@ type controller struct {
 func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLimitingInterface[types.NamespacedName], []cache.InformerSynced, error) {
 	// construct a new named logger to be reused throughout the controller
 	c.log = logf.FromContext(ctx.RootContext, ControllerName)
+	c.clock = ctx.Clock
 
 	// create a queue used to queue up items to be processed
 	c.queue = workqueue.NewTypedRateLimitingQueueWithConfig(
@@ -140,7 +146,7 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLi
 	}
 
 	c.helper = issuer.NewHelper(c.issuerLister, c.clusterIssuerLister)
-	c.scheduler = scheduler.New(logf.NewContext(ctx.RootContext, c.log), c.challengeLister, ctx.SchedulerOptions.MaxConcurrentChallenges)
+	c.scheduler = scheduler.New(logf.NewContext(ctx.RootContext, c.log), c.challengeLister, ctx.Clock, ctx.Metrics, ctx.SchedulerOptions.MaxConcurrentChallenges, ctx.SchedulerOptions.MaxConcurrentChallengesPerNamespace, ctx.SchedulerOptions.ChallengeNamespaceWeights)
 	c.recorder = ctx.Recorder
 	c.accountRegistry = ctx.ACMEOptions.AccountRegistry
 
@@ -153,6 +159,10 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLi
 	if err != nil {
 		return nil, nil, err
 	}
+	c.tlsALPNSolver, err = http.NewTLSALPNSolver(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	// read options from context
 	c.dns01Nameservers = ctx.ACMEOptions.DNS01Nameservers
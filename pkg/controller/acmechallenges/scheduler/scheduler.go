@@ -17,31 +17,66 @@ limitations under the License.
 package scheduler
 
 import (
+	"container/heap"
 	"context"
 	"sort"
+	"strconv"
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/clock"
 
 	"github.com/cert-manager/cert-manager/pkg/acme"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmacmelisters "github.com/cert-manager/cert-manager/pkg/client/listers/acme/v1"
 	"github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+)
+
+const (
+	// defaultChallengeWeight is the scheduling weight of challenges that do
+	// not have a valid scheduling weight annotation.
+	defaultChallengeWeight = 1
+	// maxChallengeWeight is the highest scheduling weight a challenge can have.
+	maxChallengeWeight = 100
+	// defaultNamespaceWeight is the scheduling weight of namespaces that are
+	// not given a weight in the controller configuration.
+	defaultNamespaceWeight = 1
 )
 
 // Scheduler implements an ACME challenge scheduler that applies heuristics
 // to challenge resources in order to determine which challenges should be
 // processing at a given time.
+// The available challenge slots are shared fairly between namespaces using
+// weighted fair queuing, so that a single namespace with many challenges
+// cannot starve the challenges of other namespaces. The weight of each
+// namespace is set by the controller configuration, as the resources in a
+// namespace are controlled by its tenant.
 type Scheduler struct {
-	log                     logr.Logger
-	challengeLister         cmacmelisters.ChallengeLister
-	maxConcurrentChallenges int
+	log                                 logr.Logger
+	challengeLister                     cmacmelisters.ChallengeLister
+	clock                               clock.Clock
+	metrics                             *metrics.Metrics
+	maxConcurrentChallenges             int
+	maxConcurrentChallengesPerNamespace int
+	namespaceWeights                    map[string]int64
 }
 
-// New will construct a new instance of a scheduler
-func New(ctx context.Context, l cmacmelisters.ChallengeLister, maxConcurrentChallenges int) *Scheduler {
+// New will construct a new instance of a scheduler.
+// If maxConcurrentChallengesPerNamespace is zero, the number of challenges
+// per namespace is only limited by maxConcurrentChallenges.
+// Namespaces that are not in namespaceWeights have a weight of 1.
+func New(ctx context.Context, l cmacmelisters.ChallengeLister, c clock.Clock, m *metrics.Metrics, maxConcurrentChallenges, maxConcurrentChallengesPerNamespace int, namespaceWeights map[string]int64) *Scheduler {
 	log := logs.FromContext(ctx, "challenge-scheduler")
-	return &Scheduler{log: log, challengeLister: l, maxConcurrentChallenges: maxConcurrentChallenges}
+	return &Scheduler{
+		log:                                 log,
+		challengeLister:                     l,
+		clock:                               c,
+		metrics:                             m,
+		maxConcurrentChallenges:             maxConcurrentChallenges,
+		maxConcurrentChallengesPerNamespace: maxConcurrentChallengesPerNamespace,
+		namespaceWeights:                    namespaceWeights,
+	}
 }
 
 // ScheduleN will return a maximum of N challenge resources that should be
@@ -55,56 +90,82 @@ func (s *Scheduler) ScheduleN(n int) ([]*cmacme.Challenge, error) {
 		return nil, err
 	}
 
-	return s.scheduleN(n, allChallenges), nil
+	selected, waiting := s.scheduleN(n, allChallenges)
+
+	// Expose the queue length and the position of the next challenge of
+	// each namespace, as well as the time the selected challenges waited.
+	lengths := make(map[string]int)
+	positions := make(map[string]int)
+	for i, ch := range waiting {
+		if _, ok := positions[ch.Namespace]; !ok {
+			positions[ch.Namespace] = i
+		}
+		lengths[ch.Namespace]++
+	}
+	s.metrics.UpdateACMEChallengeSchedulerQueue(lengths, positions)
+	now := s.clock.Now()
+	for _, ch := range selected {
+		s.metrics.ObserveACMEChallengeSchedulerWaitDuration(ch.Namespace, now.Sub(ch.CreationTimestamp.Time))
+	}
+
+	return selected, nil
 }
 
-func (s *Scheduler) scheduleN(n int, allChallenges []*cmacme.Challenge) []*cmacme.Challenge {
+// scheduleN returns the challenges that should be scheduled for processing,
+// as well as the challenges that are left waiting in the order in which they
+// will be scheduled.
+func (s *Scheduler) scheduleN(n int, allChallenges []*cmacme.Challenge) ([]*cmacme.Challenge, []*cmacme.Challenge) {
 	// Determine the list of challenges that could feasibly be scheduled on
 	// this pass of the scheduler.
 	// This function returns a list of candidates sorted by creation timestamp.
-	candidates, inProgressChallengeCount := s.determineChallengeCandidates(allChallenges)
+	candidates, inProgress := s.determineChallengeCandidates(allChallenges)
 
 	numberToSelect := n
-	remainingNumberAllowedChallenges := s.maxConcurrentChallenges - inProgressChallengeCount
-	if remainingNumberAllowedChallenges < 0 {
+	remainingNumberAllowedChallenges := s.maxConcurrentChallenges - len(inProgress)
+	if remainingNumberAllowedChallenges <= 0 {
+		s.log.V(logs.DebugLevel).Info("hit maximum concurrent challenge limit. refusing to schedule more challenges.", "in_progress", len(inProgress), "max_concurrent", s.maxConcurrentChallenges)
 		remainingNumberAllowedChallenges = 0
 	}
 	if numberToSelect > remainingNumberAllowedChallenges {
 		numberToSelect = remainingNumberAllowedChallenges
 	}
 
-	return s.selectChallengesToSchedule(candidates, numberToSelect)
+	return s.selectChallengesToSchedule(s.fairQueue(candidates, inProgress), inProgress, numberToSelect)
 }
 
-// selectChallengesToSchedule will apply some sorting heuristic to the allowed
-// challenge candidates and return a maximum of N challenges that should be
-// scheduled for processing.
-func (s *Scheduler) selectChallengesToSchedule(candidates []*cmacme.Challenge, n int) []*cmacme.Challenge {
-	// Trim the candidates returned to 'n'
-	if len(candidates) > n {
-		candidates = candidates[:n]
+// selectChallengesToSchedule will return a maximum of N challenges from the
+// fairly ordered queue of candidates that should be scheduled for processing,
+// skipping challenges in namespaces that already have the maximum number of
+// challenges per namespace in progress.
+// The challenges that are not selected are returned in queue order.
+func (s *Scheduler) selectChallengesToSchedule(queue, inProgress []*cmacme.Challenge, n int) ([]*cmacme.Challenge, []*cmacme.Challenge) {
+	perNamespace := make(map[string]int)
+	for _, ch := range inProgress {
+		perNamespace[ch.Namespace]++
 	}
-	return candidates
+
+	selected := []*cmacme.Challenge{}
+	waiting := []*cmacme.Challenge{}
+	for _, ch := range queue {
+		if len(selected) >= n || (s.maxConcurrentChallengesPerNamespace > 0 && perNamespace[ch.Namespace] >= s.maxConcurrentChallengesPerNamespace) {
+			waiting = append(waiting, ch)
+			continue
+		}
+		perNamespace[ch.Namespace]++
+		selected = append(selected, ch)
+	}
+	return selected, waiting
 }
 
 // determineChallengeCandidates will determine which, if any, challenges can
 // be scheduled given the current state of items to be scheduled and currently
-// processing.
+// processing. It also returns the challenges that are currently processing.
 // The returned challenges will be sorted in ascending order based on timestamp
 // (i.e. the oldest challenge will be element zero).
-func (s *Scheduler) determineChallengeCandidates(allChallenges []*cmacme.Challenge) ([]*cmacme.Challenge, int) {
+func (s *Scheduler) determineChallengeCandidates(allChallenges []*cmacme.Challenge) ([]*cmacme.Challenge, []*cmacme.Challenge) {
 	// consider the entire set of challenges for 'in progress', in case a challenge
 	// has processing=true whilst still being in a 'final' state
 	inProgress := processingChallenges(allChallenges)
-	inProgressChallengeCount := len(inProgress)
-
-	// Ensure we only run a max of MaxConcurrentChallenges at a time
-	// We perform this check here to avoid extra processing if we've already
-	// hit the maximum number of challenges.
-	if inProgressChallengeCount >= s.maxConcurrentChallenges {
-		s.log.V(logs.DebugLevel).Info("hit maximum concurrent challenge limit. refusing to schedule more challenges.", "in_progress", len(inProgress), "max_concurrent", s.maxConcurrentChallenges)
-		return []*cmacme.Challenge{}, inProgressChallengeCount
-	}
 
 	// Calculate incomplete challenges
 	incomplete := incompleteChallenges(allChallenges)
@@ -131,7 +192,125 @@ func (s *Scheduler) determineChallengeCandidates(allChallenges []*cmacme.Challen
 	// Finally, sorted the challenges by timestamp to ensure a stable output
 	sortChallengesByTimestamp(candidates)
 
-	return candidates, inProgressChallengeCount
+	return candidates, inProgress
+}
+
+// namespaceWeight returns the scheduling weight of the namespace, as set by
+// the controller configuration.
+func (s *Scheduler) namespaceWeight(namespace string) int64 {
+	weight, ok := s.namespaceWeights[namespace]
+	if !ok || weight < 1 {
+		return defaultNamespaceWeight
+	}
+	return weight
+}
+
+// challengeWeight returns the scheduling weight of the challenge, as set by
+// the scheduling weight annotation. It only orders the challenges within a
+// namespace, and does not change the share of the namespace.
+func challengeWeight(ch *cmacme.Challenge) int {
+	weight, err := strconv.Atoi(ch.Annotations[cmacme.ACMEChallengeSchedulingWeightAnnotationKey])
+	if err != nil || weight < 1 || weight > maxChallengeWeight {
+		return defaultChallengeWeight
+	}
+	return weight
+}
+
+// fairQueue orders the candidates using weighted fair queuing, where the
+// challenges of each namespace form a flow.
+// Each flow has a virtual time, which starts at the cost of the challenges of
+// the namespace that are already in progress, and advances by the cost of
+// each challenge that is taken from it. The cost of a challenge is the inverse
+// of the weight of its namespace. The next challenge is always taken from the
+// flow with the lowest virtual finish time, so that namespaces with fewer
+// challenges in progress are served first. Within a flow, challenges with a
+// higher weight come first, and otherwise keep the order of the candidates.
+func (s *Scheduler) fairQueue(candidates, inProgress []*cmacme.Challenge) []*cmacme.Challenge {
+	flowsByNamespace := make(map[string]*challengeFlow)
+	flowFor := func(namespace string) *challengeFlow {
+		f, ok := flowsByNamespace[namespace]
+		if !ok {
+			f = &challengeFlow{namespace: namespace, cost: 1 / float64(s.namespaceWeight(namespace))}
+			flowsByNamespace[namespace] = f
+		}
+		return f
+	}
+
+	for _, ch := range inProgress {
+		f := flowFor(ch.Namespace)
+		f.virtualTime += f.cost
+	}
+	for _, ch := range candidates {
+		f := flowFor(ch.Namespace)
+		f.challenges = append(f.challenges, ch)
+	}
+
+	flows := challengeFlows{}
+	for _, f := range flowsByNamespace {
+		if len(f.challenges) > 0 {
+			sort.SliceStable(f.challenges, func(i, j int) bool {
+				return challengeWeight(f.challenges[i]) > challengeWeight(f.challenges[j])
+			})
+			flows = append(flows, f)
+		}
+	}
+	heap.Init(&flows)
+
+	queue := make([]*cmacme.Challenge, 0, len(candidates))
+	for flows.Len() > 0 {
+		f := flows[0]
+		queue = append(queue, f.challenges[0])
+		f.virtualTime += f.cost
+		f.challenges = f.challenges[1:]
+		if len(f.challenges) == 0 {
+			heap.Pop(&flows)
+		} else {
+			heap.Fix(&flows, 0)
+		}
+	}
+	return queue
+}
+
+// challengeFlow holds the challenges of a single namespace that are waiting
+// to be scheduled, together with the cost of taking a challenge from it.
+type challengeFlow struct {
+	namespace   string
+	virtualTime float64
+	cost        float64
+	challenges  []*cmacme.Challenge
+}
+
+func (f *challengeFlow) finishTime() float64 {
+	return f.virtualTime + f.cost
+}
+
+// challengeFlows implements heap.Interface, ordering flows by the virtual
+// finish time of their next challenge. Ties are broken by the creation
+// timestamp of the next challenge, and then by namespace.
+type challengeFlows []*challengeFlow
+
+func (h challengeFlows) Len() int { return len(h) }
+
+func (h challengeFlows) Less(i, j int) bool {
+	if fi, fj := h[i].finishTime(), h[j].finishTime(); fi != fj {
+		return fi < fj
+	}
+	ti, tj := h[i].challenges[0].CreationTimestamp, h[j].challenges[0].CreationTimestamp
+	if !ti.Equal(&tj) {
+		return ti.Before(&tj)
+	}
+	return h[i].namespace < h[j].namespace
+}
+
+func (h challengeFlows) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *challengeFlows) Push(x interface{}) { *h = append(*h, x.(*challengeFlow)) }
+
+func (h *challengeFlows) Pop() interface{} {
+	old := *h
+	f := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return f
 }
 
 func sortChallengesByTimestamp(chs []*cmacme.Challenge) {
@@ -23,14 +23,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/diff"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/utils/clock"
 
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	"github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/fake"
 	cminformers "github.com/cert-manager/cert-manager/pkg/client/informers/externalversions"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
 )
 
@@ -68,6 +71,28 @@ func ascendingChallengeN(n int, mods ...gen.ChallengeModifier) []*cmacme.Challen
 	return chs
 }
 
+func namespacedChallengeN(namespace string, n int, createdAt int64, mods ...gen.ChallengeModifier) []*cmacme.Challenge {
+	chs := make([]*cmacme.Challenge, n)
+	for i := range chs {
+		name := fmt.Sprintf("%s-%d", namespace, createdAt+int64(i))
+		chs[i] = gen.Challenge(name,
+			gen.SetChallengeNamespace(namespace),
+			gen.SetChallengeDNSName(name+".example.com"),
+			gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+			withCreationTimestamp(createdAt+int64(i)))
+		for _, m := range mods {
+			m(chs[i])
+		}
+	}
+	return chs
+}
+
+func withSchedulingWeight(weight string) func(*cmacme.Challenge) {
+	return func(ch *cmacme.Challenge) {
+		ch.Annotations = map[string]string{cmacme.ACMEChallengeSchedulingWeightAnnotationKey: weight}
+	}
+}
+
 func withCreationTimestamp(i int64) func(*cmacme.Challenge) {
 	return func(ch *cmacme.Challenge) {
 		ch.CreationTimestamp.Time = time.Unix(i, 0)
@@ -82,7 +107,7 @@ func BenchmarkScheduleAscending(b *testing.B) {
 			s := &Scheduler{}
 			b.ResetTimer()
 			for n := 0; n < b.N; n++ {
-				_ = s.scheduleN(30, chs)
+				_, _ = s.scheduleN(30, chs)
 			}
 		})
 	}
@@ -96,7 +121,7 @@ func BenchmarkScheduleRandom(b *testing.B) {
 			s := &Scheduler{}
 			b.ResetTimer()
 			for n := 0; n < b.N; n++ {
-				_ = s.scheduleN(30, chs)
+				_, _ = s.scheduleN(30, chs)
 			}
 		})
 	}
@@ -110,7 +135,7 @@ func BenchmarkScheduleDuplicates(b *testing.B) {
 			s := &Scheduler{}
 			b.ResetTimer()
 			for n := 0; n < b.N; n++ {
-				_ = s.scheduleN(30, chs)
+				_, _ = s.scheduleN(30, chs)
 			}
 		})
 	}
@@ -316,7 +341,7 @@ func TestScheduleN(t *testing.T) {
 				require.NoError(t, err)
 			}
 
-			s := New(context.Background(), challengesInformer.Lister(), maxConcurrentChallenges)
+			s := New(context.Background(), challengesInformer.Lister(), clock.RealClock{}, metrics.New(logr.Discard(), clock.RealClock{}), maxConcurrentChallenges, 0, nil)
 
 			if test.expected == nil {
 				test.expected = []*cmacme.Challenge{}
@@ -334,3 +359,82 @@ func TestScheduleN(t *testing.T) {
 		})
 	}
 }
+
+func TestScheduleNFairness(t *testing.T) {
+	a := namespacedChallengeN("a", 10, 0)
+	b := namespacedChallengeN("b", 2, 100)
+	weighted := namespacedChallengeN("a", 10, 0, withSchedulingWeight("3"))
+	invalidWeight := namespacedChallengeN("a", 10, 0, withSchedulingWeight("1000"))
+	urgent := namespacedChallengeN("a", 1, 50, withSchedulingWeight("10"))
+	processing := namespacedChallengeN("a", 2, 200, gen.SetChallengeProcessing(true))
+
+	tests := map[string]struct {
+		n                int
+		maxPerNamespace  int
+		namespaceWeights map[string]int64
+		challenges       []*cmacme.Challenge
+		expected         []*cmacme.Challenge
+	}{
+		"interleave challenges of different namespaces": {
+			n:          4,
+			challenges: append(append([]*cmacme.Challenge{}, a...), b...),
+			expected:   []*cmacme.Challenge{a[0], b[0], a[1], b[1]},
+		},
+		"prefer namespaces with fewer challenges in progress": {
+			n:          2,
+			challenges: append(append(append([]*cmacme.Challenge{}, a...), b...), processing...),
+			expected:   []*cmacme.Challenge{b[0], b[1]},
+		},
+		"give namespaces with a higher weight a larger share": {
+			n:                4,
+			namespaceWeights: map[string]int64{"a": 3},
+			challenges:       append(append([]*cmacme.Challenge{}, a...), b...),
+			expected:         []*cmacme.Challenge{a[0], a[1], a[2], b[0]},
+		},
+		"do not give namespaces a larger share for challenges with a higher weight": {
+			n:          2,
+			challenges: append(append([]*cmacme.Challenge{}, weighted...), b...),
+			expected:   []*cmacme.Challenge{weighted[0], b[0]},
+		},
+		"schedule challenges with a higher weight first within their namespace": {
+			n:          3,
+			challenges: append(append(append([]*cmacme.Challenge{}, a...), urgent...), b...),
+			expected:   []*cmacme.Challenge{urgent[0], b[0], a[0]},
+		},
+		"ignore invalid weights": {
+			n:          2,
+			challenges: append(append([]*cmacme.Challenge{}, invalidWeight...), b...),
+			expected:   []*cmacme.Challenge{invalidWeight[0], b[0]},
+		},
+		"schedule no more than the maximum per namespace": {
+			n:               5,
+			maxPerNamespace: 2,
+			challenges:      append(append([]*cmacme.Challenge{}, a...), b...),
+			expected:        []*cmacme.Challenge{a[0], b[0], a[1], b[1]},
+		},
+		"schedule nothing in namespaces that reached the maximum per namespace": {
+			n:               5,
+			maxPerNamespace: 2,
+			challenges:      append(append([]*cmacme.Challenge{}, a...), processing...),
+			expected:        []*cmacme.Challenge{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cl := fake.NewSimpleClientset()
+			factory := cminformers.NewSharedInformerFactory(cl, 0)
+			challengesInformer := factory.Acme().V1().Challenges()
+			for _, ch := range test.challenges {
+				err := challengesInformer.Informer().GetIndexer().Add(ch)
+				require.NoError(t, err)
+			}
+
+			s := New(context.Background(), challengesInformer.Lister(), clock.RealClock{}, metrics.New(logr.Discard(), clock.RealClock{}), maxConcurrentChallenges, test.maxPerNamespace, test.namespaceWeights)
+
+			chs, err := s.ScheduleN(test.n)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, chs)
+		})
+	}
+}
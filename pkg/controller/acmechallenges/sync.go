@@ -20,21 +20,25 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
-	acmeapi "golang.org/x/crypto/acme"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	"github.com/cert-manager/cert-manager/pkg/acme"
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
 	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns"
 	dnsutil "github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
 )
 
 const (
@@ -43,10 +47,19 @@ const (
 	reasonPresentError   = "PresentError"
 	reasonPresented      = "Presented"
 	reasonFailed         = "Failed"
+	reasonRateLimited    = "RateLimited"
+
+	reasonCAAIssuer           = "CAAIssuerNotPermitted"
+	reasonCAAAccountURI       = "CAAAccountNotPermitted"
+	reasonCAAValidationMethod = "CAAValidationMethodNotPermitted"
 
 	// How long to wait for an authorization response from the ACME server in acceptChallenge()
 	// before giving up
 	authorizationTimeout = 20 * time.Second
+
+	// How long to wait before retrying if the ACME server rate limited a
+	// request without specifying when the rate limit is reset
+	rateLimitedRetryPeriod = 15 * time.Minute
 )
 
 // solver solves ACME challenges by presenting the given token and key in an
@@ -128,7 +141,7 @@ func (c *controller) Sync(ctx context.Context, chOriginal *cmacme.Challenge) (er
 		return nil
 	}
 
-	cl, err := c.accountRegistry.GetClient(string(genericIssuer.GetUID()))
+	cl, err := c.accountRegistry.GetClient(accounts.ClientID(string(genericIssuer.GetUID()), genericIssuer.GetSpec().ACME, ch.Spec.Server))
 	if err != nil {
 		return err
 	}
@@ -136,7 +149,7 @@ func (c *controller) Sync(ctx context.Context, chOriginal *cmacme.Challenge) (er
 	if ch.Status.State == "" {
 		err := c.syncChallengeStatus(ctx, cl, ch)
 		if err != nil {
-			return handleError(ch, err)
+			return c.handleError(ch, err)
 		}
 
 		// if the state has not changed, return an error
@@ -159,16 +172,19 @@ func (c *controller) Sync(ctx context.Context, chOriginal *cmacme.Challenge) (er
 		// Find out which identity the ACME server says it will use.
 		dir, err := cl.Discover(ctx)
 		if err != nil {
-			return handleError(ch, err)
+			return c.handleError(ch, err)
 		}
 		// TODO(dmo): figure out if missing CAA identity in directory
 		// means no CAA check is performed by ACME server or if any valid
 		// CAA would stop issuance (strongly suspect the former)
 		if len(dir.CAA) != 0 {
-			err := dnsutil.ValidateCAA(ctx, ch.Spec.DNSName, dir.CAA, ch.Spec.Wildcard, c.dns01Nameservers)
+			// RFC 8657 identifies validation methods by their ACME challenge type
+			validationMethod := strings.ToLower(string(ch.Spec.Type))
+			accountURI := acme.AccountURI(genericIssuer, ch.Spec.Server)
+			nameservers := dns.NameserversForChallenge(ch, c.dns01Nameservers)
+			err := dnsutil.ValidateCAA(ctx, ch.Spec.DNSName, dir.CAA, accountURI, validationMethod, ch.Spec.Wildcard, nameservers)
 			if err != nil {
-				ch.Status.Reason = fmt.Sprintf("CAA self-check failed: %s", err)
-				return err
+				return c.handleCAAError(ch, accountURI, validationMethod, err)
 			}
 		}
 	}
@@ -187,10 +203,21 @@ func (c *controller) Sync(ctx context.Context, chOriginal *cmacme.Challenge) (er
 		}
 
 		ch.Status.Presented = true
+		if ch.Status.PresentedTime == nil {
+			presentedTime := metav1.NewTime(c.clock.Now())
+			ch.Status.PresentedTime = &presentedTime
+		}
 		c.recorder.Eventf(ch, corev1.EventTypeNormal, reasonPresented, "Presented challenge using %s challenge mechanism", ch.Spec.Type)
 	}
 
 	err = solver.Check(ctx, genericIssuer, ch)
+	if errors.Is(err, dns.ErrManualRecordTimeout) || errors.Is(err, dns.ErrPropagationTimeout) {
+		log.Error(err, "propagation check timed out")
+		ch.Status.State = cmacme.Errored
+		ch.Status.Reason = err.Error()
+		c.recorder.Event(ch, corev1.EventTypeWarning, reasonFailed, err.Error())
+		return nil
+	}
 	if err != nil {
 		log.Error(err, "propagation check failed")
 		ch.Status.Reason = fmt.Sprintf("Waiting for %s challenge propagation: %s", ch.Spec.Type, err)
@@ -211,14 +238,56 @@ func (c *controller) Sync(ctx context.Context, chOriginal *cmacme.Challenge) (er
 	return nil
 }
 
+// handleCAAError handles an error returned by the CAA self check. If the CAA
+// records of the domain do not permit issuance, the challenge is marked as
+// errored, as the ACME server would refuse to issue the certificate. Other
+// errors, such as failed DNS lookups, are retried.
+func (c *controller) handleCAAError(ch *cmacme.Challenge, accountURI, validationMethod string, err error) error {
+	var reason, message string
+	switch {
+	case errors.Is(err, dnsutil.ErrCAAIssuer):
+		reason = reasonCAAIssuer
+		message = fmt.Sprintf("CAA records for %q do not permit the ACME server to issue certificates", ch.Spec.DNSName)
+	case errors.Is(err, dnsutil.ErrCAAAccountURI):
+		reason = reasonCAAAccountURI
+		message = fmt.Sprintf("CAA records for %q do not permit the ACME account %q to issue certificates", ch.Spec.DNSName, accountURI)
+	case errors.Is(err, dnsutil.ErrCAAValidationMethod):
+		reason = reasonCAAValidationMethod
+		message = fmt.Sprintf("CAA records for %q do not permit the %s validation method", ch.Spec.DNSName, validationMethod)
+	default:
+		ch.Status.Reason = fmt.Sprintf("CAA self-check failed: %s", err)
+		return err
+	}
+
+	ch.Status.State = cmacme.Errored
+	ch.Status.Reason = fmt.Sprintf("%s: %v", message, err)
+	c.recorder.Event(ch, corev1.EventTypeWarning, reason, ch.Status.Reason)
+	return nil
+}
+
 // handleError will handle ACME error types, updating the challenge resource
 // with any new information found whilst inspecting the error response.
 // This may include marking the challenge as expired.
-func handleError(ch *cmacme.Challenge, err error) error {
+func (c *controller) handleError(ch *cmacme.Challenge, err error) error {
 	if err == nil {
 		return nil
 	}
 
+	// If the ACME server is rate limiting requests, retry once the rate limit
+	// has been reset rather than failing the challenge.
+	if retryAfter, ok := acmeapi.RateLimit(err); ok {
+		if retryAfter <= 0 {
+			retryAfter = rateLimitedRetryPeriod
+		}
+		ch.Status.Reason = fmt.Sprintf("Rate limited by the ACME server, retrying in %s: %v", retryAfter.Round(time.Second), err)
+		c.recorder.Event(ch, corev1.EventTypeWarning, reasonRateLimited, ch.Status.Reason)
+		c.queue.AddAfter(types.NamespacedName{
+			Namespace: ch.Namespace,
+			Name:      ch.Name,
+		}, retryAfter)
+		return nil
+	}
+
 	var acmeErr *acmeapi.Error
 	var ok bool
 	if acmeErr, ok = err.(*acmeapi.Error); !ok {
@@ -376,8 +445,12 @@ func (c *controller) acceptChallenge(ctx context.Context, cl acmecl.Interface, c
 	if err != nil {
 		log.Error(err, "error accepting challenge")
 		ch.Status.Reason = fmt.Sprintf("Error accepting challenge: %v", err)
-		return handleError(ch, err)
+		return c.handleError(ch, err)
 	}
+	// Record that the challenge has passed its self check and has been
+	// accepted, so that the Order controller no longer falls back to a
+	// different solver for it.
+	ch.Status.Accepted = true
 
 	log.V(logf.DebugLevel).Info("waiting for authorization for domain")
 	// The underlying ACME implementation from golang.org/x/crypto of WaitAuthorization retries on
@@ -404,7 +477,7 @@ func (c *controller) acceptChallenge(ctx context.Context, cl acmecl.Interface, c
 func (c *controller) handleAuthorizationError(ch *cmacme.Challenge, err error) error {
 	authErr, ok := err.(*acmeapi.AuthorizationError)
 	if !ok {
-		return handleError(ch, err)
+		return c.handleError(ch, err)
 	}
 
 	// TODO: the AuthorizationError above could technically contain the final
@@ -426,8 +499,10 @@ func (c *controller) solverFor(challengeType cmacme.ACMEChallengeType) (solver,
 	switch challengeType {
 	case cmacme.ACMEChallengeTypeHTTP01:
 		return c.httpSolver, nil
-	case cmacme.ACMEChallengeTypeDNS01:
+	case cmacme.ACMEChallengeTypeDNS01, cmacme.ACMEChallengeTypeDNSAccount01:
 		return c.dnsSolver, nil
+	case cmacme.ACMEChallengeTypeTLSALPN01:
+		return c.tlsALPNSolver, nil
 	}
 	return nil, fmt.Errorf("no solver for %q implemented", challengeType)
 }
@@ -20,12 +20,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
-	acmeapi "golang.org/x/crypto/acme"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
 
 	accountstest "github.com/cert-manager/cert-manager/pkg/acme/accounts/test"
 	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
@@ -34,7 +36,10 @@ import (
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
 	"github.com/cert-manager/cert-manager/pkg/issuer"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns"
+	dnsutil "github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
 )
 
 // Present the challenge value with the given solver.
@@ -72,6 +77,7 @@ type testT struct {
 }
 
 func TestSyncHappyPath(t *testing.T) {
+	fixedClock := fakeclock.NewFakeClock(time.Now())
 	testIssuerHTTP01Enabled := gen.Issuer("testissuer", gen.SetIssuerACME(cmacme.ACMEIssuer{
 		Solvers: []cmacme.ACMEChallengeSolver{
 			{
@@ -312,6 +318,7 @@ func TestSyncHappyPath(t *testing.T) {
 					gen.SetChallengeState(cmacme.Pending),
 					gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
 				), testIssuerHTTP01Enabled},
+				Clock: fixedClock,
 				ExpectedActions: []testpkg.Action{
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("challenges"),
 						"status",
@@ -321,6 +328,7 @@ func TestSyncHappyPath(t *testing.T) {
 							gen.SetChallengeURL("testurl"),
 							gen.SetChallengeState(cmacme.Pending),
 							gen.SetChallengePresented(true),
+							gen.SetChallengePresentedTime(metav1.NewTime(fixedClock.Now())),
 							gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
 							gen.SetChallengeReason("Waiting for HTTP-01 challenge propagation: some error"),
 						))),
@@ -331,6 +339,84 @@ func TestSyncHappyPath(t *testing.T) {
 				},
 			},
 		},
+		"mark the challenge as errored if the manually created DNS01 record does not propagate in time": {
+			challenge: gen.ChallengeFrom(baseChallenge,
+				gen.SetChallengeProcessing(true),
+				gen.SetChallengeURL("testurl"),
+				gen.SetChallengeState(cmacme.Pending),
+				gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
+				gen.SetChallengePresented(true),
+			),
+			dnsSolver: &fakeSolver{
+				fakeCheck: func(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
+					return fmt.Errorf("%w: some details", dns.ErrManualRecordTimeout)
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{gen.ChallengeFrom(baseChallenge,
+					gen.SetChallengeProcessing(true),
+					gen.SetChallengeURL("testurl"),
+					gen.SetChallengeState(cmacme.Pending),
+					gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
+					gen.SetChallengePresented(true),
+				), testIssuerHTTP01Enabled},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("challenges"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.ChallengeFrom(baseChallenge,
+							gen.SetChallengeProcessing(true),
+							gen.SetChallengeURL("testurl"),
+							gen.SetChallengeState(cmacme.Errored),
+							gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
+							gen.SetChallengePresented(true),
+							gen.SetChallengeReason("timed out waiting for the DNS01 record to be created: some details"),
+						))),
+				},
+				ExpectedEvents: []string{
+					"Warning Failed timed out waiting for the DNS01 record to be created: some details",
+				},
+			},
+		},
+		"mark the challenge as errored if the DNS01 record does not propagate within the propagation timeout": {
+			challenge: gen.ChallengeFrom(baseChallenge,
+				gen.SetChallengeProcessing(true),
+				gen.SetChallengeURL("testurl"),
+				gen.SetChallengeState(cmacme.Pending),
+				gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
+				gen.SetChallengePresented(true),
+			),
+			dnsSolver: &fakeSolver{
+				fakeCheck: func(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
+					return fmt.Errorf("%w: some details", dns.ErrPropagationTimeout)
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{gen.ChallengeFrom(baseChallenge,
+					gen.SetChallengeProcessing(true),
+					gen.SetChallengeURL("testurl"),
+					gen.SetChallengeState(cmacme.Pending),
+					gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
+					gen.SetChallengePresented(true),
+				), testIssuerHTTP01Enabled},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("challenges"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.ChallengeFrom(baseChallenge,
+							gen.SetChallengeProcessing(true),
+							gen.SetChallengeURL("testurl"),
+							gen.SetChallengeState(cmacme.Errored),
+							gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
+							gen.SetChallengePresented(true),
+							gen.SetChallengeReason("timed out waiting for the DNS01 record to propagate: some details"),
+						))),
+				},
+				ExpectedEvents: []string{
+					"Warning Failed timed out waiting for the DNS01 record to propagate: some details",
+				},
+			},
+		},
 		"accept the challenge if the self check is passing": {
 			challenge: gen.ChallengeFrom(baseChallenge,
 				gen.SetChallengeProcessing(true),
@@ -368,6 +454,7 @@ func TestSyncHappyPath(t *testing.T) {
 							gen.SetChallengeState(cmacme.Valid),
 							gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
 							gen.SetChallengePresented(true),
+							gen.SetChallengeAccepted(true),
 							gen.SetChallengeReason("Successfully authorized domain"),
 						))),
 				},
@@ -387,6 +474,51 @@ func TestSyncHappyPath(t *testing.T) {
 				},
 			},
 		},
+		"do not mark the challenge as accepted if the ACME server fails to accept it": {
+			challenge: gen.ChallengeFrom(baseChallenge,
+				gen.SetChallengeProcessing(true),
+				gen.SetChallengeURL("testurl"),
+				gen.SetChallengeDNSName("test.com"),
+				gen.SetChallengeState(cmacme.Pending),
+				gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+				gen.SetChallengePresented(true),
+			),
+			httpSolver: &fakeSolver{
+				fakeCheck: func(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
+					return nil
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{gen.ChallengeFrom(baseChallenge,
+					gen.SetChallengeProcessing(true),
+					gen.SetChallengeURL("testurl"),
+					gen.SetChallengeDNSName("test.com"),
+					gen.SetChallengeState(cmacme.Pending),
+					gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+					gen.SetChallengePresented(true),
+				), testIssuerHTTP01Enabled},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("challenges"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.ChallengeFrom(baseChallenge,
+							gen.SetChallengeProcessing(true),
+							gen.SetChallengeURL("testurl"),
+							gen.SetChallengeDNSName("test.com"),
+							gen.SetChallengeState(cmacme.Pending),
+							gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+							gen.SetChallengePresented(true),
+							gen.SetChallengeReason("Error accepting challenge: 500 : service unavailable"),
+						))),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeAccept: func(context.Context, *acmeapi.Challenge) (*acmeapi.Challenge, error) {
+					return nil, &acmeapi.Error{StatusCode: 500, Detail: "service unavailable"}
+				},
+			},
+			expectErr: true,
+		},
 		"mark certificate as failed if accepting the authorization fails": {
 			challenge: gen.ChallengeFrom(baseChallenge,
 				gen.SetChallengeProcessing(true),
@@ -421,6 +553,7 @@ func TestSyncHappyPath(t *testing.T) {
 							gen.SetChallengeState(cmacme.Invalid),
 							gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
 							gen.SetChallengePresented(true),
+							gen.SetChallengeAccepted(true),
 							gen.SetChallengeReason("Error accepting authorization: acme: authorization error for example.com: an error happened"),
 						))),
 				},
@@ -480,6 +613,7 @@ func TestSyncHappyPath(t *testing.T) {
 							gen.SetChallengeState(cmacme.Invalid),
 							gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
 							gen.SetChallengePresented(true),
+							gen.SetChallengeAccepted(true),
 							gen.SetChallengeReason("Error accepting authorization: acme: authorization error for example.com: 400 fakeerror: this is a very detailed error"),
 						))),
 				},
@@ -620,3 +754,67 @@ func runTest(t *testing.T, test testT) {
 
 	test.builder.CheckAndFinish(err)
 }
+
+func TestHandleCAAError(t *testing.T) {
+	tests := map[string]struct {
+		err error
+
+		expectErr    bool
+		expectState  cmacme.State
+		expectReason string
+		expectEvent  string
+	}{
+		"mark the challenge as errored if the CAA records do not permit the issuer": {
+			err:          dnsutil.ErrCAAIssuer,
+			expectState:  cmacme.Errored,
+			expectReason: `CAA records for "example.com" do not permit the ACME server to issue certificates: CAA record does not match issuer`,
+			expectEvent:  reasonCAAIssuer,
+		},
+		"mark the challenge as errored if the CAA records do not permit the account": {
+			err:          fmt.Errorf("%w: details", dnsutil.ErrCAAAccountURI),
+			expectState:  cmacme.Errored,
+			expectReason: `CAA records for "example.com" do not permit the ACME account "https://acme/acct/1" to issue certificates: CAA record does not permit the ACME account: details`,
+			expectEvent:  reasonCAAAccountURI,
+		},
+		"mark the challenge as errored if the CAA records do not permit the validation method": {
+			err:          fmt.Errorf("%w: details", dnsutil.ErrCAAValidationMethod),
+			expectState:  cmacme.Errored,
+			expectReason: `CAA records for "example.com" do not permit the http-01 validation method: CAA record does not permit the validation method: details`,
+			expectEvent:  reasonCAAValidationMethod,
+		},
+		"retry if the CAA records could not be looked up": {
+			err:          errors.New("lookup failed"),
+			expectErr:    true,
+			expectState:  cmacme.Pending,
+			expectReason: "CAA self-check failed: lookup failed",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			recorder := new(testpkg.FakeRecorder)
+			c := &controller{recorder: recorder}
+			ch := gen.Challenge("test",
+				gen.SetChallengeDNSName("example.com"),
+				gen.SetChallengeState(cmacme.Pending),
+			)
+
+			err := c.handleCAAError(ch, "https://acme/acct/1", "http-01", test.err)
+			if (err != nil) != test.expectErr {
+				t.Errorf("expected error %t, got: %v", test.expectErr, err)
+			}
+			if ch.Status.State != test.expectState {
+				t.Errorf("expected state %q, got %q", test.expectState, ch.Status.State)
+			}
+			if ch.Status.Reason != test.expectReason {
+				t.Errorf("expected reason %q, got %q", test.expectReason, ch.Status.Reason)
+			}
+			if test.expectEvent == "" && len(recorder.Events) > 0 {
+				t.Errorf("expected no events, got %v", recorder.Events)
+			}
+			if test.expectEvent != "" && (len(recorder.Events) != 1 || !strings.Contains(recorder.Events[0], test.expectEvent)) {
+				t.Errorf("expected a %s event, got %v", test.expectEvent, recorder.Events)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acmeorders
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+)
+
+const (
+	// authorizationExpiryMargin is the minimum remaining lifetime of a cached
+	// authorization for it to be reused by an Order, so that it does not
+	// expire before the Order has been finalized.
+	authorizationExpiryMargin = time.Hour
+
+	// outcomes of the authorizations of Orders, as exposed in metrics
+	authorizationOutcomeChallenge = "challenge"
+	authorizationOutcomeReused    = "reused"
+	authorizationOutcomeCached    = "cached"
+)
+
+// authorizationCache keeps track of the ACME authorizations that are known to
+// be valid for each ACME account and identifier, so that new Orders which the
+// ACME server attaches the same authorizations to do not need to fetch them
+// again. Accounts are identified by their client ID in the account registry.
+type authorizationCache struct {
+	clock clock.Clock
+
+	lock           sync.Mutex
+	authorizations map[authorizationKey]cachedAuthorization
+}
+
+// authorizationKey identifies the authorization of an ACME account for an
+// identifier.
+type authorizationKey struct {
+	clientID   string
+	identifier string
+	wildcard   bool
+}
+
+type cachedAuthorization struct {
+	url     string
+	expires time.Time
+}
+
+func newAuthorizationCache(clock clock.Clock) *authorizationCache {
+	return &authorizationCache{
+		clock:          clock,
+		authorizations: make(map[authorizationKey]cachedAuthorization),
+	}
+}
+
+// add records the given authorization of the ACME account with the given
+// client ID, if it is valid and its expiry is known. Expired authorizations
+// are forgotten.
+func (c *authorizationCache) add(clientID string, authz cmacme.ACMEAuthorization) {
+	if authz.InitialState != cmacme.Valid || authz.Identifier == "" || authz.Expires == nil {
+		return
+	}
+
+	key := authorizationKey{
+		clientID:   clientID,
+		identifier: authz.Identifier,
+		wildcard:   authz.Wildcard != nil && *authz.Wildcard,
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := c.clock.Now()
+	for k, cached := range c.authorizations {
+		if !now.Before(cached.expires) {
+			delete(c.authorizations, k)
+		}
+	}
+
+	if existing, ok := c.authorizations[key]; ok && existing.expires.After(authz.Expires.Time) {
+		return
+	}
+	c.authorizations[key] = cachedAuthorization{url: authz.URL, expires: authz.Expires.Time}
+}
+
+// get returns the cached authorization of the ACME account with the given
+// client ID for the given identifier, if it remains valid for at least
+// authorizationExpiryMargin.
+func (c *authorizationCache) get(clientID, identifier string, wildcard bool) (cmacme.ACMEAuthorization, bool) {
+	key := authorizationKey{clientID: clientID, identifier: identifier, wildcard: wildcard}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	cached, ok := c.authorizations[key]
+	if !ok {
+		return cmacme.ACMEAuthorization{}, false
+	}
+	if c.clock.Now().Add(authorizationExpiryMargin).After(cached.expires) {
+		delete(c.authorizations, key)
+		return cmacme.ACMEAuthorization{}, false
+	}
+
+	return cmacme.ACMEAuthorization{
+		URL:          cached.url,
+		Identifier:   identifier,
+		Wildcard:     &wildcard,
+		InitialState: cmacme.Valid,
+		Expires:      &metav1.Time{Time: cached.expires},
+		Cached:       true,
+	}, true
+}
+
+// remove forgets the authorization of the ACME account with the given client
+// ID for the given identifier, e.g. because the ACME server no longer
+// considers it valid.
+func (c *authorizationCache) remove(clientID, identifier string, wildcard bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.authorizations, authorizationKey{clientID: clientID, identifier: identifier, wildcard: wildcard})
+}
+
+// orderIdentifiers returns the identifiers that the given Order requests
+// authorizations for, keyed by the identifier of the authorization and
+// whether it is for a wildcard DNS name.
+func orderIdentifiers(o *cmacme.Order) []authorizationKey {
+	var keys []authorizationKey
+	names := o.Spec.DNSNames
+	if o.Spec.CommonName != "" {
+		names = append(slices.Clip(names), o.Spec.CommonName)
+	}
+	for _, name := range names {
+		identifier, wildcard := strings.CutPrefix(name, "*.")
+		keys = append(keys, authorizationKey{identifier: identifier, wildcard: wildcard})
+	}
+	for _, ip := range o.Spec.IPAddresses {
+		keys = append(keys, authorizationKey{identifier: ip})
+	}
+	return keys
+}
+
+// applyCachedAuthorizations populates the authorizations of a newly submitted
+// Order with the cached valid authorizations of the ACME account with the
+// given client ID for the identifiers of the Order. An authorization is only
+// taken from the cache if the ACME server attached the cached authorization
+// to the Order, in which case it does not need to be fetched again.
+func (c *controller) applyCachedAuthorizations(o *cmacme.Order, clientID string) {
+	for _, key := range orderIdentifiers(o) {
+		cached, ok := c.authorizations.get(clientID, key.identifier, key.wildcard)
+		if !ok {
+			continue
+		}
+		for i, authz := range o.Status.Authorizations {
+			if authz.URL == cached.URL && authz.Identifier == "" {
+				o.Status.Authorizations[i] = cached
+				c.metrics.IncrementACMEOrderAuthorizationCount(authorizationOutcomeCached)
+			}
+		}
+	}
+}
@@ -37,6 +37,7 @@ import (
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
 	"github.com/cert-manager/cert-manager/pkg/scheduler"
 )
 
@@ -70,6 +71,16 @@ type controller struct {
 
 	// scheduledWorkQueue holds items to be re-queued after a period of time.
 	scheduledWorkQueue scheduler.ScheduledWorkQueue[types.NamespacedName]
+
+	// serverAvailability tracks ACME servers that recently failed, so that
+	// new orders are submitted to an issuer's fallback servers instead.
+	serverAvailability *serverAvailability
+
+	// authorizations caches the ACME authorizations that are known to be
+	// valid, if the ACMEAuthorizationCache feature gate is enabled.
+	authorizations *authorizationCache
+
+	metrics *metrics.Metrics
 }
 
 // NewController constructs an orders controller using the provided options.
@@ -157,6 +168,9 @@ func NewController(
 		cmClient:            ctx.CMClient,
 		accountRegistry:     ctx.AccountRegistry,
 		fieldManager:        ctx.FieldManager,
+		serverAvailability:  newServerAvailability(ctx.Clock),
+		authorizations:      newAuthorizationCache(ctx.Clock),
+		metrics:             ctx.Metrics,
 	}, queue, mustSync, nil
 
 }
@@ -0,0 +1,182 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acmeorders
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
+)
+
+const (
+	reasonFailover = "Failover"
+
+	// maxFailedFinalizeAttempts is the number of times finalizing an order
+	// may fail because of an error on the ACME server before the order is
+	// submitted to the next server of the issuer instead.
+	maxFailedFinalizeAttempts = 3
+
+	// unavailableServerBackoff is the duration for which an ACME server that
+	// failed is tried last when submitting new orders, unless the server
+	// specified a different duration in a Retry-After header.
+	unavailableServerBackoff = 10 * time.Minute
+)
+
+// serverAvailability keeps track of the ACME servers that recently failed,
+// so that new orders are submitted to the next server of the issuer first.
+// Servers are identified by their ID in the account registry, as rate limits
+// apply per ACME account.
+type serverAvailability struct {
+	clock clock.Clock
+
+	lock             sync.Mutex
+	unavailableUntil map[string]time.Time
+}
+
+func newServerAvailability(clock clock.Clock) *serverAvailability {
+	return &serverAvailability{
+		clock:            clock,
+		unavailableUntil: make(map[string]time.Time),
+	}
+}
+
+// markUnavailable marks the server with the given client ID as unavailable
+// for the given duration.
+func (s *serverAvailability) markUnavailable(clientID string, d time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.unavailableUntil[clientID] = s.clock.Now().Add(d)
+}
+
+// available returns false if the server with the given client ID has been
+// marked as unavailable and the duration has not yet elapsed.
+func (s *serverAvailability) available(clientID string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	until, ok := s.unavailableUntil[clientID]
+	if !ok {
+		return true
+	}
+	if s.clock.Now().Before(until) {
+		return false
+	}
+	delete(s.unavailableUntil, clientID)
+	return true
+}
+
+// orderServers returns the ACME servers of the given issuer in the order in
+// which new orders should be submitted to them. Servers that recently failed
+// are moved to the end of the list, but are still tried as a last resort.
+func (c *controller) orderServers(issuer cmapi.GenericIssuer) []string {
+	spec := issuer.GetSpec().ACME
+	uid := string(issuer.GetUID())
+
+	servers := []string{spec.Server}
+	for _, fs := range spec.FallbackServers {
+		servers = append(servers, fs.Server)
+	}
+	if len(servers) == 1 {
+		return servers
+	}
+
+	var available, unavailable []string
+	for _, server := range servers {
+		if c.serverAvailability.available(accounts.ClientID(uid, spec, server)) {
+			available = append(available, server)
+		} else {
+			unavailable = append(unavailable, server)
+		}
+	}
+	return append(available, unavailable...)
+}
+
+// otherServerAvailable returns true if any ACME server of the issuer other
+// than the given server is available to submit new orders to.
+func (c *controller) otherServerAvailable(issuer cmapi.GenericIssuer, server string) bool {
+	spec := issuer.GetSpec().ACME
+	uid := string(issuer.GetUID())
+	for _, s := range c.orderServers(issuer) {
+		if s != server && c.serverAvailability.available(accounts.ClientID(uid, spec, s)) {
+			return true
+		}
+	}
+	return false
+}
+
+// failOverOrder deletes the given Order after finalizing it with its ACME
+// server failed repeatedly. The CertificateRequest controller then creates a
+// new Order, which is submitted to the next server of the issuer as part of
+// the same issuance. Unlike marking the Order as failed, this does not fail
+// the CertificateRequest, so the Certificate is not subject to the backoff
+// applied after failed issuances.
+func (c *controller) failOverOrder(ctx context.Context, o *cmacme.Order, err error) error {
+	c.recorder.Eventf(o, corev1.EventTypeWarning, reasonFailover, "Failed to finalize order with ACME server %q after %d attempts, submitting a new order to the next server: %v", o.Status.Server, o.Status.FailedFinalizeAttempts, err)
+	deleteErr := c.cmClient.AcmeV1().Orders(o.Namespace).Delete(ctx, o.Name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &o.UID},
+	})
+	if apierrors.IsNotFound(deleteErr) {
+		return nil
+	}
+	return deleteErr
+}
+
+// failOver checks whether the given error returned by an ACME server warrants
+// submitting the order to the next server of the issuer instead. If so, the
+// server is marked as unavailable and an event is recorded on the Order.
+func (c *controller) failOver(o *cmacme.Order, clientID, server string, err error) bool {
+	d, ok := shouldFailOver(err)
+	if !ok {
+		return false
+	}
+	c.serverAvailability.markUnavailable(clientID, d)
+	c.recorder.Eventf(o, corev1.EventTypeWarning, reasonFailover, "Failed to submit order to ACME server %q, trying next server: %v", server, err)
+	return true
+}
+
+// shouldFailOver returns true if the given error returned while talking to an
+// ACME server indicates that the server is unavailable or that it is rate
+// limiting the account. The returned duration is how long the server should
+// be avoided for.
+func shouldFailOver(err error) (time.Duration, bool) {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return 0, false
+	}
+	if retryAfter, ok := acmeapi.RateLimit(err); ok {
+		if retryAfter <= 0 {
+			retryAfter = unavailableServerBackoff
+		}
+		return retryAfter, true
+	}
+	acmeErr, ok := err.(*acmeapi.Error)
+	if ok && acmeErr.StatusCode < 500 {
+		return 0, false
+	}
+	// Any other error means that the ACME server could not be reached or
+	// returned a server error.
+	return unavailableServerBackoff, true
+}
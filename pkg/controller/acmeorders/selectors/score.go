@@ -0,0 +1,94 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selectors
+
+import (
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+)
+
+// Score describes how specific a match between a solver's selector and a
+// DNS name is. Solvers with a higher score are preferred over solvers with a
+// lower score.
+type Score struct {
+	// DNSNames is true if the DNS name is listed in the dnsNames selector.
+	// Multiple dnsNames matches are not counted as extra weight.
+	DNSNames bool
+	// DNSZones is the number of matching segments of the longest matching
+	// dnsZones entry.
+	DNSZones int
+	// Labels is the number of labels matched by the matchLabels selector.
+	Labels int
+}
+
+// Less returns true if s is a less specific match than other.
+// dnsNames matches take precedence over dnsZones matches, which in turn take
+// precedence over matchLabels matches.
+func (s Score) Less(other Score) bool {
+	if s.DNSNames != other.DNSNames {
+		return other.DNSNames
+	}
+	if s.DNSZones != other.DNSZones {
+		return s.DNSZones < other.DNSZones
+	}
+	return s.Labels < other.Labels
+}
+
+// Match returns whether all parts of the given selector match the object
+// metadata and DNS name, and the Score of the match.
+// A nil selector matches everything with the lowest possible Score.
+func Match(sel *cmacme.CertificateDNSNameSelector, meta metav1.ObjectMeta, dnsName string) (bool, Score) {
+	if sel == nil {
+		return true, Score{}
+	}
+
+	labelsMatch, numLabelsMatch := Labels(*sel).Matches(meta, dnsName)
+	dnsNamesMatch, numDNSNamesMatch := DNSNames(*sel).Matches(meta, dnsName)
+	dnsZonesMatch, numDNSZonesMatch := DNSZones(*sel).Matches(meta, dnsName)
+	if !labelsMatch || !dnsNamesMatch || !dnsZonesMatch {
+		return false, Score{}
+	}
+
+	return true, Score{
+		DNSNames: numDNSNamesMatch > 0,
+		DNSZones: numDNSZonesMatch,
+		Labels:   numLabelsMatch,
+	}
+}
+
+// Rank returns the indices of the solvers whose selector matches the object
+// metadata and DNS name, ordered from the most to the least specific match.
+// Solvers with an equal Score keep the order in which they are configured.
+func Rank(solvers []cmacme.ACMEChallengeSolver, meta metav1.ObjectMeta, dnsName string) []int {
+	var ranked []int
+	scores := make(map[int]Score, len(solvers))
+	for i := range solvers {
+		matches, score := Match(solvers[i].Selector, meta, dnsName)
+		if !matches {
+			continue
+		}
+		ranked = append(ranked, i)
+		scores[i] = score
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return scores[ranked[j]].Less(scores[ranked[i]])
+	})
+	return ranked
+}
@@ -0,0 +1,80 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selectors
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+)
+
+func TestRank(t *testing.T) {
+	meta := metav1.ObjectMeta{Labels: map[string]string{"team": "a"}}
+	tests := []struct {
+		name     string
+		solvers  []cmacme.ACMEChallengeSolver
+		dnsName  string
+		expected []int
+	}{
+		{
+			name:     "no solvers",
+			dnsName:  "www.example.com",
+			expected: nil,
+		},
+		{
+			name: "solvers without a selector keep their order",
+			solvers: []cmacme.ACMEChallengeSolver{
+				{},
+				{},
+			},
+			dnsName:  "www.example.com",
+			expected: []int{0, 1},
+		},
+		{
+			name: "non-matching solvers are skipped",
+			solvers: []cmacme.ACMEChallengeSolver{
+				{Selector: &cmacme.CertificateDNSNameSelector{DNSNames: []string{"other.com"}}},
+				{},
+			},
+			dnsName:  "www.example.com",
+			expected: []int{1},
+		},
+		{
+			name: "dnsNames take precedence over dnsZones, which take precedence over labels",
+			solvers: []cmacme.ACMEChallengeSolver{
+				{},
+				{Selector: &cmacme.CertificateDNSNameSelector{MatchLabels: map[string]string{"team": "a"}}},
+				{Selector: &cmacme.CertificateDNSNameSelector{DNSZones: []string{"example.com"}}},
+				{Selector: &cmacme.CertificateDNSNameSelector{DNSZones: []string{"www.example.com"}}},
+				{Selector: &cmacme.CertificateDNSNameSelector{DNSNames: []string{"www.example.com"}}},
+			},
+			dnsName:  "www.example.com",
+			expected: []int{4, 3, 2, 1, 0},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ranked := Rank(test.solvers, meta, test.dnsName)
+			if !reflect.DeepEqual(ranked, test.expected) {
+				t.Errorf("expected ranking %v, got %v", test.expected, ranked)
+			}
+		})
+	}
+}
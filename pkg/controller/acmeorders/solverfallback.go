@@ -0,0 +1,98 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acmeorders
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/cert-manager/cert-manager/pkg/acme"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const reasonSolverFallback = "SolverFallback"
+
+// abandonTimedOutSolvers records the solver of each Challenge that has not
+// passed its self check within the solver's selfCheckTimeout, measured from
+// when the Challenge was presented, on the Order's
+// authorization, so that the Challenge is replaced by one that uses the next
+// matching solver of the issuer. Solvers are only abandoned if there is
+// another solver to fall back to. It returns true if any solver has been
+// abandoned, otherwise the Order is re-queued for when the next timeout
+// expires.
+func (c *controller) abandonTimedOutSolvers(ctx context.Context, issuer cmapi.GenericIssuer, o *cmacme.Order, challenges []*cmacme.Challenge) bool {
+	log := logf.FromContext(ctx, "abandonTimedOutSolvers")
+	now := c.clock.Now()
+
+	abandoned := false
+	var nextTimeout time.Duration
+	for _, ch := range challenges {
+		timeout := ch.Spec.Solver.SelfCheckTimeout
+		if timeout == nil || ch.Status.Accepted || acme.IsFinalState(ch.Status.State) {
+			continue
+		}
+		// Challenges waiting to be scheduled have not had a chance to pass
+		// their self check yet.
+		if ch.Status.PresentedTime == nil {
+			continue
+		}
+
+		i := slices.IndexFunc(o.Status.Authorizations, func(authz cmacme.ACMEAuthorization) bool {
+			return authz.URL == ch.Spec.AuthorizationURL
+		})
+		if i < 0 {
+			continue
+		}
+		authz := &o.Status.Authorizations[i]
+		if len(authz.AbandonedSolvers)+1 >= len(rankSolversForAuthorization(ctx, issuer, o, *authz)) {
+			log.V(logf.DebugLevel).Info("not abandoning solver as there is no other solver to fall back to", "challenge", ch.Name)
+			continue
+		}
+
+		remaining := ch.Status.PresentedTime.Add(timeout.Duration).Sub(now)
+		if remaining > 0 {
+			if nextTimeout == 0 || remaining < nextTimeout {
+				nextTimeout = remaining
+			}
+			continue
+		}
+
+		log.V(logf.InfoLevel).Info("abandoning solver as the challenge did not pass its self check in time", "challenge", ch.Name, "type", ch.Spec.Type, "dnsName", ch.Spec.DNSName)
+		authz.AbandonedSolvers = append(authz.AbandonedSolvers, cmacme.ACMEAbandonedSolver{
+			Type:          ch.Spec.Type,
+			DNSName:       ch.Spec.DNSName,
+			AbandonedTime: metav1.NewTime(now),
+		})
+		c.recorder.Eventf(o, corev1.EventTypeWarning, reasonSolverFallback, "Challenge %s for %q did not pass its %s self check within %s, falling back to the next matching solver", ch.Name, ch.Spec.DNSName, ch.Spec.Type, timeout.Duration)
+		abandoned = true
+	}
+
+	if !abandoned && nextTimeout > 0 {
+		c.scheduledWorkQueue.Add(types.NamespacedName{
+			Name:      o.Name,
+			Namespace: o.Namespace,
+		}, nextTimeout)
+	}
+	return abandoned
+}
@@ -22,9 +22,9 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"slices"
 	"time"
 
-	acmeapi "golang.org/x/crypto/acme"
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -37,16 +37,19 @@ import (
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	internalorders "github.com/cert-manager/cert-manager/internal/controller/orders"
 	"github.com/cert-manager/cert-manager/pkg/acme"
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
 	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
 )
 
 const (
-	reasonSolver  = "Solver"
-	reasonCreated = "Created"
+	reasonSolver      = "Solver"
+	reasonCreated     = "Created"
+	reasonRateLimited = "RateLimited"
 )
 
 var (
@@ -69,6 +72,10 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 		}
 		log.V(logf.DebugLevel).Info("updating Order resource status")
 		updateErr := c.updateOrApplyStatus(ctx, o)
+		if apierrors.IsNotFound(updateErr) {
+			dbg.Info("skipping updating resource status as the Order has been deleted")
+			return
+		}
 		if updateErr != nil {
 			log.Error(err, "failed to update status")
 			err = utilerrors.NewAggregate([]error{err, updateErr})
@@ -81,9 +88,11 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 	if err != nil {
 		return fmt.Errorf("error reading (cluster)issuer %q: %v", o.Spec.IssuerRef.Name, err)
 	}
-	cl, err := c.accountRegistry.GetClient(string(genericIssuer.GetUID()))
-	if err != nil {
-		return err
+
+	// clear the rate limit once it has been reset
+	if until := o.Status.RateLimitedUntil; until != nil && !c.clock.Now().Before(until.Time) {
+		o.Status.RateLimitedUntil = nil
+		o.Status.Reason = ""
 	}
 
 	switch {
@@ -91,9 +100,27 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 		log.V(logf.DebugLevel).Info("Doing nothing as Order is in a failed state")
 		// if the Order is failed there's nothing left for us to do, return nil
 		return nil
+	case o.Status.RateLimitedUntil != nil:
+		log.V(logf.DebugLevel).Info("Doing nothing as the ACME server rate limited the Order", "until", o.Status.RateLimitedUntil.Time)
+		c.scheduledWorkQueue.Add(types.NamespacedName{
+			Name:      o.Name,
+			Namespace: o.Namespace,
+		}, o.Status.RateLimitedUntil.Sub(c.clock.Now()))
+		return nil
 	case o.Status.URL == "":
 		log.V(logf.DebugLevel).Info("Creating new ACME order as status.url is not set")
-		return c.createOrder(ctx, cl, o)
+		return c.createOrder(ctx, genericIssuer, o)
+	}
+
+	// Once the order has been submitted, all further requests must be made
+	// to the ACME server that the order was submitted to.
+	clientID := accounts.ClientID(string(genericIssuer.GetUID()), genericIssuer.GetSpec().ACME, o.Status.Server)
+	cl, err := c.accountRegistry.GetClient(clientID)
+	if err != nil {
+		return err
+	}
+
+	switch {
 	case o.Status.FinalizeURL == "":
 		log.V(logf.DebugLevel).Info("Updating Order status as status.finalizeURL is not set")
 		_, err := c.updateOrderStatus(ctx, cl, o)
@@ -108,7 +135,7 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 		return err
 	case anyAuthorizationsMissingMetadata(o):
 		log.V(logf.DebugLevel).Info("Fetching Authorizations from ACME server as status.authorizations contains unpopulated authorizations")
-		return c.fetchMetadataForAuthorizations(ctx, o, cl)
+		return c.fetchMetadataForAuthorizations(ctx, o, cl, clientID)
 	// TODO: is this state possible? Either remove this case or add a comment as to what path could lead to it
 	case o.Status.State == cmacme.Valid && o.Status.Certificate == nil:
 		log.V(logf.DebugLevel).Info("Order is in a Valid state but the Certificate data is empty, fetching existing Certificate")
@@ -165,6 +192,13 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 		return c.finalizeOrder(ctx, cl, o, genericIssuer)
 	}
 
+	// Replace the Challenges whose solver did not pass its self check in
+	// time with Challenges that use the next matching solver. The new
+	// Challenges are created during the next sync of the Order.
+	if c.abandonTimedOutSolvers(ctx, genericIssuer, o, challenges) {
+		return nil
+	}
+
 	// At this point, if no Challenges have failed or reached a final state,
 	// we can return without taking any action. This controller will resync
 	// the Order on any owned Challenge events.
@@ -201,6 +235,17 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 		// server cannot be determined from challenge resource statuses
 		// correctly. Do not change this unless there is a real need for
 		// it.
+		// A Challenge that failed before it was accepted, for example
+		// because its DNS01 record was not created in time, is never
+		// reported to the ACME server, so the ACME Order remains pending.
+		// Fail the Order so that the Certificate is re-issued.
+		if ch := firstErroredChallenge(challenges); ch != nil && acmeOrder.Status == acmeapi.StatusPending {
+			log.V(logf.DebugLevel).Info("Marking Order as failed as a Challenge failed before it was accepted", "challenge", ch.Name)
+			c.setOrderState(&o.Status, string(cmacme.Errored))
+			o.Status.Reason = fmt.Sprintf("Challenge %s for %q failed: %s", ch.Name, ch.Spec.DNSName, ch.Status.Reason)
+			return nil
+		}
+
 		log.V(logf.DebugLevel).Info("Update Order status as at least one Challenge has failed")
 		_, err := c.updateOrderStatusFromACMEOrder(o, acmeOrder)
 		if acmeErr, ok := err.(*acmeapi.Error); ok {
@@ -222,6 +267,15 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 	// https://tools.ietf.org/html/rfc8555#section-7.1.6
 	// https://github.com/cert-manager/cert-manager/issues/2868
 	case !anyChallengesFailed(challenges) && allChallengesFinal(challenges) && acmeOrder.Status == acmeapi.StatusPending:
+		// An authorization taken from the authorization cache may no longer
+		// be valid, e.g. if it has been deactivated. Fetch the cached
+		// authorizations from the ACME server again, so that Challenges are
+		// created for them if required.
+		if c.forgetCachedAuthorizations(o, clientID) {
+			log.V(logf.InfoLevel).Info("ACME order is still pending, fetching cached authorizations from the ACME server")
+			return nil
+		}
+
 		log.V(logf.InfoLevel).Info("All challenges in a final state, waiting for ACME server to update the status of the order...")
 		// This is probably not needed as at this point the Order's status
 		// should already be Pending, but set it anyway to be explicit.
@@ -254,7 +308,7 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 	return nil
 }
 
-func (c *controller) createOrder(ctx context.Context, cl acmecl.Interface, o *cmacme.Order) error {
+func (c *controller) createOrder(ctx context.Context, issuer cmapi.GenericIssuer, o *cmacme.Order) error {
 	log := logf.FromContext(ctx)
 
 	if o.Status.URL != "" {
@@ -279,26 +333,104 @@ func (c *controller) createOrder(ctx context.Context, cl acmecl.Interface, o *cm
 	if o.Spec.Duration != nil {
 		options = append(options, acmeapi.WithOrderNotAfter(c.clock.Now().Add(o.Spec.Duration.Duration)))
 	}
-	acmeOrder, err := cl.AuthorizeOrder(ctx, authzIDs, options...)
-	if acmeErr, ok := err.(*acmeapi.Error); ok {
-		if acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
-			log.Error(err, "failed to create Order resource due to bad request, marking Order as failed")
-			c.setOrderState(&o.Status, string(cmacme.Errored))
-			o.Status.Reason = fmt.Sprintf("Failed to create Order: %v", err)
+
+	// Submit the order to the ACME servers of the issuer in turn, until one
+	// of them accepts it. Unless the issuer has fallback servers configured,
+	// this is only the server specified in spec.acme.server.
+	uid := string(issuer.GetUID())
+	servers := c.orderServers(issuer)
+	for i, server := range servers {
+		log := log.WithValues("server", server)
+		last := i == len(servers)-1
+		clientID := accounts.ClientID(uid, issuer.GetSpec().ACME, server)
+
+		cl, err := c.accountRegistry.GetClient(clientID)
+		if err != nil {
+			if last {
+				return err
+			}
+			log.V(logf.DebugLevel).Info("no ACME account registered with server, trying next server", "error", err.Error())
+			continue
+		}
+
+		serverOptions := options
+		if o.Spec.Profile != "" {
+			dir, err := cl.Discover(ctx)
+			if err != nil {
+				if !last && c.failOver(o, clientID, server, err) {
+					continue
+				}
+				return fmt.Errorf("error discovering ACME directory: %v", err)
+			}
+			if err := acme.ValidateProfile(dir, o.Spec.Profile); err != nil {
+				if !last {
+					log.V(logf.InfoLevel).Info("requested ACME profile is not supported by server, trying next server", "error", err.Error())
+					continue
+				}
+				log.Error(err, "requested ACME profile is not supported, marking Order as failed")
+				c.setOrderState(&o.Status, string(cmacme.Errored))
+				o.Status.Reason = fmt.Sprintf("Failed to create Order: %v", err)
+				return nil
+			}
+			serverOptions = append(slices.Clip(options), acmeapi.WithOrderProfile(o.Spec.Profile))
+		}
+
+		acmeOrder, err := c.authorizeOrder(ctx, cl, o, authzIDs, serverOptions)
+		if err != nil && !last && c.failOver(o, clientID, server, err) {
+			continue
+		}
+		if c.deferIfRateLimited(ctx, o, err) {
 			return nil
 		}
+		if acmeErr, ok := err.(*acmeapi.Error); ok {
+			if acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
+				log.Error(err, "failed to create Order resource due to bad request, marking Order as failed")
+				c.setOrderState(&o.Status, string(cmacme.Errored))
+				o.Status.Reason = fmt.Sprintf("Failed to create Order: %v", err)
+				return nil
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("error creating new order: %v", err)
+		}
+		log.V(logf.DebugLevel).Info("submitted Order to ACME server")
+
+		o.Status.Server = server
+		o.Status.URL = acmeOrder.URI
+		o.Status.FinalizeURL = acmeOrder.FinalizeURL
+		o.Status.Authorizations = constructAuthorizations(acmeOrder)
+		c.setOrderState(&o.Status, acmeOrder.Status)
+		if utilfeature.DefaultFeatureGate.Enabled(feature.ACMEAuthorizationCache) {
+			c.applyCachedAuthorizations(o, clientID)
+		}
+
+		return nil
 	}
-	if err != nil {
-		return fmt.Errorf("error creating new order: %v", err)
-	}
-	log.V(logf.DebugLevel).Info("submitted Order to ACME server")
 
-	o.Status.URL = acmeOrder.URI
-	o.Status.FinalizeURL = acmeOrder.FinalizeURL
-	o.Status.Authorizations = constructAuthorizations(acmeOrder)
-	c.setOrderState(&o.Status, acmeOrder.Status)
+	// Not reachable, as the last server always returns above.
+	return fmt.Errorf("no ACME server available to submit Order %q to", o.Name)
+}
 
-	return nil
+// authorizeOrder submits a new order to the ACME server.
+// If the Order is annotated with the ARI identifier of the certificate it
+// replaces, the `replaces` field is set on the new order. Should the ACME
+// server reject the order because of the `replaces` field (e.g. because the
+// certificate has already been replaced or was issued to another account),
+// the order is submitted again without it.
+func (c *controller) authorizeOrder(ctx context.Context, cl acmecl.Interface, o *cmacme.Order, authzIDs []acmeapi.AuthzID, options []acmeapi.OrderOption) (*acmeapi.Order, error) {
+	log := logf.FromContext(ctx)
+
+	replaces := o.Annotations[cmacme.ACMEReplacesAnnotationKey]
+	if replaces == "" || !utilfeature.DefaultFeatureGate.Enabled(feature.ACMERenewalInfo) {
+		return cl.AuthorizeOrder(ctx, authzIDs, options...)
+	}
+
+	acmeOrder, err := cl.AuthorizeOrder(ctx, authzIDs, append(options, acmeapi.WithOrderReplaces(replaces))...)
+	if acmeErr, ok := err.(*acmeapi.Error); ok && acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
+		log.V(logf.InfoLevel).Info("ACME server rejected order replacing a previous certificate, retrying without the replaces field", "replaces", replaces, "error", err.Error())
+		return cl.AuthorizeOrder(ctx, authzIDs, options...)
+	}
+	return acmeOrder, err
 }
 
 func (c *controller) updateOrderStatus(ctx context.Context, cl acmecl.Interface, o *cmacme.Order) (*acmeapi.Order, error) {
@@ -341,6 +473,31 @@ func (c *controller) setOrderState(o *cmacme.OrderStatus, s string) {
 	}
 }
 
+// deferIfRateLimited defers processing the Order until the rate limit has been
+// reset if the given error is a rateLimited error returned by the ACME server.
+// It returns false if the error is not a rateLimited error.
+func (c *controller) deferIfRateLimited(ctx context.Context, o *cmacme.Order, err error) bool {
+	retryAfter, ok := acmeapi.RateLimit(err)
+	if !ok {
+		return false
+	}
+	if retryAfter <= 0 {
+		retryAfter = unavailableServerBackoff
+	}
+
+	until := metav1.NewTime(c.clock.Now().Add(retryAfter))
+	logf.FromContext(ctx).V(logf.InfoLevel).Info("ACME server rate limited the Order, deferring it until the rate limit is reset", "until", until.Time, "error", err.Error())
+	o.Status.RateLimitedUntil = &until
+	o.Status.Reason = fmt.Sprintf("Rate limited by the ACME server until %s: %v", until.UTC().Format(time.RFC3339), err)
+	c.recorder.Event(o, corev1.EventTypeWarning, reasonRateLimited, o.Status.Reason)
+
+	c.scheduledWorkQueue.Add(types.NamespacedName{
+		Name:      o.Name,
+		Namespace: o.Namespace,
+	}, retryAfter)
+	return true
+}
+
 // constructAuthorizations will construct a slice of ACMEAuthorizations must be
 // completed for the given ACME order.
 // It does *not* perform a query against the ACME server for each authorization
@@ -363,8 +520,9 @@ func anyAuthorizationsMissingMetadata(o *cmacme.Order) bool {
 	return false
 }
 
-func (c *controller) fetchMetadataForAuthorizations(ctx context.Context, o *cmacme.Order, cl acmecl.Interface) error {
+func (c *controller) fetchMetadataForAuthorizations(ctx context.Context, o *cmacme.Order, cl acmecl.Interface, clientID string) error {
 	log := logf.FromContext(ctx)
+	cacheAuthorizations := utilfeature.DefaultFeatureGate.Enabled(feature.ACMEAuthorizationCache)
 	for i, authz := range o.Status.Authorizations {
 		// only fetch metadata for each authorization once
 		if authz.Identifier != "" {
@@ -387,6 +545,9 @@ func (c *controller) fetchMetadataForAuthorizations(ctx context.Context, o *cmac
 		authz.InitialState = cmacme.State(acmeAuthz.Status)
 		authz.Identifier = acmeAuthz.Identifier.Value
 		authz.Wildcard = &acmeAuthz.Wildcard
+		if !acmeAuthz.Expires.IsZero() {
+			authz.Expires = &metav1.Time{Time: acmeAuthz.Expires}
+		}
 		authz.Challenges = make([]cmacme.ACMEChallenge, len(acmeAuthz.Challenges))
 		for i, acmech := range acmeAuthz.Challenges {
 			authz.Challenges[i].URL = acmech.URI
@@ -394,10 +555,36 @@ func (c *controller) fetchMetadataForAuthorizations(ctx context.Context, o *cmac
 			authz.Challenges[i].Type = acmech.Type
 		}
 		o.Status.Authorizations[i] = authz
+
+		if authz.InitialState != cmacme.Valid {
+			c.metrics.IncrementACMEOrderAuthorizationCount(authorizationOutcomeChallenge)
+			continue
+		}
+		c.metrics.IncrementACMEOrderAuthorizationCount(authorizationOutcomeReused)
+		if cacheAuthorizations {
+			c.authorizations.add(clientID, authz)
+		}
 	}
 	return nil
 }
 
+// forgetCachedAuthorizations removes the authorizations of the Order that
+// were taken from the authorization cache from both the cache and the Order,
+// so that they are fetched from the ACME server again. It returns false if
+// none of the authorizations of the Order were cached.
+func (c *controller) forgetCachedAuthorizations(o *cmacme.Order, clientID string) bool {
+	forgotten := false
+	for i, authz := range o.Status.Authorizations {
+		if !authz.Cached {
+			continue
+		}
+		c.authorizations.remove(clientID, authz.Identifier, authz.Wildcard != nil && *authz.Wildcard)
+		o.Status.Authorizations[i] = cmacme.ACMEAuthorization{URL: authz.URL}
+		forgotten = true
+	}
+	return forgotten
+}
+
 func (c *controller) anyRequiredChallengesDoNotExist(requiredChallenges []*cmacme.Challenge) (bool, error) {
 	for _, ch := range requiredChallenges {
 		_, err := c.challengeLister.Challenges(ch.Namespace).Get(ch.Name)
@@ -552,6 +739,11 @@ func (c *controller) finalizeOrder(ctx context.Context, cl acmecl.Interface, o *
 
 	}
 
+	// The order can be finalized once the rate limit has been reset.
+	if c.deferIfRateLimited(ctx, o, err) {
+		return nil
+	}
+
 	// Any other ACME 4xx error means that the Order can be considered failed.
 	if ok && acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
 		log.Error(err, "failed to finalize Order resource due to bad request, marking Order as failed")
@@ -576,6 +768,23 @@ func (c *controller) finalizeOrder(ctx context.Context, cl acmecl.Interface, o *
 	}
 	// Check for non-4xx errors from CreateOrderCert
 	if err != nil {
+		// If the issuer has fallback servers configured, give up on this
+		// order after repeated failures so that a new order can be
+		// submitted to one of the other servers.
+		if len(issuer.GetSpec().ACME.FallbackServers) > 0 && !acme.IsFinalState(o.Status.State) {
+			o.Status.FailedFinalizeAttempts++
+			if o.Status.FailedFinalizeAttempts >= maxFailedFinalizeAttempts {
+				c.serverAvailability.markUnavailable(accounts.ClientID(string(issuer.GetUID()), issuer.GetSpec().ACME, o.Status.Server), unavailableServerBackoff)
+				if c.otherServerAvailable(issuer, o.Status.Server) {
+					log.Error(err, "failed to finalize Order repeatedly, deleting Order so that it is submitted to the next ACME server")
+					return c.failOverOrder(ctx, o, err)
+				}
+				log.Error(err, "failed to finalize Order repeatedly and no other ACME server is available, marking Order as failed")
+				c.setOrderState(&o.Status, string(cmacme.Errored))
+				o.Status.Reason = fmt.Sprintf("Failed to finalize Order after %d attempts and no other ACME server is available: %v", o.Status.FailedFinalizeAttempts, err)
+				return nil
+			}
+		}
 		return fmt.Errorf("error finalizing order: %v", err)
 	}
 
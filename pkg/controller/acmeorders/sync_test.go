@@ -21,24 +21,35 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"net/http"
+	"reflect"
 	"testing"
 	"time"
 
-	acmeapi "golang.org/x/crypto/acme"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	coretesting "k8s.io/client-go/testing"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
 	fakeclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
 	accountstest "github.com/cert-manager/cert-manager/pkg/acme/accounts/test"
 	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmfake "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/fake"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
 	schedulertest "github.com/cert-manager/cert-manager/pkg/scheduler/test"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
 )
 
 func TestSync(t *testing.T) {
@@ -302,6 +313,14 @@ Dfvp7OOGAN6dEOM4+qR9sdjoSYKEBpsr6GtPAQw4dy753ec5
 	testAuthorizationChallengeValid.Status.State = cmacme.Valid
 	testAuthorizationChallengeInvalid := testAuthorizationChallenge.DeepCopy()
 	testAuthorizationChallengeInvalid.Status.State = cmacme.Invalid
+	testAuthorizationChallengeErrored := testAuthorizationChallenge.DeepCopy()
+	testAuthorizationChallengeErrored.Status.State = cmacme.Errored
+	testAuthorizationChallengeErrored.Status.Reason = "timed out waiting for the DNS01 record to be created"
+	testOrderChallengeErrored := testOrderPending.DeepCopy()
+	testOrderChallengeErrored.Status.State = cmacme.Errored
+	testOrderChallengeErrored.Status.FailureTime = &nowMetaTime
+	testOrderChallengeErrored.Status.Reason = fmt.Sprintf("Challenge %s for %q failed: timed out waiting for the DNS01 record to be created",
+		testAuthorizationChallenge.Name, testAuthorizationChallenge.Spec.DNSName)
 
 	testACMEAuthorizationPending := &acmeapi.Authorization{
 		URI:    "http://authzurl",
@@ -343,6 +362,24 @@ Dfvp7OOGAN6dEOM4+qR9sdjoSYKEBpsr6GtPAQw4dy753ec5
 	*testACMEOrderInvalid = *testACMEOrderPending
 	testACMEOrderInvalid.Status = acmeapi.StatusInvalid
 
+	rateLimitedErr := &acmeapi.Error{
+		StatusCode:  429,
+		ProblemType: "urn:ietf:params:acme:error:rateLimited",
+		Detail:      "too many new orders recently",
+		Header:      http.Header{"Retry-After": []string{"3600"}},
+	}
+	rateLimitedUntil := metav1.NewTime(nowTime.Add(time.Hour))
+	rateLimitedReason := fmt.Sprintf("Rate limited by the ACME server until %s: %v", rateLimitedUntil.UTC().Format(time.RFC3339), rateLimitedErr)
+	testOrderRateLimited := gen.OrderFrom(testOrder, gen.SetOrderStatus(cmacme.OrderStatus{
+		RateLimitedUntil: &rateLimitedUntil,
+		Reason:           rateLimitedReason,
+	}))
+	rateLimitReset := metav1.NewTime(nowTime.Add(-time.Minute))
+	testOrderRateLimitReset := gen.OrderFrom(testOrder, gen.SetOrderStatus(cmacme.OrderStatus{
+		RateLimitedUntil: &rateLimitReset,
+		Reason:           "Rate limited by the ACME server",
+	}))
+
 	tests := map[string]testT{
 		"create a new order with the acme server, set the order url on the status resource and return nil to avoid cache timing issues": {
 			order: testOrder,
@@ -868,6 +905,25 @@ Dfvp7OOGAN6dEOM4+qR9sdjoSYKEBpsr6GtPAQw4dy753ec5
 				},
 			},
 		},
+		"fail the order if a challenge failed before it was accepted and the acme order is pending": {
+			order: testOrderPending,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, testOrderPending, testAuthorizationChallengeErrored},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("orders"),
+						"status",
+						testOrderChallengeErrored.Namespace, testOrderChallengeErrored)),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeGetOrder: func(_ context.Context, url string) (*acmeapi.Order, error) {
+					return testACMEOrderPending, nil
+				},
+				FakeHTTP01ChallengeResponse: func(s string) (string, error) {
+					return "key", nil
+				},
+			},
+		},
 		"do nothing if the order is valid": {
 			order: testOrderValid,
 			builder: &testpkg.Builder{
@@ -892,6 +948,62 @@ Dfvp7OOGAN6dEOM4+qR9sdjoSYKEBpsr6GtPAQw4dy753ec5
 			},
 			acmeClient: &acmecl.FakeACME{},
 		},
+		"defer the order until the rate limit is reset if the acme server rate limits creating it": {
+			order: testOrder,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, testOrder},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("orders"),
+						"status",
+						testOrderRateLimited.Namespace,
+						testOrderRateLimited)),
+				},
+				ExpectedEvents: []string{
+					"Warning RateLimited " + rateLimitedReason,
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeAuthorizeOrder: func(ctx context.Context, id []acmeapi.AuthzID, opt ...acmeapi.OrderOption) (*acmeapi.Order, error) {
+					return nil, rateLimitedErr
+				},
+			},
+			shouldSchedule: true,
+		},
+		"do nothing but requeue the order if it is rate limited": {
+			order: testOrderRateLimited,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, testOrderRateLimited},
+				ExpectedActions:    []testpkg.Action{},
+			},
+			acmeClient:     &acmecl.FakeACME{},
+			shouldSchedule: true,
+		},
+		"create a new order with the acme server once the rate limit is reset": {
+			order: testOrderRateLimitReset,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, testOrderRateLimitReset},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("orders"),
+						"status",
+						testOrderPending.Namespace,
+						gen.OrderFrom(testOrder, gen.SetOrderStatus(cmacme.OrderStatus{
+							State:       cmacme.Pending,
+							URL:         "http://testurl.com/abcde",
+							FinalizeURL: "http://testurl.com/abcde/finalize",
+							Authorizations: []cmacme.ACMEAuthorization{
+								{
+									URL: "http://authzurl",
+								},
+							},
+						})))),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeAuthorizeOrder: func(ctx context.Context, id []acmeapi.AuthzID, opt ...acmeapi.OrderOption) (*acmeapi.Order, error) {
+					return testACMEOrderPending, nil
+				},
+			},
+		},
 	}
 
 	for name, test := range tests {
@@ -955,3 +1067,616 @@ func runTest(t *testing.T, test testT) {
 
 	test.builder.CheckAndFinish(err)
 }
+
+func TestAuthorizeOrderReplaces(t *testing.T) {
+	const replaces = "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE"
+	replacesOpt := acmeapi.WithOrderReplaces(replaces)
+
+	tests := map[string]struct {
+		featureEnabled bool
+		annotations    map[string]string
+		rejectReplaces bool
+
+		expectedCalls  int
+		expectReplaces []bool
+	}{
+		"do not set replaces if the feature is disabled": {
+			annotations:    map[string]string{cmacme.ACMEReplacesAnnotationKey: replaces},
+			expectedCalls:  1,
+			expectReplaces: []bool{false},
+		},
+		"do not set replaces if the Order is not annotated": {
+			featureEnabled: true,
+			expectedCalls:  1,
+			expectReplaces: []bool{false},
+		},
+		"set replaces if the Order is annotated": {
+			featureEnabled: true,
+			annotations:    map[string]string{cmacme.ACMEReplacesAnnotationKey: replaces},
+			expectedCalls:  1,
+			expectReplaces: []bool{true},
+		},
+		"retry without replaces if the ACME server rejects the order": {
+			featureEnabled: true,
+			annotations:    map[string]string{cmacme.ACMEReplacesAnnotationKey: replaces},
+			rejectReplaces: true,
+			expectedCalls:  2,
+			expectReplaces: []bool{true, false},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, feature.ACMERenewalInfo, test.featureEnabled)
+
+			var gotReplaces []bool
+			cl := &acmecl.FakeACME{
+				FakeAuthorizeOrder: func(_ context.Context, _ []acmeapi.AuthzID, opts ...acmeapi.OrderOption) (*acmeapi.Order, error) {
+					hasReplaces := false
+					for _, opt := range opts {
+						if opt == replacesOpt {
+							hasReplaces = true
+						}
+					}
+					gotReplaces = append(gotReplaces, hasReplaces)
+					if hasReplaces && test.rejectReplaces {
+						return nil, &acmeapi.Error{StatusCode: 409, ProblemType: "urn:ietf:params:acme:error:alreadyReplaced"}
+					}
+					return &acmeapi.Order{URI: "http://testurl.com/abcde"}, nil
+				},
+			}
+
+			o := gen.Order("test", gen.SetOrderDNSNames("example.com"))
+			o.Annotations = test.annotations
+
+			c := &controller{}
+			if _, err := c.authorizeOrder(context.Background(), cl, o, acmeapi.DomainIDs("example.com"), nil); err != nil {
+				t.Fatal(err)
+			}
+			if len(gotReplaces) != test.expectedCalls {
+				t.Fatalf("expected %d calls to AuthorizeOrder, got %d", test.expectedCalls, len(gotReplaces))
+			}
+			for i := range gotReplaces {
+				if gotReplaces[i] != test.expectReplaces[i] {
+					t.Errorf("call %d: expected replaces to be set: %v, got: %v", i, test.expectReplaces[i], gotReplaces[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCreateOrderProfile(t *testing.T) {
+	profiles := map[string]string{
+		"classic":    "The same profile you're accustomed to",
+		"shortlived": "A short-lived certificate profile",
+	}
+
+	tests := map[string]struct {
+		profile  string
+		profiles map[string]string
+
+		expectedProfile string
+		expectedState   cmacme.State
+	}{
+		"do not request a profile if none is set": {
+			profiles:      profiles,
+			expectedState: cmacme.Pending,
+		},
+		"request the profile set on the Order": {
+			profile:         "shortlived",
+			profiles:        profiles,
+			expectedProfile: "shortlived",
+			expectedState:   cmacme.Pending,
+		},
+		"fail the Order if the profile is not offered by the ACME server": {
+			profile:       "tlsserver",
+			profiles:      profiles,
+			expectedState: cmacme.Errored,
+		},
+		"fail the Order if the ACME server does not support profiles": {
+			profile:       "shortlived",
+			expectedState: cmacme.Errored,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotProfile string
+			authorizeCalled := false
+			cl := &acmecl.FakeACME{
+				FakeDiscover: func(context.Context) (acmeapi.Directory, error) {
+					return acmeapi.Directory{Profiles: test.profiles}, nil
+				},
+				FakeAuthorizeOrder: func(_ context.Context, _ []acmeapi.AuthzID, opts ...acmeapi.OrderOption) (*acmeapi.Order, error) {
+					authorizeCalled = true
+					for _, opt := range opts {
+						if opt == acmeapi.WithOrderProfile(test.expectedProfile) {
+							gotProfile = test.expectedProfile
+						}
+					}
+					return &acmeapi.Order{URI: "http://testurl.com/abcde", Status: acmeapi.StatusPending}, nil
+				},
+			}
+
+			o := gen.Order("test", gen.SetOrderDNSNames("example.com"), gen.SetOrderProfile(test.profile))
+
+			iss := gen.Issuer("test", gen.SetIssuerACME(cmacme.ACMEIssuer{Server: "https://acme.example.com"}))
+
+			c := &controller{
+				clock: fakeclock.NewFakeClock(time.Now()),
+				accountRegistry: &accountstest.FakeRegistry{
+					GetClientFunc: func(string) (acmecl.Interface, error) { return cl, nil },
+				},
+			}
+			if err := c.createOrder(context.Background(), iss, o); err != nil {
+				t.Fatal(err)
+			}
+			if o.Status.State != test.expectedState {
+				t.Errorf("expected Order state %q, got %q (reason: %s)", test.expectedState, o.Status.State, o.Status.Reason)
+			}
+			if authorizeCalled == (test.expectedState == cmacme.Errored) {
+				t.Errorf("expected AuthorizeOrder to be called: %v, got: %v", test.expectedState != cmacme.Errored, authorizeCalled)
+			}
+			if gotProfile != test.expectedProfile {
+				t.Errorf("expected profile %q to be requested, got %q", test.expectedProfile, gotProfile)
+			}
+		})
+	}
+}
+
+func TestCreateOrderFailover(t *testing.T) {
+	const (
+		primary  = "https://primary.example.com/directory"
+		fallback = "https://fallback.example.com/directory"
+	)
+	rateLimited := &acmeapi.Error{StatusCode: 429, ProblemType: "urn:ietf:params:acme:error:rateLimited"}
+	unavailable := &acmeapi.Error{StatusCode: 503, ProblemType: "urn:ietf:params:acme:error:serverInternal"}
+	malformed := &acmeapi.Error{StatusCode: 400, ProblemType: "urn:ietf:params:acme:error:malformed"}
+
+	tests := map[string]struct {
+		errors             map[string]error
+		primaryUnavailable bool
+
+		expectedServer    string
+		expectedState     cmacme.State
+		expectedCalls     []string
+		expectedEvents    int
+		expectRateLimited bool
+	}{
+		"submit the order to the primary server": {
+			expectedServer: primary,
+			expectedState:  cmacme.Pending,
+			expectedCalls:  []string{primary},
+		},
+		"fail over to the fallback server if the primary server is rate limiting": {
+			errors:         map[string]error{primary: rateLimited},
+			expectedServer: fallback,
+			expectedState:  cmacme.Pending,
+			expectedCalls:  []string{primary, fallback},
+			expectedEvents: 1,
+		},
+		"fail over to the fallback server if the primary server is unavailable": {
+			errors:         map[string]error{primary: unavailable},
+			expectedServer: fallback,
+			expectedState:  cmacme.Pending,
+			expectedCalls:  []string{primary, fallback},
+			expectedEvents: 1,
+		},
+		"do not fail over if the primary server rejects the order": {
+			errors:        map[string]error{primary: malformed},
+			expectedState: cmacme.Errored,
+			expectedCalls: []string{primary},
+		},
+		"defer the Order if all servers are rate limiting": {
+			errors:            map[string]error{primary: rateLimited, fallback: rateLimited},
+			expectedCalls:     []string{primary, fallback},
+			expectedEvents:    2,
+			expectRateLimited: true,
+		},
+		"try the fallback server first if the primary server failed recently": {
+			primaryUnavailable: true,
+			expectedServer:     fallback,
+			expectedState:      cmacme.Pending,
+			expectedCalls:      []string{fallback},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			iss := gen.Issuer("test",
+				gen.SetIssuerACME(cmacme.ACMEIssuer{
+					Server: primary,
+					FallbackServers: []cmacme.ACMEFallbackServer{
+						{Server: fallback},
+					},
+				}),
+			)
+			spec := iss.GetSpec().ACME
+
+			var calls []string
+			clients := make(map[string]acmecl.Interface)
+			for _, server := range []string{primary, fallback} {
+				clients[accounts.ClientID(string(iss.UID), spec, server)] = &acmecl.FakeACME{
+					FakeAuthorizeOrder: func(context.Context, []acmeapi.AuthzID, ...acmeapi.OrderOption) (*acmeapi.Order, error) {
+						calls = append(calls, server)
+						if err := test.errors[server]; err != nil {
+							return nil, err
+						}
+						return &acmeapi.Order{URI: server + "/order/1", Status: acmeapi.StatusPending}, nil
+					},
+				}
+			}
+
+			clock := fakeclock.NewFakeClock(time.Now())
+			recorder := new(testpkg.FakeRecorder)
+			c := &controller{
+				clock:    clock,
+				recorder: recorder,
+				accountRegistry: &accountstest.FakeRegistry{
+					GetClientFunc: func(id string) (acmecl.Interface, error) {
+						cl, ok := clients[id]
+						if !ok {
+							return nil, accounts.ErrNotFound
+						}
+						return cl, nil
+					},
+				},
+				serverAvailability: newServerAvailability(clock),
+				scheduledWorkQueue: &schedulertest.FakeScheduler{
+					AddFunc: func(types.NamespacedName, time.Duration) {},
+				},
+			}
+			if test.primaryUnavailable {
+				c.serverAvailability.markUnavailable(accounts.ClientID(string(iss.UID), spec, primary), time.Minute)
+			}
+
+			o := gen.Order("test", gen.SetOrderDNSNames("example.com"))
+			if err := c.createOrder(context.Background(), iss, o); err != nil {
+				t.Fatal(err)
+			}
+			if o.Status.State != test.expectedState {
+				t.Errorf("expected Order state %q, got %q (reason: %s)", test.expectedState, o.Status.State, o.Status.Reason)
+			}
+			if o.Status.Server != test.expectedServer {
+				t.Errorf("expected Order to be submitted to server %q, got %q", test.expectedServer, o.Status.Server)
+			}
+			if !reflect.DeepEqual(calls, test.expectedCalls) {
+				t.Errorf("expected servers %v to be called, got %v", test.expectedCalls, calls)
+			}
+			if len(recorder.Events) != test.expectedEvents {
+				t.Errorf("expected %d events, got %v", test.expectedEvents, recorder.Events)
+			}
+			if rateLimited := o.Status.RateLimitedUntil != nil; rateLimited != test.expectRateLimited {
+				t.Errorf("expected Order to be rate limited: %v, got: %v", test.expectRateLimited, rateLimited)
+			}
+		})
+	}
+}
+
+func TestFinalizeOrderFailover(t *testing.T) {
+	const (
+		primary  = "https://primary.example.com/directory"
+		fallback = "https://fallback.example.com/directory"
+	)
+
+	tests := map[string]struct {
+		fallbackServers     []cmacme.ACMEFallbackServer
+		failedAttempts      int
+		fallbackUnavailable bool
+
+		expectErr              bool
+		expectedState          cmacme.State
+		expectedFailedAttempts int
+		expectUnavailable      bool
+		expectDeleted          bool
+	}{
+		"retry finalizing the order if the issuer has no fallback servers": {
+			failedAttempts:         maxFailedFinalizeAttempts,
+			expectErr:              true,
+			expectedState:          cmacme.Ready,
+			expectedFailedAttempts: maxFailedFinalizeAttempts,
+		},
+		"count failed attempts if the issuer has fallback servers": {
+			fallbackServers:        []cmacme.ACMEFallbackServer{{Server: fallback}},
+			expectErr:              true,
+			expectedState:          cmacme.Ready,
+			expectedFailedAttempts: 1,
+		},
+		"delete the Order after repeated failures so that it is submitted to the fallback server": {
+			fallbackServers:        []cmacme.ACMEFallbackServer{{Server: fallback}},
+			failedAttempts:         maxFailedFinalizeAttempts - 1,
+			expectedState:          cmacme.Ready,
+			expectedFailedAttempts: maxFailedFinalizeAttempts,
+			expectUnavailable:      true,
+			expectDeleted:          true,
+		},
+		"fail the Order after repeated failures if the fallback servers are unavailable": {
+			fallbackServers:        []cmacme.ACMEFallbackServer{{Server: fallback}},
+			failedAttempts:         maxFailedFinalizeAttempts - 1,
+			fallbackUnavailable:    true,
+			expectedState:          cmacme.Errored,
+			expectedFailedAttempts: maxFailedFinalizeAttempts,
+			expectUnavailable:      true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			iss := gen.Issuer("test",
+				gen.SetIssuerACME(cmacme.ACMEIssuer{
+					Server:          primary,
+					FallbackServers: test.fallbackServers,
+				}),
+			)
+			cl := &acmecl.FakeACME{
+				FakeCreateOrderCert: func(context.Context, string, []byte, bool) ([][]byte, string, error) {
+					return nil, "", &acmeapi.Error{StatusCode: 500, ProblemType: "urn:ietf:params:acme:error:serverInternal"}
+				},
+				FakeGetOrder: func(context.Context, string) (*acmeapi.Order, error) {
+					return &acmeapi.Order{URI: "http://testurl.com/abcde", Status: acmeapi.StatusReady}, nil
+				},
+			}
+
+			o := gen.Order("test",
+				gen.SetOrderNamespace("default"),
+				gen.SetOrderURL("http://testurl.com/abcde"),
+				gen.SetOrderState(cmacme.Ready),
+			)
+			o.Status.Server = primary
+			o.Status.FailedFinalizeAttempts = test.failedAttempts
+
+			clock := fakeclock.NewFakeClock(time.Now())
+			cmClient := cmfake.NewSimpleClientset(o)
+			c := &controller{
+				clock:              clock,
+				recorder:           new(testpkg.FakeRecorder),
+				cmClient:           cmClient,
+				serverAvailability: newServerAvailability(clock),
+			}
+			if test.fallbackUnavailable {
+				c.serverAvailability.markUnavailable(accounts.ClientID(string(iss.UID), iss.GetSpec().ACME, fallback), time.Hour)
+			}
+
+			err := c.finalizeOrder(context.Background(), cl, o, iss)
+			if (err != nil) != test.expectErr {
+				t.Errorf("expected error: %v, got: %v", test.expectErr, err)
+			}
+			if o.Status.State != test.expectedState {
+				t.Errorf("expected Order state %q, got %q", test.expectedState, o.Status.State)
+			}
+			if o.Status.FailedFinalizeAttempts != test.expectedFailedAttempts {
+				t.Errorf("expected %d failed finalize attempts, got %d", test.expectedFailedAttempts, o.Status.FailedFinalizeAttempts)
+			}
+			if available := c.serverAvailability.available(accounts.ClientID(string(iss.UID), iss.GetSpec().ACME, primary)); available == test.expectUnavailable {
+				t.Errorf("expected server to be unavailable: %v, got: %v", test.expectUnavailable, !available)
+			}
+			_, err = cmClient.AcmeV1().Orders(o.Namespace).Get(context.Background(), o.Name, metav1.GetOptions{})
+			if deleted := apierrors.IsNotFound(err); deleted != test.expectDeleted {
+				t.Errorf("expected Order to be deleted: %v, got: %v", test.expectDeleted, deleted)
+			}
+		})
+	}
+}
+
+func TestFetchMetadataForAuthorizationsCache(t *testing.T) {
+	featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, feature.ACMEAuthorizationCache, true)
+
+	const (
+		validURL   = "http://testurl.com/authz/valid"
+		pendingURL = "http://testurl.com/authz/pending"
+	)
+
+	clock := fakeclock.NewFakeClock(time.Now())
+	expires := clock.Now().Add(24 * time.Hour)
+
+	var fetched []string
+	cl := &acmecl.FakeACME{
+		FakeGetAuthorization: func(_ context.Context, url string) (*acmeapi.Authorization, error) {
+			fetched = append(fetched, url)
+			authz := &acmeapi.Authorization{
+				URI:        url,
+				Status:     acmeapi.StatusValid,
+				Identifier: acmeapi.AuthzID{Type: "dns", Value: "valid.example.com"},
+				Expires:    expires,
+			}
+			if url == pendingURL {
+				authz.Status = acmeapi.StatusPending
+				authz.Identifier.Value = "pending.example.com"
+			}
+			return authz, nil
+		},
+	}
+
+	c := &controller{
+		clock:          clock,
+		authorizations: newAuthorizationCache(clock),
+		metrics:        metrics.New(logf.Log, clock),
+	}
+
+	// fetchMetadata builds a new Order that the ACME server attached the same
+	// authorizations to and fetches the metadata of its authorizations.
+	fetchMetadata := func(t *testing.T) *cmacme.Order {
+		fetched = nil
+		o := gen.Order("test",
+			gen.SetOrderDNSNames("valid.example.com", "pending.example.com"),
+			gen.SetOrderStatus(cmacme.OrderStatus{
+				Authorizations: []cmacme.ACMEAuthorization{{URL: validURL}, {URL: pendingURL}},
+			}),
+		)
+		c.applyCachedAuthorizations(o, "test-uid")
+		if err := c.fetchMetadataForAuthorizations(context.Background(), o, cl, "test-uid"); err != nil {
+			t.Fatal(err)
+		}
+		return o
+	}
+
+	t.Run("fetch all authorizations that are not cached", func(t *testing.T) {
+		o := fetchMetadata(t)
+		if !reflect.DeepEqual(fetched, []string{validURL, pendingURL}) {
+			t.Errorf("expected all authorizations to be fetched, got %v", fetched)
+		}
+		if authz := o.Status.Authorizations[0]; authz.Cached || authz.Expires == nil || !authz.Expires.Time.Equal(expires) {
+			t.Errorf("expected authorization with expiry that was not cached, got %+v", authz)
+		}
+	})
+
+	t.Run("reuse cached valid authorizations", func(t *testing.T) {
+		o := fetchMetadata(t)
+		if !reflect.DeepEqual(fetched, []string{pendingURL}) {
+			t.Errorf("expected only the pending authorization to be fetched, got %v", fetched)
+		}
+		authz := o.Status.Authorizations[0]
+		if !authz.Cached || authz.InitialState != cmacme.Valid || authz.Identifier != "valid.example.com" {
+			t.Errorf("expected cached valid authorization, got %+v", authz)
+		}
+
+		if !c.forgetCachedAuthorizations(o, "test-uid") {
+			t.Fatal("expected the cached authorization to be forgotten")
+		}
+		if !reflect.DeepEqual(o.Status.Authorizations[0], cmacme.ACMEAuthorization{URL: validURL}) {
+			t.Errorf("expected the metadata of the cached authorization to be cleared, got %+v", o.Status.Authorizations[0])
+		}
+	})
+
+	t.Run("fetch cached authorizations that the ACME server did not attach to the Order", func(t *testing.T) {
+		// populate the cache again
+		fetchMetadata(t)
+
+		fetched = nil
+		o := gen.Order("test",
+			gen.SetOrderDNSNames("valid.example.com"),
+			gen.SetOrderStatus(cmacme.OrderStatus{
+				Authorizations: []cmacme.ACMEAuthorization{{URL: pendingURL}},
+			}),
+		)
+		c.applyCachedAuthorizations(o, "test-uid")
+		if o.Status.Authorizations[0].Cached {
+			t.Errorf("expected the authorization not to be taken from the cache, got %+v", o.Status.Authorizations[0])
+		}
+		if !c.forgetCachedAuthorizations(fetchMetadata(t), "test-uid") {
+			t.Fatal("expected the cached authorization to be forgotten")
+		}
+	})
+
+	t.Run("fetch authorizations that have been forgotten", func(t *testing.T) {
+		fetchMetadata(t)
+		if !reflect.DeepEqual(fetched, []string{validURL, pendingURL}) {
+			t.Errorf("expected all authorizations to be fetched, got %v", fetched)
+		}
+	})
+
+	t.Run("fetch cached authorizations that are about to expire", func(t *testing.T) {
+		clock.SetTime(expires.Add(-authorizationExpiryMargin / 2))
+		fetchMetadata(t)
+		if !reflect.DeepEqual(fetched, []string{validURL, pendingURL}) {
+			t.Errorf("expected all authorizations to be fetched, got %v", fetched)
+		}
+	})
+}
+
+func TestAbandonTimedOutSolvers(t *testing.T) {
+	const authzURL = "http://testurl.com/authz/1"
+
+	http01Solver := cmacme.ACMEChallengeSolver{
+		Selector: &cmacme.CertificateDNSNameSelector{
+			DNSNames: []string{"example.com"},
+		},
+		HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+			Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+		},
+		SelfCheckTimeout: &metav1.Duration{Duration: 10 * time.Minute},
+	}
+	dns01Solver := cmacme.ACMEChallengeSolver{
+		DNS01: &cmacme.ACMEChallengeSolverDNS01{
+			Webhook: &cmacme.ACMEIssuerDNS01ProviderWebhook{},
+		},
+	}
+
+	tests := map[string]struct {
+		solvers      []cmacme.ACMEChallengeSolver
+		age          time.Duration
+		notPresented bool
+		accepted     bool
+
+		expectAbandoned bool
+		expectRequeue   time.Duration
+	}{
+		"requeue the Order for when the self check times out": {
+			solvers:       []cmacme.ACMEChallengeSolver{http01Solver, dns01Solver},
+			age:           4 * time.Minute,
+			expectRequeue: 6 * time.Minute,
+		},
+		"abandon the solver once the self check timed out": {
+			solvers:         []cmacme.ACMEChallengeSolver{http01Solver, dns01Solver},
+			age:             11 * time.Minute,
+			expectAbandoned: true,
+		},
+		"do not abandon the solver if the challenge has not been presented yet": {
+			solvers:      []cmacme.ACMEChallengeSolver{http01Solver, dns01Solver},
+			notPresented: true,
+		},
+		"do not abandon the solver if the challenge has been accepted": {
+			solvers:  []cmacme.ACMEChallengeSolver{http01Solver, dns01Solver},
+			age:      11 * time.Minute,
+			accepted: true,
+		},
+		"do not abandon the solver if there is no other solver to fall back to": {
+			solvers: []cmacme.ACMEChallengeSolver{http01Solver},
+			age:     11 * time.Minute,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			clock := fakeclock.NewFakeClock(time.Now())
+			recorder := new(testpkg.FakeRecorder)
+			var requeue time.Duration
+			c := &controller{
+				clock:    clock,
+				recorder: recorder,
+				scheduledWorkQueue: &schedulertest.FakeScheduler{
+					AddFunc: func(_ types.NamespacedName, d time.Duration) { requeue = d },
+				},
+			}
+
+			iss := gen.Issuer("test", gen.SetIssuerACME(cmacme.ACMEIssuer{Solvers: test.solvers}))
+			o := gen.Order("test", gen.SetOrderDNSNames("example.com"))
+			o.Status.Authorizations = []cmacme.ACMEAuthorization{{
+				URL:        authzURL,
+				Identifier: "example.com",
+				Challenges: []cmacme.ACMEChallenge{
+					{Type: "http-01", Token: "http-01-token"},
+					{Type: "dns-01", Token: "dns-01-token"},
+				},
+			}}
+			ch := gen.Challenge("test",
+				gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+				gen.SetChallengeDNSName("example.com"),
+			)
+			// The timeout is measured from when the challenge was presented,
+			// not from when it was created.
+			ch.CreationTimestamp = metav1.NewTime(clock.Now().Add(-time.Hour))
+			if !test.notPresented {
+				ch.Status.PresentedTime = ptr.To(metav1.NewTime(clock.Now().Add(-test.age)))
+			}
+			ch.Spec.AuthorizationURL = authzURL
+			ch.Spec.Solver = http01Solver
+			ch.Status.State = cmacme.Pending
+			ch.Status.Accepted = test.accepted
+
+			abandoned := c.abandonTimedOutSolvers(context.Background(), iss, o, []*cmacme.Challenge{ch})
+			if abandoned != test.expectAbandoned {
+				t.Errorf("expected abandoned: %v, got: %v", test.expectAbandoned, abandoned)
+			}
+			if n := len(o.Status.Authorizations[0].AbandonedSolvers); (n > 0) != test.expectAbandoned {
+				t.Errorf("expected abandoned solvers to be recorded: %v, got %d", test.expectAbandoned, n)
+			}
+			if (len(recorder.Events) > 0) != test.expectAbandoned {
+				t.Errorf("expected an event: %v, got %v", test.expectAbandoned, recorder.Events)
+			}
+			if requeue != test.expectRequeue {
+				t.Errorf("expected Order to be requeued after %s, got %s", test.expectRequeue, requeue)
+			}
+		})
+	}
+}
@@ -74,205 +74,135 @@ func buildPartialChallenge(ctx context.Context, issuer cmapi.GenericIssuer, o *c
 		return nil, err
 	}
 
-	return &cmacme.Challenge{
+	ch := &cmacme.Challenge{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            chName,
 			Namespace:       o.Namespace,
 			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(o, orderGvk)},
 		},
 		Spec: *chSpec,
-	}, nil
+	}
+	// Propagate the scheduling weight of the Order so that the challenge
+	// scheduler can order the challenges of the namespace by it.
+	if weight, ok := o.Annotations[cmacme.ACMEChallengeSchedulingWeightAnnotationKey]; ok {
+		ch.Annotations = map[string]string{cmacme.ACMEChallengeSchedulingWeightAnnotationKey: weight}
+	}
+	return ch, nil
 }
 
-// partialChallengeSpecForAuthorization builds a partial challenge spec by
-// looking at the ACME authorization object and issuer. It does not make any
-// ACME calls.
-func partialChallengeSpecForAuthorization(ctx context.Context, issuer cmapi.GenericIssuer, o *cmacme.Order, authz cmacme.ACMEAuthorization) (*cmacme.ChallengeSpec, error) {
-	log := logf.FromContext(ctx, "challengeSpecForAuthorization")
-	dbg := log.V(logf.DebugLevel)
+// rankedSolver is a solver that can be used to solve an ACME authorization,
+// together with the ACME challenge it would solve.
+type rankedSolver struct {
+	solver    *cmacme.ACMEChallengeSolver
+	challenge *cmacme.ACMEChallenge
+}
+
+// rankSolversForAuthorization returns the solvers of the issuer that can be
+// used to solve the given ACME authorization, ordered by how specifically
+// their selector matches the authorization.
+func rankSolversForAuthorization(ctx context.Context, issuer cmapi.GenericIssuer, o *cmacme.Order, authz cmacme.ACMEAuthorization) []rankedSolver {
+	dbg := logf.FromContext(ctx, "rankSolversForAuthorization").V(logf.DebugLevel)
 
-	// 1. fetch solvers from issuer
 	solvers := issuer.GetSpec().ACME.Solvers
 
-	wc := false
-	if authz.Wildcard != nil {
-		wc = *authz.Wildcard
-	}
 	domainToFind := authz.Identifier
-	if wc {
+	if authz.Wildcard != nil && *authz.Wildcard {
 		domainToFind = "*." + domainToFind
 	}
 
-	var selectedSolver *cmacme.ACMEChallengeSolver
-	var selectedChallenge *cmacme.ACMEChallenge
-	selectedNumLabelsMatch := 0
-	selectedNumDNSNamesMatch := 0
-	selectedNumDNSZonesMatch := 0
-
 	challengeForSolver := func(solver *cmacme.ACMEChallengeSolver) *cmacme.ACMEChallenge {
+		var dns01Challenge *cmacme.ACMEChallenge
 		for _, ch := range authz.Challenges {
 			switch {
 			case ch.Type == "http-01" && solver.HTTP01 != nil:
 				return &ch
 			case ch.Type == "dns-01" && solver.DNS01 != nil:
+				if !solver.DNS01.UseDNSAccountChallenge {
+					return &ch
+				}
+				// prefer a dns-account-01 challenge if the ACME server
+				// offers one, and fall back to dns-01 otherwise
+				if dns01Challenge == nil {
+					dns01Challenge = &ch
+				}
+			case ch.Type == "dns-account-01" && solver.DNS01 != nil && solver.DNS01.UseDNSAccountChallenge:
+				return &ch
+			case ch.Type == "tls-alpn-01" && solver.TLSALPN01 != nil:
 				return &ch
 			}
 		}
-		return nil
+		return dns01Challenge
 	}
 
-	// 2. filter solvers to only those that matchLabels
-	for _, cfg := range solvers {
-		acmech := challengeForSolver(&cfg) // #nosec G601 -- False positive. See https://github.com/golang/go/discussions/56010
+	var ranked []rankedSolver
+	for _, i := range selectors.Rank(solvers, o.ObjectMeta, domainToFind) {
+		acmech := challengeForSolver(&solvers[i])
 		if acmech == nil {
-			dbg.Info("cannot use solver as the ACME authorization does not allow solvers of this type")
-			continue
-		}
-
-		if cfg.Selector == nil {
-			if selectedSolver != nil {
-				dbg.Info("not selecting solver as previously selected solver has a just as or more specific selector")
-				continue
-			}
-			dbg.Info("selecting solver due to match all selector and no previously selected solver")
-			selectedSolver = cfg.DeepCopy()
-			selectedChallenge = acmech
-			continue
-		}
-
-		labelsMatch, numLabelsMatch := selectors.Labels(*cfg.Selector).Matches(o.ObjectMeta, domainToFind)
-		dnsNamesMatch, numDNSNamesMatch := selectors.DNSNames(*cfg.Selector).Matches(o.ObjectMeta, domainToFind)
-		dnsZonesMatch, numDNSZonesMatch := selectors.DNSZones(*cfg.Selector).Matches(o.ObjectMeta, domainToFind)
-
-		if !labelsMatch || !dnsNamesMatch || !dnsZonesMatch {
-			dbg.Info("not selecting solver", "labels_match", labelsMatch, "dnsnames_match", dnsNamesMatch, "dnszones_match", dnsZonesMatch)
-			continue
-		}
-
-		dbg.Info("selector matches")
-
-		selectSolver := func() {
-			selectedSolver = cfg.DeepCopy()
-			selectedChallenge = acmech
-			selectedNumLabelsMatch = numLabelsMatch
-			selectedNumDNSNamesMatch = numDNSNamesMatch
-			selectedNumDNSZonesMatch = numDNSZonesMatch
-		}
-
-		if selectedSolver == nil {
-			dbg.Info("selecting solver as there is no previously selected solver")
-			selectSolver()
-			continue
-		}
-
-		dbg.Info("determining whether this match is more significant than last")
-
-		// because we don't count multiple dnsName matches as extra 'weight'
-		// in the selection process, we normalize the numDNSNamesMatch vars
-		// to be either 1 or 0 (i.e. true or false)
-		selectedHasMatchingDNSNames := selectedNumDNSNamesMatch > 0
-		hasMatchingDNSNames := numDNSNamesMatch > 0
-
-		// dnsName selectors have the highest precedence, so check them first
-		switch {
-		case !selectedHasMatchingDNSNames && hasMatchingDNSNames:
-			dbg.Info("selecting solver as this solver has matching DNS names and the previous one does not")
-			selectSolver()
-			continue
-		case selectedHasMatchingDNSNames && !hasMatchingDNSNames:
-			dbg.Info("not selecting solver as the previous one has matching DNS names and this one does not")
-			continue
-		case !selectedHasMatchingDNSNames && !hasMatchingDNSNames:
-			dbg.Info("solver does not have any matching DNS names, checking dnsZones")
-			// check zones
-		case selectedHasMatchingDNSNames && hasMatchingDNSNames:
-			dbg.Info("both this solver and the previously selected one matches dnsNames, comparing zones")
-			if numDNSZonesMatch > selectedNumDNSZonesMatch {
-				dbg.Info("selecting solver as this one has a more specific dnsZone match than the previously selected one")
-				selectSolver()
-				continue
-			}
-			if selectedNumDNSZonesMatch > numDNSZonesMatch {
-				dbg.Info("not selecting this solver as the previously selected one has a more specific dnsZone match")
-				continue
-			}
-			dbg.Info("both this solver and the previously selected one match dnsZones, comparing labels")
-			// choose the one with the most labels
-			if numLabelsMatch > selectedNumLabelsMatch {
-				dbg.Info("selecting solver as this one has more labels than the previously selected one")
-				selectSolver()
-				continue
-			}
-			dbg.Info("not selecting this solver as previous one has either the same number of or more labels")
-			continue
-		}
-
-		selectedHasMatchingDNSZones := selectedNumDNSZonesMatch > 0
-		hasMatchingDNSZones := numDNSZonesMatch > 0
-
-		switch {
-		case !selectedHasMatchingDNSZones && hasMatchingDNSZones:
-			dbg.Info("selecting solver as this solver has matching DNS zones and the previous one does not")
-			selectSolver()
-			continue
-		case selectedHasMatchingDNSZones && !hasMatchingDNSZones:
-			dbg.Info("not selecting solver as the previous one has matching DNS zones and this one does not")
-			continue
-		case !selectedHasMatchingDNSZones && !hasMatchingDNSZones:
-			dbg.Info("solver does not have any matching DNS zones, checking labels")
-			// check labels
-		case selectedHasMatchingDNSZones && hasMatchingDNSZones:
-			dbg.Info("both this solver and the previously selected one matches dnsZones")
-			dbg.Info("comparing number of matching domain segments")
-			// choose the one with the most matching DNS zone segments
-			if numDNSZonesMatch > selectedNumDNSZonesMatch {
-				dbg.Info("selecting solver because this one has more matching DNS zone segments")
-				selectSolver()
-				continue
-			}
-			if selectedNumDNSZonesMatch > numDNSZonesMatch {
-				dbg.Info("not selecting solver because previous one has more matching DNS zone segments")
-				continue
-			}
-			// choose the one with the most labels
-			if numLabelsMatch > selectedNumLabelsMatch {
-				dbg.Info("selecting solver because this one has more labels than the previous one")
-				selectSolver()
-				continue
-			}
-			dbg.Info("not selecting solver as this one's number of matching labels is equal to or less than the last one")
+			dbg.Info("cannot use solver as the ACME authorization does not allow solvers of this type", "solver_index", i)
 			continue
 		}
+		ranked = append(ranked, rankedSolver{solver: solvers[i].DeepCopy(), challenge: acmech})
+	}
+	return ranked
+}
 
-		if numLabelsMatch > selectedNumLabelsMatch {
-			dbg.Info("selecting solver as this one has more labels than the last one")
-			selectSolver()
-			continue
-		}
+// partialChallengeSpecForAuthorization builds a partial challenge spec by
+// looking at the ACME authorization object and issuer. It does not make any
+// ACME calls.
+func partialChallengeSpecForAuthorization(ctx context.Context, issuer cmapi.GenericIssuer, o *cmacme.Order, authz cmacme.ACMEAuthorization) (*cmacme.ChallengeSpec, error) {
+	log := logf.FromContext(ctx, "challengeSpecForAuthorization")
+	dbg := log.V(logf.DebugLevel)
 
-		dbg.Info("not selecting solver as this one's number of matching labels is equal to or less than the last one (reached end of loop)")
-		// if we get here, the number of matches is less than or equal so we
-		// fallback to choosing the first in the list
+	wc := false
+	if authz.Wildcard != nil {
+		wc = *authz.Wildcard
 	}
 
-	if selectedSolver == nil || selectedChallenge == nil {
+	// 1. fetch the solvers from the issuer that can be used for this
+	//    authorization, most specific first
+	candidates := rankSolversForAuthorization(ctx, issuer, o, authz)
+	if len(candidates) == 0 {
 		return nil, fmt.Errorf("no configured challenge solvers can be used for this challenge")
 	}
 
+	// 2. skip the solvers that have previously been abandoned because they
+	//    did not pass their self check in time. If every solver has been
+	//    abandoned (e.g. because the issuer has since been changed), keep
+	//    using the least specific one.
+	attempt := len(authz.AbandonedSolvers)
+	if attempt >= len(candidates) {
+		attempt = len(candidates) - 1
+	}
+	if attempt > 0 {
+		dbg.Info("falling back to a less specific solver as previously selected solvers have been abandoned", "abandoned_solvers", len(authz.AbandonedSolvers))
+	}
+	selectedSolver := candidates[attempt].solver
+	selectedChallenge := candidates[attempt].challenge
+
 	// It should never be possible for this case to be hit as earlier in this
-	// method we already assert that the challenge type is one of 'http-01'
-	// or 'dns-01'.
+	// method we already assert that the challenge type is one of 'http-01',
+	// 'dns-01', 'dns-account-01' or 'tls-alpn-01'.
 	chType, err := challengeType(selectedChallenge.Type)
 	if err != nil {
 		return nil, err
 	}
 
-	// 4. handle overriding the HTTP01 ingress class and name fields using the
+	// 3. handle overriding the HTTP01 ingress class and name fields using the
 	//    ACMECertificateHTTP01IngressNameOverride & Class annotations
 	if err := applyIngressParameterAnnotationOverrides(o, selectedSolver); err != nil {
 		return nil, err
 	}
 
+	// 4. the name of the TXT record of dns-account-01 challenges is derived
+	//    from the URI of the ACME account that the Order was submitted with
+	var accountURI string
+	if chType == cmacme.ACMEChallengeTypeDNSAccount01 {
+		accountURI = acme.AccountURI(issuer, o.Status.Server)
+		if accountURI == "" {
+			return nil, fmt.Errorf("cannot solve dns-account-01 challenge as the URI of the ACME account is not known")
+		}
+	}
+
 	// 5. construct Challenge resource with spec.solver field set
 	return &cmacme.ChallengeSpec{
 		AuthorizationURL: authz.URL,
@@ -281,9 +211,11 @@ func partialChallengeSpecForAuthorization(ctx context.Context, issuer cmapi.Gene
 		DNSName:          authz.Identifier,
 		Token:            selectedChallenge.Token,
 		// selectedSolver cannot be nil due to the check above.
-		Solver:    *selectedSolver,
-		Wildcard:  wc,
-		IssuerRef: o.Spec.IssuerRef,
+		Solver:     *selectedSolver,
+		Wildcard:   wc,
+		IssuerRef:  o.Spec.IssuerRef,
+		Server:     o.Status.Server,
+		AccountURI: accountURI,
 	}, nil
 }
 
@@ -293,6 +225,10 @@ func challengeType(t string) (cmacme.ACMEChallengeType, error) {
 		return cmacme.ACMEChallengeTypeHTTP01, nil
 	case "dns-01":
 		return cmacme.ACMEChallengeTypeDNS01, nil
+	case "dns-account-01":
+		return cmacme.ACMEChallengeTypeDNSAccount01, nil
+	case "tls-alpn-01":
+		return cmacme.ACMEChallengeTypeTLSALPN01, nil
 	default:
 		return "", fmt.Errorf("unsupported challenge type: %v", t)
 	}
@@ -333,8 +269,15 @@ func ensureKeysForChallenges(cl acmecl.Interface, challenges []*cmacme.Challenge
 		switch ch.Spec.Type {
 		case cmacme.ACMEChallengeTypeHTTP01:
 			key, err = cl.HTTP01ChallengeResponse(ch.Spec.Token)
-		case cmacme.ACMEChallengeTypeDNS01:
+		case cmacme.ACMEChallengeTypeDNS01, cmacme.ACMEChallengeTypeDNSAccount01:
+			// dns-account-01 challenges use the same TXT record value as
+			// dns-01 challenges, only the name of the record differs.
 			key, err = cl.DNS01ChallengeRecord(ch.Spec.Token)
+		case cmacme.ACMEChallengeTypeTLSALPN01:
+			// the acmesolver computes the acmeIdentifier extension of the
+			// challenge certificate from the key authorization, which is
+			// the same as the HTTP01 challenge response.
+			key, err = cl.HTTP01ChallengeResponse(ch.Spec.Token)
 		default:
 			return nil, fmt.Errorf("challenge %s has unsupported challenge type: %s", ch.Name, ch.Spec.Type)
 		}
@@ -355,6 +298,17 @@ func anyChallengesFailed(chs []*cmacme.Challenge) bool {
 	return false
 }
 
+// firstErroredChallenge returns the first of the given Challenges that has
+// failed without being accepted by the ACME server, or nil if there is none.
+func firstErroredChallenge(chs []*cmacme.Challenge) *cmacme.Challenge {
+	for _, ch := range chs {
+		if ch.Status.State == cmacme.Errored && !ch.Status.Accepted {
+			return ch
+		}
+	}
+	return nil
+}
+
 func allChallengesFinal(chs []*cmacme.Challenge) bool {
 	for _, ch := range chs {
 		if !acme.IsFinalState(ch.Status.State) {
@@ -90,6 +90,43 @@ func TestChallengeSpecForAuthorization(t *testing.T) {
 		Type:  "dns-01",
 		Token: "dns-01-token",
 	}
+	acmeChallengeTLSALPN01 := &cmacme.ACMEChallenge{
+		Type:  "tls-alpn-01",
+		Token: "tls-alpn-01-token",
+	}
+	acmeChallengeDNSAccount01 := &cmacme.ACMEChallenge{
+		Type:  "dns-account-01",
+		Token: "dns-account-01-token",
+	}
+	dnsAccountChallengeSolverDNS01 := cmacme.ACMEChallengeSolver{
+		DNS01: &cmacme.ACMEChallengeSolverDNS01{
+			UseDNSAccountChallenge: true,
+			Cloudflare: &cmacme.ACMEIssuerDNS01ProviderCloudflare{
+				Email: "test-cloudflare-email",
+			},
+		},
+	}
+	dnsAccountChallengeIssuer := &cmapi.Issuer{
+		Spec: cmapi.IssuerSpec{
+			IssuerConfig: cmapi.IssuerConfig{
+				ACME: &cmacme.ACMEIssuer{
+					Server:  "https://acme.example.com/directory",
+					Solvers: []cmacme.ACMEChallengeSolver{dnsAccountChallengeSolverDNS01},
+					FallbackServers: []cmacme.ACMEFallbackServer{
+						{Server: "https://fallback.example.com/directory"},
+					},
+				},
+			},
+		},
+		Status: cmapi.IssuerStatus{
+			ACME: &cmacme.ACMEIssuerStatus{
+				URI: "https://acme.example.com/acct/1",
+				FallbackAccounts: []cmacme.ACMEFallbackAccountStatus{
+					{Server: "https://fallback.example.com/directory", URI: "https://fallback.example.com/acct/2"},
+				},
+			},
+		},
+	}
 
 	tests := map[string]struct {
 		acmeClient acmecl.Interface
@@ -100,6 +137,128 @@ func TestChallengeSpecForAuthorization(t *testing.T) {
 		expectedChallengeSpec *cmacme.ChallengeSpec
 		expectedError         bool
 	}{
+		"should select a tls-alpn-01 solver for a tls-alpn-01 challenge": {
+			acmeClient: basicACMEClient,
+			issuer: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: cmapi.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{
+								emptySelectorSolverDNS01,
+								{TLSALPN01: &cmacme.ACMEChallengeSolverTLSALPN01{}},
+							},
+						},
+					},
+				},
+			},
+			order: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"example.com"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "example.com",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeHTTP01, *acmeChallengeTLSALPN01},
+			},
+			expectedChallengeSpec: &cmacme.ChallengeSpec{
+				Type:    cmacme.ACMEChallengeTypeTLSALPN01,
+				DNSName: "example.com",
+				Token:   acmeChallengeTLSALPN01.Token,
+				Solver: cmacme.ACMEChallengeSolver{
+					TLSALPN01: &cmacme.ACMEChallengeSolverTLSALPN01{},
+				},
+			},
+		},
+		"should select a dns-01 challenge if the solver does not use dns-account-01 challenges": {
+			acmeClient: basicACMEClient,
+			issuer: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: cmapi.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{emptySelectorSolverDNS01},
+						},
+					},
+				},
+			},
+			order: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"example.com"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "example.com",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeDNSAccount01, *acmeChallengeDNS01},
+			},
+			expectedChallengeSpec: &cmacme.ChallengeSpec{
+				Type:    cmacme.ACMEChallengeTypeDNS01,
+				DNSName: "example.com",
+				Token:   acmeChallengeDNS01.Token,
+				Solver:  emptySelectorSolverDNS01,
+			},
+		},
+		"should select a dns-account-01 challenge if the solver uses dns-account-01 challenges": {
+			acmeClient: basicACMEClient,
+			issuer:     dnsAccountChallengeIssuer,
+			order: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"example.com"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "example.com",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeDNS01, *acmeChallengeDNSAccount01},
+			},
+			expectedChallengeSpec: &cmacme.ChallengeSpec{
+				Type:       cmacme.ACMEChallengeTypeDNSAccount01,
+				DNSName:    "example.com",
+				Token:      acmeChallengeDNSAccount01.Token,
+				Solver:     dnsAccountChallengeSolverDNS01,
+				AccountURI: "https://acme.example.com/acct/1",
+			},
+		},
+		"should use the account of the fallback server the order was submitted to for dns-account-01 challenges": {
+			acmeClient: basicACMEClient,
+			issuer:     dnsAccountChallengeIssuer,
+			order: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"example.com"},
+				},
+				Status: cmacme.OrderStatus{
+					Server: "https://fallback.example.com/directory",
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "example.com",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeDNSAccount01},
+			},
+			expectedChallengeSpec: &cmacme.ChallengeSpec{
+				Type:       cmacme.ACMEChallengeTypeDNSAccount01,
+				DNSName:    "example.com",
+				Token:      acmeChallengeDNSAccount01.Token,
+				Solver:     dnsAccountChallengeSolverDNS01,
+				Server:     "https://fallback.example.com/directory",
+				AccountURI: "https://fallback.example.com/acct/2",
+			},
+		},
+		"should fall back to a dns-01 challenge if the ACME server does not offer dns-account-01 challenges": {
+			acmeClient: basicACMEClient,
+			issuer:     dnsAccountChallengeIssuer,
+			order: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"example.com"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "example.com",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeHTTP01, *acmeChallengeDNS01},
+			},
+			expectedChallengeSpec: &cmacme.ChallengeSpec{
+				Type:    cmacme.ACMEChallengeTypeDNS01,
+				DNSName: "example.com",
+				Token:   acmeChallengeDNS01.Token,
+				Solver:  dnsAccountChallengeSolverDNS01,
+			},
+		},
 		"should override the ingress name to edit if override annotation is specified": {
 			acmeClient: basicACMEClient,
 			issuer: &cmapi.Issuer{
@@ -1234,6 +1393,73 @@ func TestChallengeSpecForAuthorization(t *testing.T) {
 				Solver:  exampleComDNSNameSelectorSolver,
 			},
 		},
+		"should fall back to the next matching solver if the most specific solver has been abandoned": {
+			acmeClient: basicACMEClient,
+			issuer: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: cmapi.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{
+								emptySelectorSolverDNS01,
+								exampleComDNSNameSelectorSolver,
+							},
+						},
+					},
+				},
+			},
+			order: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"example.com"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "example.com",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeHTTP01, *acmeChallengeDNS01},
+				AbandonedSolvers: []cmacme.ACMEAbandonedSolver{
+					{Type: cmacme.ACMEChallengeTypeHTTP01, DNSName: "example.com"},
+				},
+			},
+			expectedChallengeSpec: &cmacme.ChallengeSpec{
+				Type:    cmacme.ACMEChallengeTypeDNS01,
+				DNSName: "example.com",
+				Token:   acmeChallengeDNS01.Token,
+				Solver:  emptySelectorSolverDNS01,
+			},
+		},
+		"should keep using the least specific solver if all solvers have been abandoned": {
+			acmeClient: basicACMEClient,
+			issuer: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: cmapi.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{
+								emptySelectorSolverDNS01,
+								exampleComDNSNameSelectorSolver,
+							},
+						},
+					},
+				},
+			},
+			order: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"example.com"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "example.com",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeHTTP01, *acmeChallengeDNS01},
+				AbandonedSolvers: []cmacme.ACMEAbandonedSolver{
+					{Type: cmacme.ACMEChallengeTypeHTTP01, DNSName: "example.com"},
+					{Type: cmacme.ACMEChallengeTypeDNS01, DNSName: "example.com"},
+				},
+			},
+			expectedChallengeSpec: &cmacme.ChallengeSpec{
+				Type:    cmacme.ACMEChallengeTypeDNS01,
+				DNSName: "example.com",
+				Token:   acmeChallengeDNS01.Token,
+				Solver:  emptySelectorSolverDNS01,
+			},
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -1304,6 +1530,17 @@ func Test_ensureKeysForChallenges(t *testing.T) {
 				gen.ChallengeFrom(barChallenge, gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
 					gen.SetChallengeKey("barKeyDNS01"))},
 		},
+		"happy path with some dns-account-01 challenges": {
+			acmeClient: basicACMEClient,
+			partialChallenges: []*cmacme.Challenge{
+				gen.ChallengeFrom(fooChallenge, gen.SetChallengeType(cmacme.ACMEChallengeTypeDNSAccount01)),
+				gen.ChallengeFrom(barChallenge, gen.SetChallengeType(cmacme.ACMEChallengeTypeDNSAccount01))},
+			want: []*cmacme.Challenge{
+				gen.ChallengeFrom(fooChallenge, gen.SetChallengeType(cmacme.ACMEChallengeTypeDNSAccount01),
+					gen.SetChallengeKey("fooKeyDNS01")),
+				gen.ChallengeFrom(barChallenge, gen.SetChallengeType(cmacme.ACMEChallengeTypeDNSAccount01),
+					gen.SetChallengeKey("barKeyDNS01"))},
+		},
 		"unhappy path with an unknown challenge type": {
 			acmeClient:        basicACMEClient,
 			partialChallenges: []*cmacme.Challenge{gen.ChallengeFrom(fooChallenge, gen.SetChallengeType(cmacme.ACMEChallengeType("foo")))},
@@ -21,6 +21,7 @@ import (
 	"crypto/x509"
 	"fmt"
 	"slices"
+	"time"
 
 	"github.com/go-logr/logr"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
@@ -142,7 +143,7 @@ func (a *ACME) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuer cm
 	}
 
 	// If we fail to build the order we have to hard fail.
-	expectedOrder, err := buildOrder(cr, csr, issuer.GetSpec().ACME.EnableDurationFeature)
+	expectedOrder, err := buildOrder(cr, csr, issuer.GetSpec().ACME.EnableDurationFeature, issuer.GetSpec().ACME.Profile)
 	if err != nil {
 		message := "Failed to build order"
 
@@ -202,6 +203,18 @@ func (a *ACME) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuer cm
 		return nil, nil
 	}
 
+	// If the ACME server rate limited the order, report this separately so that
+	// it can be told apart from other reasons for the order not completing.
+	if order.Status.RateLimitedUntil != nil {
+		a.reporter.RateLimited(cr, "OrderRateLimited",
+			fmt.Sprintf("Order %s/%s is rate limited by the ACME server and will be retried after %s: %s",
+				expectedOrder.Namespace, order.Name, order.Status.RateLimitedUntil.UTC().Format(time.RFC3339), order.Status.Reason))
+
+		log.V(logf.DebugLevel).Info("acme Order resource is rate limited, waiting...")
+
+		return nil, nil
+	}
+
 	if order.Status.State != cmacme.Valid {
 		// We update here to just pending while we wait for the order to be resolved.
 		a.reporter.Pending(cr, nil, "OrderPending",
@@ -242,7 +255,9 @@ func (a *ACME) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuer cm
 }
 
 // Build order. If we error here it is a terminating failure.
-func buildOrder(cr *cmapi.CertificateRequest, csr *x509.CertificateRequest, enableDurationFeature bool) (*cmacme.Order, error) {
+// The ACME profile requested by the CertificateRequest takes precedence over
+// the issuer's profile.
+func buildOrder(cr *cmapi.CertificateRequest, csr *x509.CertificateRequest, enableDurationFeature bool, issuerProfile string) (*cmacme.Order, error) {
 	var ipAddresses []string
 	for _, ip := range csr.IPAddresses {
 		ipAddresses = append(ipAddresses, ip.String())
@@ -265,6 +280,11 @@ func buildOrder(cr *cmapi.CertificateRequest, csr *x509.CertificateRequest, enab
 		spec.Duration = cr.Spec.Duration
 	}
 
+	spec.Profile = issuerProfile
+	if profile := cr.Annotations[cmacme.ACMEProfileAnnotationKey]; profile != "" {
+		spec.Profile = profile
+	}
+
 	computeNameSpec := spec.DeepCopy()
 	// create a deep copy of the OrderSpec so we can overwrite the Request and NotAfter field
 	computeNameSpec.Request = nil
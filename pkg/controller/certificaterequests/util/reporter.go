@@ -103,6 +103,19 @@ func (r *Reporter) Pending(cr *cmapi.CertificateRequest, err error, reason, mess
 		cmmeta.ConditionFalse, cmapi.CertificateRequestReasonPending, message)
 }
 
+// RateLimited marks a CertificateRequest as still in progress, but rate
+// limited by the certificate authority, and sends a corresponding event.
+//
+// The event is only sent if the CertificateRequest is not already rate limited.
+func (r *Reporter) RateLimited(cr *cmapi.CertificateRequest, reason, message string) {
+	if apiutil.CertificateRequestReadyReason(cr) != cmapi.CertificateRequestReasonRateLimited {
+		r.recorder.Event(cr, corev1.EventTypeWarning, reason, message)
+	}
+
+	apiutil.SetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionReady,
+		cmmeta.ConditionFalse, cmapi.CertificateRequestReasonRateLimited, message)
+}
+
 // Ready marks a CertificateRequest as Ready and sends a corresponding event.
 func (r *Reporter) Ready(cr *cmapi.CertificateRequest) {
 	r.recorder.Event(cr, corev1.EventTypeNormal, "CertificateIssued", readyMessage)
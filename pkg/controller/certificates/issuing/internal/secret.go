@@ -20,6 +20,7 @@ import (
 	"context"
 	"crypto/x509"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -27,6 +28,7 @@ import (
 	applycorev1 "k8s.io/client-go/applyconfigurations/core/v1"
 	applymetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/utils/clock"
 
 	"github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
@@ -46,6 +48,7 @@ var (
 type SecretsManager struct {
 	secretClient coreclient.SecretsGetter
 	secretLister internalinformers.SecretLister
+	clock        clock.Clock
 
 	// fieldManager is the manager name used for the Apply operations on Secrets.
 	fieldManager string
@@ -62,6 +65,9 @@ type SecretData struct {
 	PrivateKey, Certificate, CA         []byte
 	CertificateName                     string
 	IssuerName, IssuerKind, IssuerGroup string
+	// PrivateKeyCreationTime is the time at which the private key was
+	// created. It is not recorded on the Secret if nil.
+	PrivateKeyCreationTime *metav1.Time
 }
 
 // NewSecretsManager returns a new SecretsManager. Setting
@@ -70,12 +76,14 @@ type SecretData struct {
 func NewSecretsManager(
 	secretClient coreclient.SecretsGetter,
 	secretLister internalinformers.SecretLister,
+	clock clock.Clock,
 	fieldManager string,
 	enableSecretOwnerReferences bool,
 ) *SecretsManager {
 	return &SecretsManager{
 		secretClient:                secretClient,
 		secretLister:                secretLister,
+		clock:                       clock,
 		fieldManager:                fieldManager,
 		enableSecretOwnerReferences: enableSecretOwnerReferences,
 	}
@@ -199,9 +207,44 @@ func (s *SecretsManager) setValues(crt *cmapi.Certificate, secret *corev1.Secret
 		secret.Annotations[cmapi.IssuerKindAnnotationKey] = data.IssuerKind
 		secret.Annotations[cmapi.IssuerGroupAnnotationKey] = data.IssuerGroup
 	}
+	if data.PrivateKeyCreationTime != nil {
+		secret.Annotations[cmapi.PrivateKeyCreationTimeAnnotationKey] = data.PrivateKeyCreationTime.UTC().Format(time.RFC3339)
+	}
 
 	secret.Labels[cmapi.PartOfCertManagerControllerLabelKey] = "true"
 
+	if err := s.setPreviousCertificate(crt, secret, data); err != nil {
+		return fmt.Errorf("failed to add previous certificate to Secret: %w", err)
+	}
+
+	return nil
+}
+
+// setPreviousCertificate will keep the previously issued certificate and
+// private key in the Secret if the Certificate retains them, together with
+// an annotation recording until when they are kept.
+func (s *SecretsManager) setPreviousCertificate(crt *cmapi.Certificate, secret *corev1.Secret, data SecretData) error {
+	if crt.Spec.RetainPrevious == nil {
+		return nil
+	}
+
+	existingSecret, err := s.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	previous := certificates.PreviousCertificateForSecret(crt, existingSecret, data.Certificate, s.clock.Now())
+	if previous == nil {
+		return nil
+	}
+
+	secret.Data[cmapi.PreviousTLSCertKey] = previous.Certificate
+	secret.Data[cmapi.PreviousTLSPrivateKeyKey] = previous.PrivateKey
+	secret.Annotations[cmapi.PreviousCertificateRetainedUntilAnnotationKey] = previous.RetainedUntil.UTC().Format(time.RFC3339)
+
 	return nil
 }
 
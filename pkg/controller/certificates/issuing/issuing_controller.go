@@ -46,6 +46,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/issuing/internal"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/scheduler"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	utilkube "github.com/cert-manager/cert-manager/pkg/util/kube"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
@@ -69,6 +70,10 @@ type controller struct {
 	recorder                 record.EventRecorder
 	clock                    clock.Clock
 
+	// scheduledWorkQueue is used to re-check Certificates once the previous
+	// certificate and private key kept in their Secret should be removed.
+	scheduledWorkQueue scheduler.ScheduledWorkQueue[types.NamespacedName]
+
 	client cmclient.Interface
 
 	// secretsUpdateData is used by the SecretTemplate controller for
@@ -87,6 +92,10 @@ type controller struct {
 
 	// localTemporarySigner signs a certificate that is stored temporarily
 	localTemporarySigner localTemporarySignerFn
+
+	// historyLimit is the maximum number of issuances recorded in the
+	// status.history of each Certificate.
+	historyLimit int
 }
 
 func NewController(
@@ -140,7 +149,7 @@ func NewController(
 	}
 
 	secretsManager := internal.NewSecretsManager(
-		ctx.Client.CoreV1(), secretsInformer.Lister(),
+		ctx.Client.CoreV1(), secretsInformer.Lister(), ctx.Clock,
 		ctx.FieldManager, ctx.CertificateOptions.EnableOwnerRef,
 	)
 
@@ -151,13 +160,16 @@ func NewController(
 		client:                   ctx.CMClient,
 		recorder:                 ctx.Recorder,
 		clock:                    ctx.Clock,
+		scheduledWorkQueue:       scheduler.NewScheduledWorkQueue(ctx.Clock, queue.Add),
 		secretsUpdateData:        secretsManager.UpdateData,
 		postIssuancePolicyChain: policies.NewSecretPostIssuancePolicyChain(
+			ctx.Clock,
 			ctx.CertificateOptions.EnableOwnerRef,
 			ctx.FieldManager,
 		),
 		fieldManager:         ctx.FieldManager,
 		localTemporarySigner: pki.GenerateLocallySignedTemporaryCertificate,
+		historyLimit:         ctx.CertificateOptions.HistoryLimit,
 	}, queue, mustSync, nil
 }
 
@@ -254,6 +266,10 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	if err != nil {
 		return err
 	}
+	// The issuer is not looked up here, so only an ACME profile set on the
+	// Certificate itself is compared. Changes to the issuer's profile are
+	// handled by the trigger controller.
+	requestViolations = append(requestViolations, internalcertificates.ACMEProfileViolations(req, crt.Spec, nil)...)
 	if len(requestViolations) > 0 {
 		log.V(logf.DebugLevel).Info("CertificateRequest does not match Certificate, waiting for keymanager controller")
 		return nil
@@ -346,11 +362,42 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 		return err
 	}
 
+	// Surface that the issuer is being rate limited by the certificate
+	// authority, so that it can be told apart from other reasons for the
+	// issuance not completing.
+	if crReadyCond.Reason == cmapi.CertificateRequestReasonRateLimited {
+		return c.rateLimitedIssuance(ctx, log, crt, crReadyCond)
+	}
+
 	// CertificateRequest is not in a final state so do nothing.
 	log.V(logf.DebugLevel).Info("CertificateRequest not in final state, waiting...", "reason", crReadyCond.Reason)
 	return nil
 }
 
+// rateLimitedIssuance will set the reason and message of the Issuing condition
+// of this Certificate to that of the rate limited CertificateRequest
+// condition passed, and log an appropriate event. The Issuing condition
+// remains True as the issuance will continue once the rate limit is reset.
+func (c *controller) rateLimitedIssuance(ctx context.Context, log logr.Logger, crt *cmapi.Certificate, condition *cmapi.CertificateRequestCondition) error {
+	certIssuingCond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing)
+	if certIssuingCond.Reason == condition.Reason && certIssuingCond.Message == condition.Message {
+		return nil
+	}
+
+	log.V(logf.DebugLevel).Info("CertificateRequest is rate limited, waiting...")
+
+	crt = crt.DeepCopy()
+	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionIssuing, cmmeta.ConditionTrue, condition.Reason, condition.Message)
+
+	if err := c.updateOrApplyStatus(ctx, crt, false, false); err != nil {
+		return err
+	}
+
+	c.recorder.Event(crt, corev1.EventTypeWarning, condition.Reason, condition.Message)
+
+	return nil
+}
+
 // failIssueCertificate will mark the Issuing condition of this Certificate as
 // false, set the Certificate's last failure time and issuance attempts, and log
 // an appropriate event. The reason and message of the Issuing condition will be that of
@@ -375,7 +422,7 @@ func (c *controller) failIssueCertificate(ctx context.Context, log logr.Logger,
 	crt = crt.DeepCopy()
 	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionIssuing, cmmeta.ConditionFalse, reason, message)
 
-	if err := c.updateOrApplyStatus(ctx, crt, false); err != nil {
+	if err := c.updateOrApplyStatus(ctx, crt, false, false); err != nil {
 		return err
 	}
 
@@ -407,13 +454,47 @@ func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt
 		IssuerGroup:     req.Spec.IssuerRef.Group,
 	}
 
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	// Record the certificate that is about to be superseded if the
+	// Certificate's revocation policy asks for it to be revoked.
+	var supersededRevocation *cmapi.CertificateRevocation
+	if utilfeature.DefaultFeatureGate.Enabled(feature.CertificateRevocation) {
+		supersededRevocation = internalcertificates.SupersededRevocation(crt, secret, pk)
+	}
+
+	// Keep track of the age of the private key, so that it can be rotated
+	// once it is older than spec.privateKey.rotationMaxAge. It is stored on
+	// the Secret together with the private key, so that it is not lost if
+	// the Certificate's status fails to be updated.
+	privateKeyCreationTime := internalcertificates.PrivateKeyCreationTime(crt, secret, pk, c.clock.Now())
+	secretData.PrivateKeyCreationTime = privateKeyCreationTime
+
+	// Record the certificate in the Certificate's issuance history, which
+	// outlives the CertificateRequest it was issued for.
+	issuance, err := internalcertificates.IssuanceForCertificateRequest(req, nextRevision, c.clock.Now())
+	if err != nil {
+		return err
+	}
+
 	if err := c.secretsUpdateData(ctx, crt, secretData); err != nil {
 		return err
 	}
 
+	if supersededRevocation != nil {
+		crt.Status.Revocations = append(crt.Status.Revocations, *supersededRevocation)
+	}
+
 	// Set status.revision to revision of the CertificateRequest
 	crt.Status.Revision = &nextRevision
 
+	crt.Status.PrivateKeyCreationTime = privateKeyCreationTime
+
+	crt.Status.History = internalcertificates.AppendIssuance(crt.Status.History, *issuance, c.historyLimit)
+
 	// Remove Issuing status condition
 	// TODO @joshvanl: Once we move to only server-side apply API calls, this
 	// should be changed to setting the Issuing condition to False.
@@ -425,7 +506,7 @@ func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt
 	// Clear status.lastFailureTime (if set)
 	crt.Status.LastFailureTime = nil
 
-	if err := c.updateOrApplyStatus(ctx, crt, true); err != nil {
+	if err := c.updateOrApplyStatus(ctx, crt, true, supersededRevocation != nil); err != nil {
 		return err
 	}
 
@@ -443,7 +524,10 @@ func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt
 // this controller. If the ServerSideApply feature is enabled and condition
 // have been removed, the Issuing condition will be set to False before
 // applying.
-func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certificate, conditionRemoved bool) error {
+// revocationsChanged should be true if a revocation has been added to the
+// Certificate's status by this controller, in which case the revocations are
+// included when applying.
+func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certificate, conditionRemoved, revocationsChanged bool) error {
 	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
 		// TODO @joshvanl: Once we move to only server-side apply API calls,
 		// `conditionRemoved` can be removed and setting the Issuing condition to
@@ -458,14 +542,22 @@ func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certifi
 			conditions = []cmapi.CertificateCondition{*cond}
 		}
 
-		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
+		status := cmapi.CertificateStatus{
+			Revision:               crt.Status.Revision,
+			LastFailureTime:        crt.Status.LastFailureTime,
+			PrivateKeyCreationTime: crt.Status.PrivateKeyCreationTime,
+			History:                crt.Status.History,
+			Conditions:             conditions,
+		}
+		if revocationsChanged {
+			status.Revocations = crt.Status.Revocations
+		}
+
+		_, err := internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
 			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
-			Status: cmapi.CertificateStatus{
-				Revision:        crt.Status.Revision,
-				LastFailureTime: crt.Status.LastFailureTime,
-				Conditions:      conditions,
-			},
+			Status:     status,
 		})
+		return err
 	} else {
 		_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
 		return err
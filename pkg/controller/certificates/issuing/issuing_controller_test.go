@@ -18,6 +18,7 @@ package issuing
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"testing"
 	"time"
@@ -76,6 +77,19 @@ func TestIssuingController(t *testing.T) {
 
 	exampleBundleAlt := testcrypto.MustCreateCryptoBundle(t, baseCert.DeepCopy(), fixedClock)
 	metaFixedClockStart := metav1.NewTime(fixedClockStart)
+	metaPrivateKeyCreationTime := metav1.NewTime(fixedClockStart.Truncate(time.Second))
+	exampleIssuance := cmapi.CertificateIssuance{
+		Revision:           2,
+		CertificateRequest: exampleBundle.CertificateRequestReady.Name,
+		IssuanceTime:       metaPrivateKeyCreationTime,
+		SerialNumber:       exampleBundle.Cert.SerialNumber.Text(16),
+		SHA256Fingerprint:  fmt.Sprintf("%x", sha256.Sum256(exampleBundle.Cert.Raw)),
+		Issuer:             exampleBundle.Cert.Issuer.String(),
+		KeyAlgorithm:       cmapi.RSAKeyAlgorithm,
+	}
+
+	exampleRecoveredIssuance := exampleIssuance
+	exampleRecoveredIssuance.Revision = 1
 
 	issuingCert := gen.CertificateFrom(baseCert.DeepCopy(),
 		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
@@ -99,6 +113,57 @@ func TestIssuingController(t *testing.T) {
 			expectedErr: false,
 		},
 
+		"if certificate is not in Issuing state and the certificate in its Secret is missing from its history, record it": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					baseCert.DeepCopy(),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "1",
+						}),
+					),
+				},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "output",
+							Namespace: exampleBundle.Certificate.Namespace,
+							Annotations: map[string]string{
+								cmapi.CertificateNameKey:       "test",
+								cmapi.IssuerNameAnnotationKey:  "ca-issuer",
+								cmapi.IssuerKindAnnotationKey:  "Issuer",
+								cmapi.IssuerGroupAnnotationKey: "foo.io",
+							},
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       exampleBundle.CertificateRequestReady.Status.Certificate,
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(baseCert,
+							gen.SetCertificateHistory(exampleRecoveredIssuance),
+						),
+					)),
+				},
+			},
+			expSecretUpdateDataCall: &internal.SecretData{
+				Certificate:     exampleBundle.CertificateRequestReady.Status.Certificate,
+				PrivateKey:      exampleBundle.PrivateKeyBytes,
+				CertificateName: "test",
+				IssuerName:      "ca-issuer",
+				IssuerKind:      "Issuer",
+				IssuerGroup:     "foo.io",
+			},
+			expectedErr: false,
+		},
+
 		"if certificate is an Issuing state but is set to False, then do nothing": {
 			certificate: exampleBundle.Certificate,
 			builder: &testpkg.Builder{
@@ -499,6 +564,8 @@ func TestIssuingController(t *testing.T) {
 						exampleBundle.Certificate.Namespace,
 						gen.CertificateFrom(exampleBundle.Certificate,
 							gen.SetCertificateRevision(2),
+							gen.SetCertificatePrivateKeyCreationTime(metaPrivateKeyCreationTime),
+							gen.SetCertificateHistory(exampleIssuance),
 						),
 					)),
 				},
@@ -507,13 +574,14 @@ func TestIssuingController(t *testing.T) {
 				},
 			},
 			expSecretUpdateDataCall: &internal.SecretData{
-				Certificate:     exampleBundle.CertificateRequestReady.Status.Certificate,
-				PrivateKey:      exampleBundle.PrivateKeyBytes,
-				CA:              nil,
-				CertificateName: "test",
-				IssuerName:      "ca-issuer",
-				IssuerKind:      "Issuer",
-				IssuerGroup:     "foo.io",
+				Certificate:            exampleBundle.CertificateRequestReady.Status.Certificate,
+				PrivateKey:             exampleBundle.PrivateKeyBytes,
+				CA:                     nil,
+				CertificateName:        "test",
+				IssuerName:             "ca-issuer",
+				IssuerKind:             "Issuer",
+				IssuerGroup:            "foo.io",
+				PrivateKeyCreationTime: &metaPrivateKeyCreationTime,
 			},
 			expectedErr: false,
 		},
@@ -557,6 +625,8 @@ func TestIssuingController(t *testing.T) {
 						exampleBundle.Certificate.Namespace,
 						gen.CertificateFrom(exampleBundle.Certificate,
 							gen.SetCertificateRevision(2),
+							gen.SetCertificatePrivateKeyCreationTime(metaPrivateKeyCreationTime),
+							gen.SetCertificateHistory(exampleIssuance),
 						),
 					)),
 				},
@@ -565,13 +635,14 @@ func TestIssuingController(t *testing.T) {
 				},
 			},
 			expSecretUpdateDataCall: &internal.SecretData{
-				Certificate:     exampleBundle.CertificateRequestReady.Status.Certificate,
-				PrivateKey:      exampleBundle.PrivateKeyBytes,
-				CA:              nil,
-				CertificateName: "test",
-				IssuerName:      "ca-issuer",
-				IssuerKind:      "Issuer",
-				IssuerGroup:     "foo.io",
+				Certificate:            exampleBundle.CertificateRequestReady.Status.Certificate,
+				PrivateKey:             exampleBundle.PrivateKeyBytes,
+				CA:                     nil,
+				CertificateName:        "test",
+				IssuerName:             "ca-issuer",
+				IssuerKind:             "Issuer",
+				IssuerGroup:            "foo.io",
+				PrivateKeyCreationTime: &metaPrivateKeyCreationTime,
 			},
 			expectedErr: false,
 		},
@@ -614,6 +685,8 @@ func TestIssuingController(t *testing.T) {
 						exampleBundle.Certificate.Namespace,
 						gen.CertificateFrom(exampleBundle.Certificate,
 							gen.SetCertificateRevision(2),
+							gen.SetCertificatePrivateKeyCreationTime(metaPrivateKeyCreationTime),
+							gen.SetCertificateHistory(exampleIssuance),
 						),
 					)),
 				},
@@ -622,13 +695,14 @@ func TestIssuingController(t *testing.T) {
 				},
 			},
 			expSecretUpdateDataCall: &internal.SecretData{
-				Certificate:     exampleBundle.CertificateRequestReady.Status.Certificate,
-				PrivateKey:      exampleBundle.PrivateKeyBytes,
-				CA:              nil,
-				CertificateName: "test",
-				IssuerName:      "ca-issuer",
-				IssuerKind:      "Issuer",
-				IssuerGroup:     "foo.io",
+				Certificate:            exampleBundle.CertificateRequestReady.Status.Certificate,
+				PrivateKey:             exampleBundle.PrivateKeyBytes,
+				CA:                     nil,
+				CertificateName:        "test",
+				IssuerName:             "ca-issuer",
+				IssuerKind:             "Issuer",
+				IssuerGroup:            "foo.io",
+				PrivateKeyCreationTime: &metaPrivateKeyCreationTime,
 			},
 			expectedErr: false,
 		},
@@ -672,6 +746,8 @@ func TestIssuingController(t *testing.T) {
 						exampleBundle.Certificate.Namespace,
 						gen.CertificateFrom(exampleBundle.Certificate,
 							gen.SetCertificateRevision(2),
+							gen.SetCertificatePrivateKeyCreationTime(metaPrivateKeyCreationTime),
+							gen.SetCertificateHistory(exampleIssuance),
 						),
 					)),
 				},
@@ -680,13 +756,14 @@ func TestIssuingController(t *testing.T) {
 				},
 			},
 			expSecretUpdateDataCall: &internal.SecretData{
-				Certificate:     exampleBundle.CertificateRequestReady.Status.Certificate,
-				PrivateKey:      exampleBundle.PrivateKeyBytes,
-				CA:              nil,
-				CertificateName: "test",
-				IssuerName:      "ca-issuer",
-				IssuerKind:      "Issuer",
-				IssuerGroup:     "foo.io",
+				Certificate:            exampleBundle.CertificateRequestReady.Status.Certificate,
+				PrivateKey:             exampleBundle.PrivateKeyBytes,
+				CA:                     nil,
+				CertificateName:        "test",
+				IssuerName:             "ca-issuer",
+				IssuerKind:             "Issuer",
+				IssuerGroup:            "foo.io",
+				PrivateKeyCreationTime: &metaPrivateKeyCreationTime,
 			},
 			expectedErr: false,
 		},
@@ -721,10 +798,11 @@ func TestIssuingController(t *testing.T) {
 				},
 			},
 			expSecretUpdateDataCall: &internal.SecretData{
-				Certificate:     exampleBundle.LocalTemporaryCertificateBytes,
-				PrivateKey:      exampleBundle.PrivateKeyBytes,
-				CA:              nil,
-				CertificateName: "test",
+				Certificate:            exampleBundle.LocalTemporaryCertificateBytes,
+				PrivateKey:             exampleBundle.PrivateKeyBytes,
+				CA:                     nil,
+				CertificateName:        "test",
+				PrivateKeyCreationTime: &metaPrivateKeyCreationTime,
 			},
 			expectedErr: false,
 		},
@@ -770,10 +848,11 @@ func TestIssuingController(t *testing.T) {
 				},
 			},
 			expSecretUpdateDataCall: &internal.SecretData{
-				Certificate:     exampleBundle.LocalTemporaryCertificateBytes,
-				PrivateKey:      exampleBundle.PrivateKeyBytes,
-				CA:              nil,
-				CertificateName: "test",
+				Certificate:            exampleBundle.LocalTemporaryCertificateBytes,
+				PrivateKey:             exampleBundle.PrivateKeyBytes,
+				CA:                     nil,
+				CertificateName:        "test",
+				PrivateKeyCreationTime: &metaPrivateKeyCreationTime,
 			},
 			expectedErr: false,
 		},
@@ -868,10 +947,11 @@ func TestIssuingController(t *testing.T) {
 				},
 			},
 			expSecretUpdateDataCall: &internal.SecretData{
-				Certificate:     exampleBundle.LocalTemporaryCertificateBytes,
-				PrivateKey:      exampleBundle.PrivateKeyBytes,
-				CA:              nil,
-				CertificateName: "test",
+				Certificate:            exampleBundle.LocalTemporaryCertificateBytes,
+				PrivateKey:             exampleBundle.PrivateKeyBytes,
+				CA:                     nil,
+				CertificateName:        "test",
+				PrivateKeyCreationTime: &metaPrivateKeyCreationTime,
 			},
 			expectedErr: false,
 		},
@@ -956,6 +1036,8 @@ func TestIssuingController(t *testing.T) {
 								cmapi.IssueTemporaryCertificateAnnotation: "true",
 							}),
 							gen.SetCertificateRevision(2),
+							gen.SetCertificatePrivateKeyCreationTime(metaPrivateKeyCreationTime),
+							gen.SetCertificateHistory(exampleIssuance),
 						),
 					)),
 				},
@@ -964,13 +1046,14 @@ func TestIssuingController(t *testing.T) {
 				},
 			},
 			expSecretUpdateDataCall: &internal.SecretData{
-				Certificate:     exampleBundle.CertificateRequestReady.Status.Certificate,
-				PrivateKey:      exampleBundle.PrivateKeyBytes,
-				CA:              nil,
-				CertificateName: "test",
-				IssuerName:      "ca-issuer",
-				IssuerKind:      "Issuer",
-				IssuerGroup:     "foo.io",
+				Certificate:            exampleBundle.CertificateRequestReady.Status.Certificate,
+				PrivateKey:             exampleBundle.PrivateKeyBytes,
+				CA:                     nil,
+				CertificateName:        "test",
+				IssuerName:             "ca-issuer",
+				IssuerKind:             "Issuer",
+				IssuerGroup:            "foo.io",
+				PrivateKeyCreationTime: &metaPrivateKeyCreationTime,
 			},
 			expectedErr: false,
 		},
@@ -1383,6 +1466,7 @@ func TestIssuingController(t *testing.T) {
 			_, _, err := w.Register(test.builder.Context)
 			require.NoError(t, err)
 			w.controller.localTemporarySigner = testLocalTemporarySignerFn(exampleBundle.LocalTemporaryCertificateBytes)
+			w.controller.historyLimit = 10
 
 			var secretsUpdateDataCalled bool
 			w.controller.secretsUpdateData = func(_ context.Context, _ *cmapi.Certificate, secretData internal.SecretData) error {
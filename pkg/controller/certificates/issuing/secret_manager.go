@@ -17,18 +17,26 @@ limitations under the License.
 package issuing
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"strconv"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/controller/certificates/policies"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/issuing/internal"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/pkg/util/predicate"
 )
 
 // ensureSecretData ensures that the Certificate's Secret is up to date with
@@ -65,6 +73,13 @@ func (c *controller) ensureSecretData(ctx context.Context, log logr.Logger, crt
 		return nil
 	}
 
+	// Record the Secret's certificate in the Certificate's issuance history if
+	// it is missing, e.g. because the status update that should have recorded
+	// it failed after the Secret was written.
+	if err := c.ensureIssuanceRecorded(ctx, log, crt, secret); err != nil {
+		return err
+	}
+
 	data := internal.SecretData{
 		PrivateKey:      secret.Data[corev1.TLSPrivateKeyKey],
 		Certificate:     secret.Data[corev1.TLSCertKey],
@@ -73,6 +88,9 @@ func (c *controller) ensureSecretData(ctx context.Context, log logr.Logger, crt
 		IssuerName:      secret.Annotations[cmapi.IssuerNameAnnotationKey],
 		IssuerKind:      secret.Annotations[cmapi.IssuerKindAnnotationKey],
 		IssuerGroup:     secret.Annotations[cmapi.IssuerGroupAnnotationKey],
+		// Keep the recorded creation time of the private key, which cannot
+		// be determined from the Secret's data.
+		PrivateKeyCreationTime: internalcertificates.CurrentPrivateKeyCreationTime(crt, secret),
 	}
 
 	// Check whether the Certificate's Secret has correct output format and
@@ -100,7 +118,66 @@ func (c *controller) ensureSecretData(ctx context.Context, log logr.Logger, crt
 		}
 	}
 
-	// No Secret violations, nothing to do.
+	// No Secret violations. If the Secret keeps a previous certificate and
+	// private key, re-check the Certificate once they should be removed.
+	if retainedUntil, ok := internalcertificates.PreviousCertificateRetainedUntil(secret); ok {
+		key := types.NamespacedName{Namespace: crt.Namespace, Name: crt.Name}
+		c.scheduledWorkQueue.Add(key, retainedUntil.Sub(c.clock.Now()))
+	}
+
+	return nil
+}
+
+// ensureIssuanceRecorded records the certificate stored in the Certificate's
+// Secret in its status.history if it is not recorded yet. The issuance is
+// recorded using the CertificateRequest owned by the Certificate that the
+// certificate was issued for. If that CertificateRequest no longer exists, the
+// issuance cannot be recorded and the history is left unchanged.
+func (c *controller) ensureIssuanceRecorded(ctx context.Context, log logr.Logger, crt *cmapi.Certificate, secret *corev1.Secret) error {
+	if c.historyLimit <= 0 {
+		return nil
+	}
+
+	cert, err := pki.DecodeX509CertificateBytes(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		// Invalid certificate data is handled by the post issuance policy
+		// checks.
+		return nil
+	}
+	if internalcertificates.HistoryContainsCertificate(crt.Status.History, cert) {
+		return nil
+	}
+
+	reqs, err := certificates.ListCertificateRequestsMatchingPredicates(c.certificateRequestLister.CertificateRequests(crt.Namespace),
+		labels.Everything(),
+		predicate.ResourceOwnedBy(crt),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, req := range reqs {
+		if !bytes.Equal(req.Status.Certificate, secret.Data[corev1.TLSCertKey]) {
+			continue
+		}
+
+		revision, err := strconv.Atoi(req.Annotations[cmapi.CertificateRequestRevisionAnnotationKey])
+		if err != nil {
+			log.V(logf.DebugLevel).Info("cannot record issuance of CertificateRequest with invalid revision annotation",
+				"certificaterequest", req.Name, "error", err.Error())
+			return nil
+		}
+
+		issuance, err := internalcertificates.IssuanceForCertificateRequest(req, revision, c.clock.Now())
+		if err != nil {
+			return err
+		}
+
+		log.V(logf.InfoLevel).Info("recording missing issuance in the Certificate's history", "certificaterequest", req.Name)
+		crt = crt.DeepCopy()
+		crt.Status.History = internalcertificates.AppendIssuance(crt.Status.History, *issuance, c.historyLimit)
+		return c.updateOrApplyStatus(ctx, crt, false, false)
+	}
 
 	return nil
 }
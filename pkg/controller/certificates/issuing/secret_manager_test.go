@@ -1156,7 +1156,7 @@ func Test_ensureSecretData(t *testing.T) {
 				actionCalled = true
 				return nil
 			}
-			w.postIssuancePolicyChain = policies.NewSecretPostIssuancePolicyChain(test.enableOwnerRef, fieldManager)
+			w.postIssuancePolicyChain = policies.NewSecretPostIssuancePolicyChain(builder.Context.Clock, test.enableOwnerRef, fieldManager)
 
 			// Start the informers and begin processing updates.
 			builder.Start()
@@ -32,6 +32,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 
 	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
@@ -54,6 +55,7 @@ const (
 	reasonDecodeFailed        = "DecodeFailed"
 	reasonCannotRegenerateKey = "CannotRegenerateKey"
 	reasonDeleted             = "Deleted"
+	reasonRotating            = "Rotating"
 )
 
 var (
@@ -66,6 +68,7 @@ type controller struct {
 	client            cmclient.Interface
 	coreClient        kubernetes.Interface
 	recorder          record.EventRecorder
+	clock             clock.Clock
 
 	// fieldManager is the string which will be used as the Field Manager on
 	// fields created or edited by the cert-manager Kubernetes client during
@@ -122,6 +125,7 @@ func NewController(
 		client:            ctx.CMClient,
 		coreClient:        ctx.Client,
 		recorder:          ctx.Recorder,
+		clock:             ctx.Clock,
 		fieldManager:      ctx.FieldManager,
 	}, queue, mustSync, nil
 }
@@ -254,6 +258,11 @@ func (c *controller) createNextPrivateKeyRotationPolicyNever(ctx context.Context
 		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonCannotRegenerateKey, "User intervention required: existing private key in Secret %q does not match requirements on Certificate resource, mismatching fields: %v, but cert-manager cannot create new private key as the Certificate's .spec.privateKey.rotationPolicy is unset or set to Never. To allow cert-manager to create a new private key you can set .spec.privateKey.rotationPolicy to 'Always' (this will result in the private key being regenerated every time a cert is renewed) ", crt.Spec.SecretName, violations)
 		return nil
 	}
+	if rotationTime := internalcertificates.PrivateKeyRotationTime(crt, s); rotationTime != nil && !c.clock.Now().Before(rotationTime.Time) {
+		log.V(logf.DebugLevel).Info("Creating new nextPrivateKeySecretName Secret because the existing private key is older than the rotation max age", "rotation_time", rotationTime)
+		c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonRotating, "Generating a new private key as the private key stored in Secret %q is older than .spec.privateKey.rotationMaxAge", crt.Spec.SecretName)
+		return c.createAndSetNextPrivateKey(ctx, crt)
+	}
 
 	nextPkSecret, err := c.createNewPrivateKeySecret(ctx, crt, pk)
 	if err != nil {
@@ -313,10 +322,11 @@ func (c *controller) setNextPrivateKeySecretName(ctx context.Context, crt *cmapi
 // applied using the relevant Patch API call.
 func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certificate) error {
 	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
-		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
+		_, err := internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
 			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
 			Status:     cmapi.CertificateStatus{NextPrivateKeySecretName: crt.Status.NextPrivateKeySecretName},
 		})
+		return err
 	} else {
 		_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
 		return err
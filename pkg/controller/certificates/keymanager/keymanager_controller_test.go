@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/kr/pretty"
 	corev1 "k8s.io/api/core/v1"
@@ -93,6 +94,7 @@ func TestProcessItem(t *testing.T) {
 			Data: data,
 		}
 	}
+	twoHoursAgo := metav1.NewTime(time.Now().Add(-2 * time.Hour).Truncate(time.Second))
 	tests := map[string]struct {
 		// key that should be passed to ProcessItem.
 		// if not set, the 'namespace/name' of the 'Certificate' field will be used.
@@ -195,6 +197,75 @@ func TestProcessItem(t *testing.T) {
 				), relaxedSecretMatcher),
 			},
 		},
+		"create a secret with a new private key if the existing private key is older than the rotation max age": {
+			certificate: &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+				Spec: cmapi.CertificateSpec{
+					SecretName: "test-secret",
+					PrivateKey: &cmapi.CertificatePrivateKey{
+						RotationMaxAge: &metav1.Duration{Duration: time.Hour},
+					},
+				},
+				Status: cmapi.CertificateStatus{
+					PrivateKeyCreationTime: &twoHoursAgo,
+					Conditions: []cmapi.CertificateCondition{
+						{
+							Type:   cmapi.CertificateConditionIssuing,
+							Status: cmmeta.ConditionTrue,
+						},
+					},
+				},
+			},
+			secrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test-secret"},
+					Data:       map[string][]byte{corev1.TLSPrivateKeyKey: mustGenerateRSA(t, 2048)},
+				},
+			},
+			expectedEvents: []string{
+				`Normal Rotating Generating a new private key as the private key stored in Secret "test-secret" is older than .spec.privateKey.rotationMaxAge`,
+				`Normal Generated Stored new private key in temporary Secret resource "test-notrandom"`,
+			},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"),
+					"status",
+					"testns",
+					&cmapi.Certificate{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+						Spec: cmapi.CertificateSpec{
+							SecretName: "test-secret",
+							PrivateKey: &cmapi.CertificatePrivateKey{
+								RotationMaxAge: &metav1.Duration{Duration: time.Hour},
+							},
+						},
+						Status: cmapi.CertificateStatus{
+							NextPrivateKeySecretName: ptr.To("test-notrandom"),
+							PrivateKeyCreationTime:   &twoHoursAgo,
+							Conditions: []cmapi.CertificateCondition{
+								{
+									Type:   cmapi.CertificateConditionIssuing,
+									Status: cmmeta.ConditionTrue,
+								},
+							},
+						},
+					},
+				)),
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					"testns",
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:       "testns",
+							GenerateName:    "test-",
+							Labels:          map[string]string{cmapi.IsNextPrivateKeySecretLabelKey: "true", cmapi.PartOfCertManagerControllerLabelKey: "true"},
+							OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(&cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"}}, certificateGvk)},
+						},
+						Data: map[string][]byte{"tls.key": nil},
+					},
+				), relaxedSecretMatcher),
+			},
+		},
 		"create a secret using the already allocated name if it is set": {
 			certificate: &cmapi.Certificate{
 				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
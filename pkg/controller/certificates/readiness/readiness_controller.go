@@ -19,6 +19,7 @@ package readiness
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -29,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 
 	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/controller/certificates/policies"
@@ -41,6 +43,7 @@ import (
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
@@ -66,6 +69,10 @@ type controller struct {
 	policyEvaluator policyEvaluatorFunc
 	// renewalTimeCalculator calculates renewal time of a certificate
 	renewalTimeCalculator pki.RenewalTimeFunc
+	// defaultRenewalJitter is used to spread the renewal times of
+	// Certificates that do not set spec.renewalJitter
+	defaultRenewalJitter time.Duration
+	clock                clock.Clock
 
 	// fieldManager is the string which will be used as the Field Manager on
 	// fields created or edited by the cert-manager Kubernetes client during
@@ -96,6 +103,7 @@ func NewController(
 	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
 	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
 	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
+	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
 
 	if _, err := certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue}); err != nil {
 		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
@@ -116,12 +124,34 @@ func NewController(
 		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
 	}
 
+	// When an Issuer or ClusterIssuer changes, enqueue the Certificate resources
+	// that reference it, as the issuer may define their renewal window.
+	enqueueCertificatesForIssuer := &controllerpkg.BlockingEventHandler{
+		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(), predicate.CertificateIssuer),
+	}
+	if _, err := issuerInformer.Informer().AddEventHandler(enqueueCertificatesForIssuer); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+
 	// build a list of InformerSynced functions that will be returned by the Register method.
 	// the controller will only begin processing items once all of these informers have synced.
 	mustSync := []cache.InformerSynced{
 		certificateRequestInformer.Informer().HasSynced,
 		secretsInformer.Informer().HasSynced,
 		certificateInformer.Informer().HasSynced,
+		issuerInformer.Informer().HasSynced,
+	}
+
+	// If we are running in non-namespaced mode, we also need a lister for
+	// ClusterIssuers.
+	var clusterIssuerLister cmlisters.ClusterIssuerLister
+	if ctx.Namespace == "" {
+		clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+		if _, err := clusterIssuerInformer.Informer().AddEventHandler(enqueueCertificatesForIssuer); err != nil {
+			return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+		}
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+		clusterIssuerLister = clusterIssuerInformer.Lister()
 	}
 
 	return &controller{
@@ -133,9 +163,12 @@ func NewController(
 		gatherer: &policies.Gatherer{
 			CertificateRequestLister: certificateRequestInformer.Lister(),
 			SecretLister:             secretsInformer.Lister(),
+			IssuerHelper:             issuer.NewHelper(issuerInformer.Lister(), clusterIssuerLister),
 		},
 		policyEvaluator:       policyEvaluator,
 		renewalTimeCalculator: renewalTimeCalculator,
+		defaultRenewalJitter:  ctx.CertificateOptions.RenewalJitter,
+		clock:                 ctx.Clock,
 		fieldManager:          ctx.FieldManager,
 	}, queue, mustSync, nil
 }
@@ -181,7 +214,18 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 
 		notBefore := metav1.NewTime(x509cert.NotBefore)
 		notAfter := metav1.NewTime(x509cert.NotAfter)
-		renewalTime := c.renewalTimeCalculator(x509cert.NotBefore, x509cert.NotAfter, crt.Spec.RenewBefore, crt.Spec.RenewBeforePercentage)
+		renewalTime, _, err := internalcertificates.RenewalTime(crt, x509cert, input.RenewalWindow, c.defaultRenewalJitter, c.clock.Now(), c.renewalTimeCalculator)
+		if err != nil {
+			log.Error(err, "ignoring invalid renewal window")
+		}
+		if utilfeature.DefaultFeatureGate.Enabled(feature.ACMERenewalInfo) {
+			// If the ACME server suggests renewing the certificate earlier
+			// than we otherwise would, honour its suggestion.
+			ariRenewalTime := internalcertificates.RenewalInfoRenewalTime(crt.Status.RenewalInfo, x509cert)
+			if ariRenewalTime != nil && (renewalTime == nil || ariRenewalTime.Before(renewalTime)) {
+				renewalTime = ariRenewalTime
+			}
+		}
 
 		// update Certificate's Status
 		crt.Status.NotBefore = &notBefore
@@ -212,7 +256,7 @@ func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certifi
 		if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionReady); cond != nil {
 			conditions = []cmapi.CertificateCondition{*cond}
 		}
-		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
+		_, err := internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
 			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
 			Status: cmapi.CertificateStatus{
 				NotAfter:    crt.Status.NotAfter,
@@ -221,6 +265,7 @@ func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certifi
 				Conditions:  conditions,
 			},
 		})
+		return err
 	} else {
 		_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
 		return err
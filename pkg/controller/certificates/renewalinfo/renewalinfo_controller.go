@@ -0,0 +1,330 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package renewalinfo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	"github.com/cert-manager/cert-manager/pkg/acme"
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cmacmelisters "github.com/cert-manager/cert-manager/pkg/client/listers/acme/v1"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/scheduler"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/pkg/util/predicate"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
+)
+
+const (
+	// ControllerName is the name of the certificate renewal info controller.
+	ControllerName = "certificates-renewal-info"
+
+	// defaultCheckInterval is the interval after which renewal information is
+	// retrieved again if the ACME server did not send a Retry-After header.
+	// RFC 9773 section 4.3.2 suggests polling roughly twice per day.
+	defaultCheckInterval = 6 * time.Hour
+	// minCheckInterval and maxCheckInterval are the bounds that the
+	// Retry-After header sent by the ACME server is clamped to, as
+	// recommended by RFC 9773 section 4.3.2.
+	minCheckInterval = time.Minute
+	maxCheckInterval = 24 * time.Hour
+)
+
+// This controller retrieves ACME Renewal Information (ARI, RFC 9773) for the
+// certificates stored in the Secrets of Certificates issued by an ACME Issuer,
+// and stores it on the Certificate's `status.renewalInfo` field.
+// The readiness controller takes the suggested renewal window into account when
+// calculating `status.renewalTime` and the trigger controller will trigger a
+// renewal once the renewal time within the window has been reached.
+type controller struct {
+	certificateLister  cmlisters.CertificateLister
+	secretLister       internalinformers.SecretLister
+	orderLister        cmacmelisters.OrderLister
+	helper             issuer.Helper
+	accountRegistry    accounts.Getter
+	client             cmclient.Interface
+	scheduledWorkQueue scheduler.ScheduledWorkQueue[types.NamespacedName]
+
+	// fieldManager is the string which will be used as the Field Manager on
+	// fields created or edited by the cert-manager Kubernetes client during
+	// Apply API calls.
+	fieldManager string
+
+	clock clock.Clock
+}
+
+// NewController returns a new certificate renewal info controller.
+func NewController(
+	log logr.Logger,
+	ctx *controllerpkg.Context,
+	isNamespaced bool,
+) (*controller, workqueue.TypedRateLimitingInterface[types.NamespacedName], []cache.InformerSynced, error) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewTypedRateLimitingQueueWithConfig(
+		controllerpkg.DefaultACMERateLimiter(),
+		workqueue.TypedRateLimitingQueueConfig[types.NamespacedName]{
+			Name: ControllerName,
+		},
+	)
+
+	// obtain references to all the informers used by this controller
+	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
+	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
+	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
+	orderInformer := ctx.SharedInformerFactory.Acme().V1().Orders()
+
+	if _, err := certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue}); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+	// When a Secret resource changes, enqueue any Certificate resources that name it as spec.secretName.
+	if _, err := secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+			predicate.ExtractResourceName(predicate.CertificateSecretName)),
+	}); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		certificateInformer.Informer().HasSynced,
+		issuerInformer.Informer().HasSynced,
+		secretsInformer.Informer().HasSynced,
+		orderInformer.Informer().HasSynced,
+	}
+
+	// If we are running in non-namespaced mode, we also need a lister for
+	// ClusterIssuers.
+	var clusterIssuerLister cmlisters.ClusterIssuerLister
+	if !isNamespaced {
+		clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+		clusterIssuerLister = clusterIssuerInformer.Lister()
+	}
+
+	return &controller{
+		certificateLister:  certificateInformer.Lister(),
+		secretLister:       secretsInformer.Lister(),
+		orderLister:        orderInformer.Lister(),
+		helper:             issuer.NewHelper(issuerInformer.Lister(), clusterIssuerLister),
+		accountRegistry:    ctx.AccountRegistry,
+		client:             ctx.CMClient,
+		scheduledWorkQueue: scheduler.NewScheduledWorkQueue(ctx.Clock, queue.Add),
+		fieldManager:       ctx.FieldManager,
+		clock:              ctx.Clock,
+	}, queue, mustSync, nil
+}
+
+// ProcessItem is a worker function that will be called when a new key
+// corresponding to a Certificate to be re-synced is pulled from the workqueue.
+// ProcessItem will update the renewal info of a Certificate.
+func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+	ctx = logf.NewContext(ctx, log)
+	namespace, name := key.Namespace, key.Name
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("certificate not found for key", "error", err.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	oldCrt := crt
+	crt = crt.DeepCopy()
+
+	info, err := c.renewalInfoForCertificate(ctx, crt)
+	if err != nil {
+		return err
+	}
+	crt.Status.RenewalInfo = info
+
+	if info != nil && info.NextCheckTime != nil {
+		// ensure the renewal information is refreshed once the ACME
+		// server asked us to check again
+		c.scheduledWorkQueue.Add(key, info.NextCheckTime.Time.Sub(c.clock.Now()))
+	}
+
+	if apiequality.Semantic.DeepEqual(oldCrt.Status, crt.Status) {
+		return nil
+	}
+
+	log.V(logf.DebugLevel).Info("updating renewal info", "renewalInfo", crt.Status.RenewalInfo)
+	return c.updateOrApplyStatus(ctx, crt)
+}
+
+// renewalInfoForCertificate returns the renewal info that should be stored on
+// the given Certificate's status. If the renewal info on the status is still
+// fresh, it is returned unchanged. A nil return value means that no renewal
+// info is available for the Certificate.
+func (c *controller) renewalInfoForCertificate(ctx context.Context, crt *cmapi.Certificate) (*cmapi.CertificateRenewalInfo, error) {
+	log := logf.FromContext(ctx)
+
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("Secret does not exist yet, not retrieving renewal info")
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	x509Cert, err := pki.DecodeX509CertificateBytes(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		log.V(logf.DebugLevel).Info("Secret does not contain a valid certificate, not retrieving renewal info", "error", err.Error())
+		return nil, nil
+	}
+
+	certID, err := acmeapi.ARICertID(x509Cert)
+	if err != nil {
+		log.V(logf.DebugLevel).Info("Unable to compute ARI certificate identifier, not retrieving renewal info", "error", err.Error())
+		return nil, nil
+	}
+
+	// fast-path if the stored renewal info is for the current certificate and
+	// it is not yet time to check again
+	if info := crt.Status.RenewalInfo; info != nil && info.CertID == certID &&
+		info.NextCheckTime != nil && info.NextCheckTime.Time.After(c.clock.Now()) {
+		return info, nil
+	}
+
+	genericIssuer, err := c.helper.GetGenericIssuer(crt.Spec.IssuerRef, crt.Namespace)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("Issuer not found, not retrieving renewal info")
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if genericIssuer.GetSpec().ACME == nil {
+		// renewal info is only available for certificates issued by ACME
+		// Issuers
+		return nil, nil
+	}
+
+	// Renewal information must be retrieved from the ACME server that issued
+	// the certificate, which is recorded on the Order that it was issued for.
+	orders, err := c.orderLister.Orders(crt.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	server, _ := acme.ServerForCertificate(orders, x509Cert)
+	cl, err := c.accountRegistry.GetClient(accounts.ClientID(string(genericIssuer.GetUID()), genericIssuer.GetSpec().ACME, server))
+	if err != nil {
+		return nil, err
+	}
+
+	ri, err := cl.GetRenewalInfo(ctx, certID)
+	if errors.Is(err, acmeapi.ErrRenewalInfoUnsupported) {
+		log.V(logf.DebugLevel).Info("ACME server does not support ACME Renewal Information")
+		return nil, nil
+	}
+	if acmeErr, ok := err.(*acmeapi.Error); ok && acmeErr.StatusCode == 404 {
+		// The certificate is unknown to the ACME server, e.g. because it was
+		// issued by a different ACME server than the one currently configured.
+		log.V(logf.DebugLevel).Info("ACME server has no renewal info for the certificate", "error", err.Error())
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve renewal info from ACME server: %w", err)
+	}
+
+	now := c.clock.Now()
+	checkInterval := defaultCheckInterval
+	if !ri.RetryAfter.IsZero() {
+		checkInterval = min(max(ri.RetryAfter.Sub(now), minCheckInterval), maxCheckInterval)
+	}
+
+	return &cmapi.CertificateRenewalInfo{
+		CertID:               certID,
+		SuggestedWindowStart: metav1.NewTime(ri.SuggestedWindow.Start),
+		SuggestedWindowEnd:   metav1.NewTime(ri.SuggestedWindow.End),
+		ExplanationURL:       ri.ExplanationURL,
+		NextCheckTime:        &metav1.Time{Time: now.Add(checkInterval)},
+	}, nil
+}
+
+// updateOrApplyStatus will update the controller status. If the
+// ServerSideApply feature is enabled, the managed fields will instead get
+// applied using the relevant Patch API call.
+func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certificate) error {
+	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
+		_, err := internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
+			Status: cmapi.CertificateStatus{
+				RenewalInfo: crt.Status.RenewalInfo,
+			},
+		})
+		return err
+	} else {
+		_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
+		return err
+	}
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLimitingInterface[types.NamespacedName], []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	// If --namespace flag was set thus limiting cert-manager to a single namespace.
+	isNamespaced := ctx.Namespace != ""
+
+	ctrl, queue, mustSync, err := NewController(log, ctx, isNamespaced)
+	c.controller = ctrl
+
+	return queue, mustSync, err
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
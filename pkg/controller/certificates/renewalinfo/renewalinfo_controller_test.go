@@ -0,0 +1,308 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package renewalinfo
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	accountstest "github.com/cert-manager/cert-manager/pkg/acme/accounts/test"
+	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	schedulertest "github.com/cert-manager/cert-manager/pkg/scheduler/test"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
+)
+
+// mustCreateCertWithAKI returns a self-signed certificate which has an
+// Authority Key Identifier, which is required to compute its ARI identifier.
+func mustCreateCertWithAKI(t *testing.T, crt *cmapi.Certificate) ([]byte, string) {
+	pk, err := pki.DecodePrivateKeyBytes(testcrypto.MustCreatePEMPrivateKey(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	template, err := pki.CertificateTemplateFromCertificate(crt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template.SerialNumber = big.NewInt(1234)
+	template.AuthorityKeyId = []byte{1, 2, 3, 4}
+
+	certPEM, x509Cert, err := pki.SignCertificate(template, template, pk.Public(), pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certID, err := acmeapi.ARICertID(x509Cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return certPEM, certID
+}
+
+func TestProcessItem(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	windowStart, windowEnd := now.Add(24*time.Hour), now.Add(48*time.Hour)
+
+	acmeIssuer := gen.Issuer("acme-issuer",
+		gen.SetIssuerNamespace("testns"),
+		gen.SetIssuerACME(cmacme.ACMEIssuer{}),
+	)
+	caIssuer := gen.Issuer("ca-issuer",
+		gen.SetIssuerNamespace("testns"),
+		gen.SetIssuerCA(cmapi.CAIssuer{}),
+	)
+	baseCert := gen.Certificate("test",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateSecretName("test-secret"),
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "acme-issuer"}),
+	)
+	certPEM, certID := mustCreateCertWithAKI(t, baseCert)
+	secret := gen.Secret("test-secret",
+		gen.SetSecretNamespace("testns"),
+		gen.SetSecretData(map[string][]byte{corev1.TLSCertKey: certPEM}),
+	)
+
+	freshInfo := &cmapi.CertificateRenewalInfo{
+		CertID:               certID,
+		SuggestedWindowStart: metav1.NewTime(windowStart),
+		SuggestedWindowEnd:   metav1.NewTime(windowEnd),
+		NextCheckTime:        &metav1.Time{Time: now.Add(time.Hour)},
+	}
+
+	tests := map[string]struct {
+		cert    *cmapi.Certificate
+		secret  *corev1.Secret
+		issuers []*cmapi.Issuer
+		orders  []*cmacme.Order
+
+		// expectedClientID is the ID of the ACME client that renewal info is
+		// expected to be retrieved with, if set.
+		expectedClientID string
+
+		getRenewalInfo func(ctx context.Context, certID string) (*acmeapi.RenewalInfo, error)
+
+		expectedRenewalInfo *cmapi.CertificateRenewalInfo
+		certShouldUpdate    bool
+		shouldSchedule      bool
+		wantsErr            bool
+	}{
+		"do nothing if the Certificate does not exist": {},
+		"do nothing if the Secret does not exist": {
+			cert:    baseCert,
+			issuers: []*cmapi.Issuer{acmeIssuer},
+		},
+		"do nothing if the Certificate is not issued by an ACME Issuer": {
+			cert: gen.CertificateFrom(baseCert,
+				gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "ca-issuer"}),
+			),
+			secret:  secret,
+			issuers: []*cmapi.Issuer{caIssuer},
+		},
+		"do nothing if the ACME server does not support ARI": {
+			cert:    baseCert,
+			secret:  secret,
+			issuers: []*cmapi.Issuer{acmeIssuer},
+			getRenewalInfo: func(context.Context, string) (*acmeapi.RenewalInfo, error) {
+				return nil, acmeapi.ErrRenewalInfoUnsupported
+			},
+		},
+		"do not call the ACME server if the stored renewal info is still fresh": {
+			cert: gen.CertificateFrom(baseCert, func(crt *cmapi.Certificate) {
+				crt.Status.RenewalInfo = freshInfo
+			}),
+			secret:         secret,
+			issuers:        []*cmapi.Issuer{acmeIssuer},
+			shouldSchedule: true,
+		},
+		"store renewal info returned by the ACME server": {
+			cert:    baseCert,
+			secret:  secret,
+			issuers: []*cmapi.Issuer{acmeIssuer},
+			getRenewalInfo: func(_ context.Context, id string) (*acmeapi.RenewalInfo, error) {
+				if id != certID {
+					t.Errorf("unexpected certID %q, expected %q", id, certID)
+				}
+				return &acmeapi.RenewalInfo{
+					SuggestedWindow: acmeapi.RenewalInfoWindow{Start: windowStart, End: windowEnd},
+					ExplanationURL:  "https://example.com/explanation",
+					RetryAfter:      now.Add(time.Hour),
+				}, nil
+			},
+			expectedRenewalInfo: &cmapi.CertificateRenewalInfo{
+				CertID:               certID,
+				SuggestedWindowStart: metav1.NewTime(windowStart),
+				SuggestedWindowEnd:   metav1.NewTime(windowEnd),
+				ExplanationURL:       "https://example.com/explanation",
+				NextCheckTime:        &metav1.Time{Time: now.Add(time.Hour)},
+			},
+			certShouldUpdate: true,
+			shouldSchedule:   true,
+		},
+		"refresh stale renewal info for a previous certificate": {
+			cert: gen.CertificateFrom(baseCert, func(crt *cmapi.Certificate) {
+				crt.Status.RenewalInfo = &cmapi.CertificateRenewalInfo{
+					CertID:        "AQIDBA.AQ",
+					NextCheckTime: &metav1.Time{Time: now.Add(time.Hour)},
+				}
+			}),
+			secret:  secret,
+			issuers: []*cmapi.Issuer{acmeIssuer},
+			getRenewalInfo: func(context.Context, string) (*acmeapi.RenewalInfo, error) {
+				return &acmeapi.RenewalInfo{
+					SuggestedWindow: acmeapi.RenewalInfoWindow{Start: windowStart, End: windowEnd},
+				}, nil
+			},
+			expectedRenewalInfo: &cmapi.CertificateRenewalInfo{
+				CertID:               certID,
+				SuggestedWindowStart: metav1.NewTime(windowStart),
+				SuggestedWindowEnd:   metav1.NewTime(windowEnd),
+				NextCheckTime:        &metav1.Time{Time: now.Add(defaultCheckInterval)},
+			},
+			certShouldUpdate: true,
+			shouldSchedule:   true,
+		},
+		"retrieve renewal info from the fallback ACME server that issued the certificate": {
+			cert:   baseCert,
+			secret: secret,
+			issuers: []*cmapi.Issuer{gen.IssuerFrom(acmeIssuer,
+				func(iss cmapi.GenericIssuer) { iss.GetObjectMeta().UID = "acme-issuer-uid" },
+				gen.SetIssuerACME(cmacme.ACMEIssuer{
+					Server:          "https://primary.example.com/directory",
+					FallbackServers: []cmacme.ACMEFallbackServer{{Server: "https://fallback.example.com/directory"}},
+				}),
+			)},
+			orders: []*cmacme.Order{gen.Order("test-order",
+				gen.SetOrderNamespace("testns"),
+				gen.SetOrderStatus(cmacme.OrderStatus{
+					Server:      "https://fallback.example.com/directory",
+					Certificate: certPEM,
+				}),
+			)},
+			expectedClientID: "acme-issuer-uid/https://fallback.example.com/directory",
+			getRenewalInfo: func(context.Context, string) (*acmeapi.RenewalInfo, error) {
+				return &acmeapi.RenewalInfo{
+					SuggestedWindow: acmeapi.RenewalInfoWindow{Start: windowStart, End: windowEnd},
+				}, nil
+			},
+			expectedRenewalInfo: &cmapi.CertificateRenewalInfo{
+				CertID:               certID,
+				SuggestedWindowStart: metav1.NewTime(windowStart),
+				SuggestedWindowEnd:   metav1.NewTime(windowEnd),
+				NextCheckTime:        &metav1.Time{Time: now.Add(defaultCheckInterval)},
+			},
+			certShouldUpdate: true,
+			shouldSchedule:   true,
+		},
+		"return an error if the ACME server returns an unexpected error": {
+			cert:    baseCert,
+			secret:  secret,
+			issuers: []*cmapi.Issuer{acmeIssuer},
+			getRenewalInfo: func(context.Context, string) (*acmeapi.RenewalInfo, error) {
+				return nil, &acmeapi.Error{StatusCode: 500}
+			},
+			wantsErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:     t,
+				Clock: fakeclock.NewFakeClock(now),
+			}
+			if test.cert != nil {
+				builder.CertManagerObjects = append(builder.CertManagerObjects, test.cert)
+			}
+			for _, iss := range test.issuers {
+				builder.CertManagerObjects = append(builder.CertManagerObjects, iss)
+			}
+			for _, o := range test.orders {
+				builder.CertManagerObjects = append(builder.CertManagerObjects, o)
+			}
+			if test.secret != nil {
+				builder.KubeObjects = append(builder.KubeObjects, test.secret)
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+
+			w.controller.accountRegistry = &accountstest.FakeRegistry{
+				GetClientFunc: func(clientID string) (acmecl.Interface, error) {
+					if test.expectedClientID != "" && clientID != test.expectedClientID {
+						t.Errorf("unexpected ACME client %q, expected %q", clientID, test.expectedClientID)
+					}
+					return &acmecl.FakeACME{
+						FakeGetRenewalInfo: func(ctx context.Context, certID string) (*acmeapi.RenewalInfo, error) {
+							if test.getRenewalInfo == nil {
+								t.Fatal("unexpected call to GetRenewalInfo")
+							}
+							return test.getRenewalInfo(ctx, certID)
+						},
+					}, nil
+				},
+			}
+			gotScheduled := false
+			w.controller.scheduledWorkQueue = &schedulertest.FakeScheduler{
+				AddFunc: func(types.NamespacedName, time.Duration) {
+					gotScheduled = true
+				},
+			}
+
+			if test.certShouldUpdate {
+				c := test.cert.DeepCopy()
+				c.Status.RenewalInfo = test.expectedRenewalInfo
+				builder.ExpectedActions = append(builder.ExpectedActions,
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						c.Namespace,
+						c)))
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			err := w.controller.ProcessItem(context.Background(), types.NamespacedName{Namespace: "testns", Name: "test"})
+			if test.wantsErr != (err != nil) {
+				t.Errorf("expected error: %v, got : %v", test.wantsErr, err)
+			}
+			if gotScheduled != test.shouldSchedule {
+				t.Errorf("expected Certificate to be re-queued: %v, got re-queued: %v", test.shouldSchedule, gotScheduled)
+			}
+
+			if err := builder.AllActionsExecuted(); err != nil {
+				builder.T.Error(err)
+			}
+		})
+	}
+}
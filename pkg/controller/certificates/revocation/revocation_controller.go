@@ -0,0 +1,460 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revocation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/scheduler"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	// ControllerName is the name of the certificate revocation controller.
+	ControllerName = "certificates-revocation"
+
+	// maxRevocationAttempts is the number of failed attempts after which
+	// cert-manager gives up revoking a certificate.
+	maxRevocationAttempts = 10
+	// initialRetryInterval and maxRetryInterval bound the exponential
+	// back-off between failed revocation attempts.
+	initialRetryInterval = time.Minute
+	maxRetryInterval     = time.Hour
+
+	// maxRevocationHistory is the number of completed revocations that are
+	// retained on the status of a Certificate.
+	maxRevocationHistory = 10
+
+	reasonRevoked          = "Revoked"
+	reasonRevocationFailed = "RevocationFailed"
+)
+
+// This controller revokes the certificates issued for a Certificate as
+// configured by its `spec.revocationPolicy`.
+// Certificates that have been superseded by a certificate for a different
+// private key are recorded as pending revocations on the Certificate's
+// status by the issuing controller. Certificates that are to be revoked when
+// the Certificate is deleted are protected by a finalizer, which is removed
+// once the current certificate has been revoked. The current certificate can
+// also be revoked on demand using the cert-manager.io/revoke annotation.
+// The controller always runs so that the finalizer can be removed if the
+// CertificateRevocation feature gate is disabled again.
+type controller struct {
+	certificateLister  cmlisters.CertificateLister
+	secretLister       internalinformers.SecretLister
+	helper             issuer.Helper
+	issuerFactory      issuer.Factory
+	client             cmclient.Interface
+	recorder           record.EventRecorder
+	scheduledWorkQueue scheduler.ScheduledWorkQueue[types.NamespacedName]
+
+	// fieldManager is the string which will be used as the Field Manager on
+	// fields created or edited by the cert-manager Kubernetes client during
+	// Apply API calls.
+	fieldManager string
+
+	// revocationEnabled is true if the CertificateRevocation feature gate is
+	// enabled. Otherwise, the controller only removes the revocation
+	// finalizer from Certificates.
+	revocationEnabled bool
+
+	clock clock.Clock
+}
+
+// NewController returns a new certificate revocation controller.
+func NewController(
+	log logr.Logger,
+	ctx *controllerpkg.Context,
+	isNamespaced bool,
+) (*controller, workqueue.TypedRateLimitingInterface[types.NamespacedName], []cache.InformerSynced, error) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewTypedRateLimitingQueueWithConfig(
+		controllerpkg.DefaultCertificateRateLimiter(),
+		workqueue.TypedRateLimitingQueueConfig[types.NamespacedName]{
+			Name: ControllerName,
+		},
+	)
+
+	// obtain references to all the informers used by this controller
+	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
+	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
+	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
+
+	if _, err := certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue}); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		certificateInformer.Informer().HasSynced,
+		issuerInformer.Informer().HasSynced,
+		secretsInformer.Informer().HasSynced,
+	}
+
+	// If we are running in non-namespaced mode, we also need a lister for
+	// ClusterIssuers.
+	var clusterIssuerLister cmlisters.ClusterIssuerLister
+	if !isNamespaced {
+		clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+		clusterIssuerLister = clusterIssuerInformer.Lister()
+	}
+
+	return &controller{
+		certificateLister:  certificateInformer.Lister(),
+		secretLister:       secretsInformer.Lister(),
+		helper:             issuer.NewHelper(issuerInformer.Lister(), clusterIssuerLister),
+		issuerFactory:      issuer.NewFactory(ctx),
+		client:             ctx.CMClient,
+		recorder:           ctx.Recorder,
+		scheduledWorkQueue: scheduler.NewScheduledWorkQueue(ctx.Clock, queue.Add),
+		fieldManager:       ctx.FieldManager,
+		revocationEnabled:  utilfeature.DefaultFeatureGate.Enabled(feature.CertificateRevocation),
+		clock:              ctx.Clock,
+	}, queue, mustSync, nil
+}
+
+// ProcessItem is a worker function that will be called when a new key
+// corresponding to a Certificate to be re-synced is pulled from the workqueue.
+// ProcessItem will attempt any pending revocations of the Certificate and
+// manage its revocation finalizer.
+func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+	ctx = logf.NewContext(ctx, log)
+	namespace, name := key.Namespace, key.Name
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("certificate not found for key", "error", err.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	log = logf.WithResource(log, crt)
+	ctx = logf.NewContext(ctx, log)
+
+	oldCrt := crt
+	crt = crt.DeepCopy()
+
+	deleting := crt.DeletionTimestamp != nil
+	revokeOnDeletion := crt.Spec.RevocationPolicy != nil && crt.Spec.RevocationPolicy.OnDeletion
+	hasFinalizer := sets.New(crt.Finalizers...).Has(cmapi.CertificateRevocationFinalizer)
+
+	if !c.revocationEnabled {
+		// Certificates may still hold the finalizer added while revocation was
+		// enabled, which would otherwise prevent them from ever being deleted.
+		if !hasFinalizer {
+			return nil
+		}
+		log.V(logf.DebugLevel).Info("removing revocation finalizer as certificate revocation is disabled")
+		crt.Finalizers = sets.List(sets.New(crt.Finalizers...).Delete(cmapi.CertificateRevocationFinalizer))
+		_, err = c.client.CertmanagerV1().Certificates(crt.Namespace).Update(ctx, crt, metav1.UpdateOptions{FieldManager: c.fieldManager})
+		return err
+	}
+
+	revokeReason, revokeRequested := crt.Annotations[cmapi.RevokeCertificateAnnotationKey]
+	if revokeRequested {
+		reason := cmapi.CertificateRevocationReason(revokeReason)
+		if reason == "" {
+			reason = cmapi.RevocationReasonUnspecified
+		}
+		recorded, err := c.revokeCurrentCertificate(crt, reason)
+		if err != nil {
+			return err
+		}
+		if !recorded {
+			c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonRevocationFailed,
+				"Ignoring the %s annotation as the Secret %q does not contain a certificate issued by cert-manager", cmapi.RevokeCertificateAnnotationKey, crt.Spec.SecretName)
+		}
+	}
+
+	if deleting && hasFinalizer && revokeOnDeletion {
+		if _, err := c.revokeCurrentCertificate(crt, cmapi.RevocationReasonCessationOfOperation); err != nil {
+			return err
+		}
+	}
+
+	retryIn, pending := c.processRevocations(ctx, crt)
+	crt.Status.Revocations = trimRevocations(crt.Status.Revocations)
+
+	if !apiequality.Semantic.DeepEqual(oldCrt.Status, crt.Status) {
+		if err := c.updateOrApplyStatus(ctx, crt); err != nil {
+			return err
+		}
+	}
+
+	if pending {
+		c.scheduledWorkQueue.Add(key, retryIn)
+	}
+
+	// the revocation has been recorded on the status, so the annotation is
+	// removed to avoid revoking the next certificate issued
+	needsUpdate := revokeRequested
+	delete(crt.Annotations, cmapi.RevokeCertificateAnnotationKey)
+
+	switch {
+	case deleting && hasFinalizer && !pending:
+		// all revocations have been attempted, the Certificate can be removed
+		crt.Finalizers = sets.List(sets.New(crt.Finalizers...).Delete(cmapi.CertificateRevocationFinalizer))
+		needsUpdate = true
+	case !deleting && revokeOnDeletion && !hasFinalizer:
+		crt.Finalizers = append(crt.Finalizers, cmapi.CertificateRevocationFinalizer)
+		needsUpdate = true
+	case !deleting && !revokeOnDeletion && hasFinalizer:
+		crt.Finalizers = sets.List(sets.New(crt.Finalizers...).Delete(cmapi.CertificateRevocationFinalizer))
+		needsUpdate = true
+	}
+	if !needsUpdate {
+		return nil
+	}
+
+	log.V(logf.DebugLevel).Info("updating revocation finalizer and annotations", "finalizers", crt.Finalizers)
+	_, err = c.client.CertmanagerV1().Certificates(crt.Namespace).Update(ctx, crt, metav1.UpdateOptions{FieldManager: c.fieldManager})
+	return err
+}
+
+// revokeCurrentCertificate records a pending revocation, with the given
+// reason, of the certificate currently stored in the Certificate's Secret on
+// the Certificate's status. It returns false if the Secret does not contain a
+// certificate issued by cert-manager.
+func (c *controller) revokeCurrentCertificate(crt *cmapi.Certificate, reason cmapi.CertificateRevocationReason) (bool, error) {
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return false, err
+	}
+	revocation, _ := internalcertificates.PendingRevocationForSecret(secret, reason)
+	if revocation == nil {
+		return false, nil
+	}
+	if !internalcertificates.HasRevocation(crt.Status.Revocations, revocation.SerialNumber) {
+		crt.Status.Revocations = append(crt.Status.Revocations, *revocation)
+	}
+	return true, nil
+}
+
+// processRevocations attempts all pending revocations on the Certificate's
+// status whose back-off has expired, and records their outcome on the
+// status. It returns whether any revocations are still pending, and the
+// time after which the next one should be attempted.
+func (c *controller) processRevocations(ctx context.Context, crt *cmapi.Certificate) (time.Duration, bool) {
+	log := logf.FromContext(ctx)
+
+	var retryIn time.Duration
+	pending := false
+	for i := range crt.Status.Revocations {
+		revocation := &crt.Status.Revocations[i]
+		if revocation.State != cmapi.RevocationStatePending {
+			continue
+		}
+
+		now := c.clock.Now()
+		if revocation.LastAttemptTime != nil {
+			if wait := revocation.LastAttemptTime.Add(retryInterval(revocation.FailedAttempts)).Sub(now); wait > 0 {
+				if !pending || wait < retryIn {
+					retryIn = wait
+				}
+				pending = true
+				continue
+			}
+		}
+
+		log := log.WithValues("serialNumber", revocation.SerialNumber, "reason", revocation.Reason)
+		permanent, err := c.revoke(ctx, crt, revocation)
+		revocation.LastAttemptTime = &metav1.Time{Time: now}
+		if err == nil {
+			log.V(logf.InfoLevel).Info("revoked certificate")
+			revocation.State = cmapi.RevocationStateRevoked
+			revocation.RevocationTime = &metav1.Time{Time: now}
+			revocation.Certificate = nil
+			revocation.Message = fmt.Sprintf("Certificate with serial number %s has been revoked", revocation.SerialNumber)
+			c.recorder.Event(crt, corev1.EventTypeNormal, reasonRevoked, revocation.Message)
+			continue
+		}
+
+		revocation.FailedAttempts++
+		if permanent || revocation.FailedAttempts >= maxRevocationAttempts {
+			log.Error(err, "giving up revoking certificate", "failedAttempts", revocation.FailedAttempts)
+			revocation.State = cmapi.RevocationStateFailed
+			revocation.Certificate = nil
+			revocation.Message = fmt.Sprintf("Failed to revoke certificate with serial number %s: %v", revocation.SerialNumber, err)
+			c.recorder.Event(crt, corev1.EventTypeWarning, reasonRevocationFailed, revocation.Message)
+			continue
+		}
+
+		log.Error(err, "failed to revoke certificate, will retry", "failedAttempts", revocation.FailedAttempts)
+		revocation.Message = fmt.Sprintf("Failed to revoke certificate with serial number %s, will retry: %v", revocation.SerialNumber, err)
+		c.recorder.Event(crt, corev1.EventTypeWarning, reasonRevocationFailed, revocation.Message)
+		if wait := retryInterval(revocation.FailedAttempts); !pending || wait < retryIn {
+			retryIn = wait
+		}
+		pending = true
+	}
+
+	return retryIn, pending
+}
+
+// revoke asks the issuer that issued the certificate of the given revocation
+// to revoke it. The returned boolean is true if the revocation failed in a
+// way that will not be resolved by retrying.
+func (c *controller) revoke(ctx context.Context, crt *cmapi.Certificate, revocation *cmapi.CertificateRevocation) (bool, error) {
+	cert, err := pki.DecodeX509CertificateBytes(revocation.Certificate)
+	if err != nil {
+		return true, err
+	}
+
+	if group := revocation.IssuerRef.Group; group != "" && group != certmanager.GroupName {
+		return true, fmt.Errorf("%w: external issuers of group %q cannot revoke certificates", issuer.ErrRevocationUnsupported, group)
+	}
+
+	genericIssuer, err := c.helper.GetGenericIssuer(revocation.IssuerRef, crt.Namespace)
+	if err != nil {
+		return false, err
+	}
+
+	iss, err := c.issuerFactory.IssuerFor(genericIssuer)
+	if err != nil {
+		return true, err
+	}
+
+	revoker, ok := iss.(issuer.Revoker)
+	if !ok {
+		return true, issuer.ErrRevocationUnsupported
+	}
+
+	err = revoker.Revoke(ctx, cert, revocation.Reason)
+	return errors.Is(err, issuer.ErrRevocationUnsupported), err
+}
+
+// retryInterval returns the time to wait before attempting a revocation
+// again after the given number of failed attempts.
+func retryInterval(failedAttempts int) time.Duration {
+	interval := initialRetryInterval
+	for i := 1; i < failedAttempts && interval < maxRetryInterval; i++ {
+		interval *= 2
+	}
+	return min(interval, maxRetryInterval)
+}
+
+// trimRevocations drops the oldest completed revocations from the given
+// list so that at most maxRevocationHistory of them are retained.
+// Pending revocations are always retained.
+func trimRevocations(revocations []cmapi.CertificateRevocation) []cmapi.CertificateRevocation {
+	completed := 0
+	for _, revocation := range revocations {
+		if revocation.State != cmapi.RevocationStatePending {
+			completed++
+		}
+	}
+	if completed <= maxRevocationHistory {
+		return revocations
+	}
+
+	toDrop := completed - maxRevocationHistory
+	trimmed := make([]cmapi.CertificateRevocation, 0, len(revocations)-toDrop)
+	for _, revocation := range revocations {
+		if toDrop > 0 && revocation.State != cmapi.RevocationStatePending {
+			toDrop--
+			continue
+		}
+		trimmed = append(trimmed, revocation)
+	}
+	return trimmed
+}
+
+// updateOrApplyStatus will update the controller status. If the
+// ServerSideApply feature is enabled, the managed fields will instead get
+// applied using the relevant Patch API call.
+func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certificate) error {
+	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
+		applied, err := internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
+			Status: cmapi.CertificateStatus{
+				Revocations: crt.Status.Revocations,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		// allow the finalizers to be updated without a conflict
+		crt.ResourceVersion = applied.ResourceVersion
+		return nil
+	} else {
+		updated, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
+		if err != nil {
+			return err
+		}
+		// allow the finalizers to be updated without a conflict
+		crt.ResourceVersion = updated.ResourceVersion
+		return nil
+	}
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLimitingInterface[types.NamespacedName], []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	// If --namespace flag was set thus limiting cert-manager to a single namespace.
+	isNamespaced := ctx.Namespace != ""
+
+	ctrl, queue, mustSync, err := NewController(log, ctx, isNamespaced)
+	c.controller = ctrl
+
+	return queue, mustSync, err
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
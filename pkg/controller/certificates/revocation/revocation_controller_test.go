@@ -0,0 +1,433 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revocation
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	coretesting "k8s.io/client-go/testing"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmfake "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/fake"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	issuerfake "github.com/cert-manager/cert-manager/pkg/issuer/fake"
+	schedulertest "github.com/cert-manager/cert-manager/pkg/scheduler/test"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+
+	baseCert := gen.Certificate("test",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateSecretName("test-secret"),
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "ca-issuer", Kind: "Issuer"}),
+	)
+	certPEM := testcrypto.MustCreateCert(t, testcrypto.MustCreatePEMPrivateKey(t), baseCert)
+	x509Cert, err := pki.DecodeX509CertificateBytes(certPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serialNumber := x509Cert.SerialNumber.Text(16)
+
+	secret := gen.Secret("test-secret",
+		gen.SetSecretNamespace("testns"),
+		gen.SetSecretAnnotations(map[string]string{
+			cmapi.IssuerNameAnnotationKey: "ca-issuer",
+			cmapi.IssuerKindAnnotationKey: "Issuer",
+		}),
+		gen.SetSecretData(map[string][]byte{corev1.TLSCertKey: certPEM}),
+	)
+
+	pending := cmapi.CertificateRevocation{
+		SerialNumber: serialNumber,
+		IssuerRef:    cmmeta.ObjectReference{Name: "ca-issuer", Kind: "Issuer"},
+		Reason:       cmapi.RevocationReasonSuperseded,
+		State:        cmapi.RevocationStatePending,
+		Certificate:  certPEM,
+	}
+	withRevocations := func(revocations ...cmapi.CertificateRevocation) gen.CertificateModifier {
+		return func(crt *cmapi.Certificate) {
+			crt.Status.Revocations = revocations
+		}
+	}
+	withPolicy := func(policy cmapi.CertificateRevocationPolicy) gen.CertificateModifier {
+		return func(crt *cmapi.Certificate) {
+			crt.Spec.RevocationPolicy = &policy
+		}
+	}
+	withFinalizer := func(crt *cmapi.Certificate) {
+		crt.Finalizers = append(crt.Finalizers, cmapi.CertificateRevocationFinalizer)
+	}
+	deleting := func(crt *cmapi.Certificate) {
+		crt.DeletionTimestamp = &metav1.Time{Time: now}
+	}
+	withRevokeAnnotation := func(reason string) gen.CertificateModifier {
+		return gen.AddCertificateAnnotations(map[string]string{cmapi.RevokeCertificateAnnotationKey: reason})
+	}
+
+	errRevoke := errors.New("connection refused")
+
+	tests := map[string]struct {
+		cert   *cmapi.Certificate
+		secret *corev1.Secret
+
+		revocationDisabled bool
+
+		revoke func(ctx context.Context, cert *x509.Certificate, reason cmapi.CertificateRevocationReason) error
+
+		expectedRevocations []cmapi.CertificateRevocation
+		expectedFinalizers  []string
+		statusShouldUpdate  bool
+		certShouldUpdate    bool
+		expectedEvents      []string
+		shouldSchedule      bool
+	}{
+		"do nothing if the Certificate does not exist": {},
+		"do nothing if there are no revocations and no revocation policy": {
+			cert: baseCert,
+		},
+		"revoke a pending certificate": {
+			cert: gen.CertificateFrom(baseCert, withRevocations(pending)),
+			revoke: func(_ context.Context, cert *x509.Certificate, reason cmapi.CertificateRevocationReason) error {
+				if cert.SerialNumber.Cmp(x509Cert.SerialNumber) != 0 {
+					t.Errorf("unexpected serial number %s", cert.SerialNumber)
+				}
+				if reason != cmapi.RevocationReasonSuperseded {
+					t.Errorf("unexpected reason %q", reason)
+				}
+				return nil
+			},
+			expectedRevocations: []cmapi.CertificateRevocation{{
+				SerialNumber:    serialNumber,
+				IssuerRef:       pending.IssuerRef,
+				Reason:          cmapi.RevocationReasonSuperseded,
+				State:           cmapi.RevocationStateRevoked,
+				Message:         "Certificate with serial number " + serialNumber + " has been revoked",
+				LastAttemptTime: &metav1.Time{Time: now},
+				RevocationTime:  &metav1.Time{Time: now},
+			}},
+			statusShouldUpdate: true,
+			expectedEvents:     []string{"Normal Revoked Certificate with serial number " + serialNumber + " has been revoked"},
+		},
+		"mark the revocation as failed if the issuer does not support revocation": {
+			cert: gen.CertificateFrom(baseCert, withRevocations(pending)),
+			expectedRevocations: []cmapi.CertificateRevocation{{
+				SerialNumber:    serialNumber,
+				IssuerRef:       pending.IssuerRef,
+				Reason:          cmapi.RevocationReasonSuperseded,
+				State:           cmapi.RevocationStateFailed,
+				Message:         "Failed to revoke certificate with serial number " + serialNumber + ": " + issuer.ErrRevocationUnsupported.Error(),
+				FailedAttempts:  1,
+				LastAttemptTime: &metav1.Time{Time: now},
+			}},
+			statusShouldUpdate: true,
+			expectedEvents:     []string{"Warning RevocationFailed Failed to revoke certificate with serial number " + serialNumber + ": " + issuer.ErrRevocationUnsupported.Error()},
+		},
+		"retry a revocation which failed with a transient error": {
+			cert: gen.CertificateFrom(baseCert, withRevocations(pending)),
+			revoke: func(context.Context, *x509.Certificate, cmapi.CertificateRevocationReason) error {
+				return errRevoke
+			},
+			expectedRevocations: []cmapi.CertificateRevocation{{
+				SerialNumber:    serialNumber,
+				IssuerRef:       pending.IssuerRef,
+				Reason:          cmapi.RevocationReasonSuperseded,
+				State:           cmapi.RevocationStatePending,
+				Certificate:     certPEM,
+				Message:         "Failed to revoke certificate with serial number " + serialNumber + ", will retry: connection refused",
+				FailedAttempts:  1,
+				LastAttemptTime: &metav1.Time{Time: now},
+			}},
+			statusShouldUpdate: true,
+			expectedEvents:     []string{"Warning RevocationFailed Failed to revoke certificate with serial number " + serialNumber + ", will retry: connection refused"},
+			shouldSchedule:     true,
+		},
+		"do not retry a failed revocation before its back-off has expired": {
+			cert: gen.CertificateFrom(baseCert, withRevocations(func() cmapi.CertificateRevocation {
+				r := pending
+				r.FailedAttempts = 2
+				r.LastAttemptTime = &metav1.Time{Time: now.Add(-time.Minute)}
+				return r
+			}())),
+			shouldSchedule: true,
+		},
+		"add the finalizer if the Certificate should be revoked on deletion": {
+			cert:               gen.CertificateFrom(baseCert, withPolicy(cmapi.CertificateRevocationPolicy{OnDeletion: true})),
+			expectedFinalizers: []string{cmapi.CertificateRevocationFinalizer},
+			certShouldUpdate:   true,
+		},
+		"remove the finalizer if the Certificate should no longer be revoked on deletion": {
+			cert:               gen.CertificateFrom(baseCert, withFinalizer),
+			expectedFinalizers: []string{},
+			certShouldUpdate:   true,
+		},
+		"revoke the current certificate and remove the finalizer when the Certificate is deleted": {
+			cert: gen.CertificateFrom(baseCert,
+				withPolicy(cmapi.CertificateRevocationPolicy{OnDeletion: true}),
+				withFinalizer,
+				deleting,
+			),
+			secret: secret,
+			revoke: func(_ context.Context, _ *x509.Certificate, reason cmapi.CertificateRevocationReason) error {
+				if reason != cmapi.RevocationReasonCessationOfOperation {
+					t.Errorf("unexpected reason %q", reason)
+				}
+				return nil
+			},
+			expectedRevocations: []cmapi.CertificateRevocation{{
+				SerialNumber:    serialNumber,
+				IssuerRef:       pending.IssuerRef,
+				Reason:          cmapi.RevocationReasonCessationOfOperation,
+				State:           cmapi.RevocationStateRevoked,
+				Message:         "Certificate with serial number " + serialNumber + " has been revoked",
+				LastAttemptTime: &metav1.Time{Time: now},
+				RevocationTime:  &metav1.Time{Time: now},
+			}},
+			expectedFinalizers: []string{},
+			statusShouldUpdate: true,
+			certShouldUpdate:   true,
+			expectedEvents:     []string{"Normal Revoked Certificate with serial number " + serialNumber + " has been revoked"},
+		},
+		"keep the finalizer while the revocation of a deleted Certificate is retried": {
+			cert: gen.CertificateFrom(baseCert,
+				withPolicy(cmapi.CertificateRevocationPolicy{OnDeletion: true}),
+				withFinalizer,
+				deleting,
+			),
+			secret: secret,
+			revoke: func(context.Context, *x509.Certificate, cmapi.CertificateRevocationReason) error {
+				return errRevoke
+			},
+			expectedRevocations: []cmapi.CertificateRevocation{{
+				SerialNumber:    serialNumber,
+				IssuerRef:       pending.IssuerRef,
+				Reason:          cmapi.RevocationReasonCessationOfOperation,
+				State:           cmapi.RevocationStatePending,
+				Certificate:     certPEM,
+				Message:         "Failed to revoke certificate with serial number " + serialNumber + ", will retry: connection refused",
+				FailedAttempts:  1,
+				LastAttemptTime: &metav1.Time{Time: now},
+			}},
+			statusShouldUpdate: true,
+			expectedEvents:     []string{"Warning RevocationFailed Failed to revoke certificate with serial number " + serialNumber + ", will retry: connection refused"},
+			shouldSchedule:     true,
+		},
+		"revoke the current certificate with the reason requested by the revoke annotation": {
+			cert:   gen.CertificateFrom(baseCert, withRevokeAnnotation("KeyCompromise")),
+			secret: secret,
+			revoke: func(_ context.Context, _ *x509.Certificate, reason cmapi.CertificateRevocationReason) error {
+				if reason != cmapi.RevocationReasonKeyCompromise {
+					t.Errorf("unexpected reason %q", reason)
+				}
+				return nil
+			},
+			expectedRevocations: []cmapi.CertificateRevocation{{
+				SerialNumber:    serialNumber,
+				IssuerRef:       pending.IssuerRef,
+				Reason:          cmapi.RevocationReasonKeyCompromise,
+				State:           cmapi.RevocationStateRevoked,
+				Message:         "Certificate with serial number " + serialNumber + " has been revoked",
+				LastAttemptTime: &metav1.Time{Time: now},
+				RevocationTime:  &metav1.Time{Time: now},
+			}},
+			statusShouldUpdate: true,
+			certShouldUpdate:   true,
+			expectedEvents:     []string{"Normal Revoked Certificate with serial number " + serialNumber + " has been revoked"},
+		},
+		"use the Unspecified reason if the revoke annotation is empty": {
+			cert:   gen.CertificateFrom(baseCert, withRevokeAnnotation("")),
+			secret: secret,
+			revoke: func(context.Context, *x509.Certificate, cmapi.CertificateRevocationReason) error {
+				return errRevoke
+			},
+			expectedRevocations: []cmapi.CertificateRevocation{{
+				SerialNumber:    serialNumber,
+				IssuerRef:       pending.IssuerRef,
+				Reason:          cmapi.RevocationReasonUnspecified,
+				State:           cmapi.RevocationStatePending,
+				Certificate:     certPEM,
+				Message:         "Failed to revoke certificate with serial number " + serialNumber + ", will retry: connection refused",
+				FailedAttempts:  1,
+				LastAttemptTime: &metav1.Time{Time: now},
+			}},
+			statusShouldUpdate: true,
+			certShouldUpdate:   true,
+			expectedEvents:     []string{"Warning RevocationFailed Failed to revoke certificate with serial number " + serialNumber + ", will retry: connection refused"},
+			shouldSchedule:     true,
+		},
+		"remove the revoke annotation if there is no certificate to revoke": {
+			cert:             gen.CertificateFrom(baseCert, withRevokeAnnotation("KeyCompromise")),
+			certShouldUpdate: true,
+			expectedEvents:   []string{"Warning RevocationFailed Ignoring the cert-manager.io/revoke annotation as the Secret \"test-secret\" does not contain a certificate issued by cert-manager"},
+		},
+		"remove the finalizer if certificate revocation is disabled": {
+			cert: gen.CertificateFrom(baseCert,
+				withPolicy(cmapi.CertificateRevocationPolicy{OnDeletion: true}),
+				withFinalizer,
+				deleting,
+			),
+			secret:             secret,
+			revocationDisabled: true,
+			expectedFinalizers: []string{},
+			certShouldUpdate:   true,
+		},
+		"ignore the revoke annotation if certificate revocation is disabled": {
+			cert:               gen.CertificateFrom(baseCert, withRevokeAnnotation("KeyCompromise")),
+			secret:             secret,
+			revocationDisabled: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:              t,
+				Clock:          fakeclock.NewFakeClock(now),
+				ExpectedEvents: test.expectedEvents,
+			}
+			if test.cert != nil {
+				builder.CertManagerObjects = append(builder.CertManagerObjects, test.cert)
+			}
+			if test.secret != nil {
+				builder.KubeObjects = append(builder.KubeObjects, test.secret)
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+
+			w.controller.revocationEnabled = !test.revocationDisabled
+			w.controller.helper = &issuerfake.Helper{
+				GetGenericIssuerFunc: func(ref cmmeta.ObjectReference, ns string) (cmapi.GenericIssuer, error) {
+					return gen.Issuer(ref.Name, gen.SetIssuerNamespace(ns)), nil
+				},
+			}
+			w.controller.issuerFactory = &issuerfake.Factory{
+				IssuerForFunc: func(cmapi.GenericIssuer) (issuer.Interface, error) {
+					return &issuerfake.Issuer{RevokeFunc: test.revoke}, nil
+				},
+			}
+			gotScheduled := false
+			w.controller.scheduledWorkQueue = &schedulertest.FakeScheduler{
+				AddFunc: func(types.NamespacedName, time.Duration) {
+					gotScheduled = true
+				},
+			}
+
+			if test.statusShouldUpdate {
+				c := test.cert.DeepCopy()
+				c.Status.Revocations = test.expectedRevocations
+				builder.ExpectedActions = append(builder.ExpectedActions,
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						c.Namespace,
+						c)))
+			}
+			if test.certShouldUpdate {
+				c := test.cert.DeepCopy()
+				c.Status.Revocations = test.expectedRevocations
+				c.Finalizers = test.expectedFinalizers
+				if !test.revocationDisabled {
+					delete(c.Annotations, cmapi.RevokeCertificateAnnotationKey)
+				}
+				builder.ExpectedActions = append(builder.ExpectedActions,
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						c.Namespace,
+						c)))
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			if err := w.controller.ProcessItem(context.Background(), types.NamespacedName{Namespace: "testns", Name: "test"}); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if gotScheduled != test.shouldSchedule {
+				t.Errorf("expected Certificate to be re-queued: %v, got re-queued: %v", test.shouldSchedule, gotScheduled)
+			}
+
+			if err := builder.AllActionsExecuted(); err != nil {
+				builder.T.Error(err)
+			}
+			if err := builder.AllEventsCalled(); err != nil {
+				builder.T.Error(err)
+			}
+		})
+	}
+}
+
+// TestUpdateOrApplyStatusServerSideApply checks that the resource version of
+// the applied status is kept, so that the finalizers and annotations can be
+// updated afterwards without a conflict.
+func TestUpdateOrApplyStatusServerSideApply(t *testing.T) {
+	featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, feature.ServerSideApply, true)
+
+	crt := gen.Certificate("test", gen.SetCertificateNamespace("testns"))
+	crt.ResourceVersion = "1"
+
+	client := cmfake.NewSimpleClientset()
+	client.PrependReactor("patch", "certificates", func(action coretesting.Action) (bool, runtime.Object, error) {
+		applied := crt.DeepCopy()
+		applied.ResourceVersion = "2"
+		return true, applied, nil
+	})
+
+	c := &controller{client: client, fieldManager: "cert-manager-test"}
+	if err := c.updateOrApplyStatus(context.Background(), crt); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if crt.ResourceVersion != "2" {
+		t.Errorf("expected the resource version of the applied status to be kept, got %q", crt.ResourceVersion)
+	}
+}
+
+func TestRetryInterval(t *testing.T) {
+	tests := map[int]time.Duration{
+		0:  time.Minute,
+		1:  time.Minute,
+		2:  2 * time.Minute,
+		4:  8 * time.Minute,
+		7:  time.Hour,
+		20: time.Hour,
+	}
+	for failedAttempts, expected := range tests {
+		if got := retryInterval(failedAttempts); got != expected {
+			t.Errorf("retryInterval(%d): expected %s, got %s", failedAttempts, expected, got)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// renewalLimiter spreads the renewals of Certificates over time using a token
+// bucket, so that a large number of Certificates becoming due for renewal at
+// the same time does not overload the issuing CA and the Kubernetes API server.
+// A Certificate that cannot be renewed immediately is given a slot in the
+// future at which it may be renewed, which it keeps until it is renewed.
+type renewalLimiter struct {
+	limiter *rate.Limiter
+
+	lock  sync.Mutex
+	slots map[types.NamespacedName]time.Time
+}
+
+// newRenewalLimiter returns a renewalLimiter that allows qps renewals per
+// second on average, with bursts of up to burst renewals. It returns nil if
+// renewals should not be rate limited.
+func newRenewalLimiter(qps float32, burst int) *renewalLimiter {
+	if qps <= 0 {
+		return nil
+	}
+	return &renewalLimiter{
+		limiter: rate.NewLimiter(rate.Limit(qps), burst),
+		slots:   make(map[types.NamespacedName]time.Time),
+	}
+}
+
+// wait returns how long the renewal of the Certificate with the given key has
+// to be delayed. A Certificate must only be renewed if the returned delay is
+// zero.
+func (l *renewalLimiter) wait(key types.NamespacedName, now time.Time) time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if slot, ok := l.slots[key]; ok {
+		if now.Before(slot) {
+			return slot.Sub(now)
+		}
+		delete(l.slots, key)
+		return 0
+	}
+
+	delay := l.limiter.ReserveN(now, 1).DelayFrom(now)
+	if delay > 0 {
+		l.slots[key] = now.Add(delay)
+	}
+	return delay
+}
+
+// forget releases the slot of the Certificate with the given key, if any.
+func (l *renewalLimiter) forget(key types.NamespacedName) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	delete(l.slots, key)
+}
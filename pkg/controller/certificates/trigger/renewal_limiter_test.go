@@ -0,0 +1,53 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func Test_renewalLimiter(t *testing.T) {
+	assert.Nil(t, newRenewalLimiter(0, 10), "renewals should not be rate limited without a rate")
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newRenewalLimiter(1, 2)
+	key := func(name string) types.NamespacedName {
+		return types.NamespacedName{Namespace: "default", Name: name}
+	}
+
+	// The burst allows two renewals immediately.
+	assert.Zero(t, l.wait(key("a"), now))
+	assert.Zero(t, l.wait(key("b"), now))
+
+	// Further renewals are given slots one second apart.
+	assert.Equal(t, time.Second, l.wait(key("c"), now))
+	assert.Equal(t, 2*time.Second, l.wait(key("d"), now))
+
+	// A Certificate keeps its slot when it is processed again.
+	assert.Equal(t, 1500*time.Millisecond, l.wait(key("d"), now.Add(500*time.Millisecond)))
+	assert.Zero(t, l.wait(key("c"), now.Add(time.Second)))
+	assert.Zero(t, l.wait(key("d"), now.Add(2*time.Second)))
+
+	// Forgetting a Certificate releases its slot.
+	assert.Equal(t, time.Second, l.wait(key("e"), now.Add(2*time.Second)))
+	l.forget(key("e"))
+	assert.Empty(t, l.slots)
+}
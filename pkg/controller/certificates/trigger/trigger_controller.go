@@ -44,6 +44,7 @@ import (
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	"github.com/cert-manager/cert-manager/pkg/scheduler"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
@@ -77,6 +78,14 @@ type controller struct {
 	// Apply API calls.
 	fieldManager string
 
+	// renewalLimiter spreads renewals of Certificates over time. It is nil if
+	// renewals are not rate limited.
+	renewalLimiter *renewalLimiter
+
+	// defaultRenewalJitter is used to spread the renewal times of
+	// Certificates that do not set spec.renewalJitter
+	defaultRenewalJitter time.Duration
+
 	// The following are used for testing purposes.
 	clock              clock.Clock
 	shouldReissue      policies.Func
@@ -100,6 +109,7 @@ func NewController(
 	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
 	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
 	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
+	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
 
 	if _, err := certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue}); err != nil {
 		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
@@ -120,12 +130,34 @@ func NewController(
 		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
 	}
 
+	// When an Issuer or ClusterIssuer changes, enqueue the Certificate resources
+	// that reference it, as the issuer may define their renewal window and ACME profile.
+	enqueueCertificatesForIssuer := &controllerpkg.BlockingEventHandler{
+		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(), predicate.CertificateIssuer),
+	}
+	if _, err := issuerInformer.Informer().AddEventHandler(enqueueCertificatesForIssuer); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+
 	// build a list of InformerSynced functions that will be returned by the Register method.
 	// the controller will only begin processing items once all of these informers have synced.
 	mustSync := []cache.InformerSynced{
 		certificateRequestInformer.Informer().HasSynced,
 		secretsInformer.Informer().HasSynced,
 		certificateInformer.Informer().HasSynced,
+		issuerInformer.Informer().HasSynced,
+	}
+
+	// If we are running in non-namespaced mode, we also need a lister for
+	// ClusterIssuers.
+	var clusterIssuerLister cmlisters.ClusterIssuerLister
+	if ctx.Namespace == "" {
+		clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+		if _, err := clusterIssuerInformer.Informer().AddEventHandler(enqueueCertificatesForIssuer); err != nil {
+			return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+		}
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+		clusterIssuerLister = clusterIssuerInformer.Lister()
 	}
 
 	return &controller{
@@ -136,6 +168,8 @@ func NewController(
 		recorder:                 ctx.Recorder,
 		scheduledWorkQueue:       scheduler.NewScheduledWorkQueue(ctx.Clock, queue.Add),
 		fieldManager:             ctx.FieldManager,
+		renewalLimiter:           newRenewalLimiter(ctx.CertificateOptions.RenewalQPS, ctx.CertificateOptions.RenewalBurst),
+		defaultRenewalJitter:     ctx.CertificateOptions.RenewalJitter,
 
 		// The following are used for testing purposes.
 		clock:         ctx.Clock,
@@ -143,6 +177,7 @@ func NewController(
 		dataForCertificate: (&policies.Gatherer{
 			CertificateRequestLister: certificateRequestInformer.Lister(),
 			SecretLister:             secretsInformer.Lister(),
+			IssuerHelper:             issuer.NewHelper(issuerInformer.Lister(), clusterIssuerLister),
 		}).DataForCertificate,
 	}, queue, mustSync, nil
 }
@@ -155,6 +190,9 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	crt, err := c.certificateLister.Certificates(namespace).Get(name)
 	if apierrors.IsNotFound(err) {
 		log.V(logf.DebugLevel).Info("certificate not found for key", "error", err.Error())
+		if c.renewalLimiter != nil {
+			c.renewalLimiter.forget(key)
+		}
 		return nil
 	}
 	if err != nil {
@@ -195,7 +233,7 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	}
 
 	// Don't trigger issuance if we need to back off due to previous failures and Certificate's spec has not changed.
-	backoff, delay := shouldBackoffReissuingOnFailure(log, c.clock, input.Certificate, input.NextRevisionRequest)
+	backoff, delay := shouldBackoffReissuingOnFailure(log, c.clock, input.Certificate, input.Issuer, input.NextRevisionRequest)
 	if backoff {
 		nextIssuanceRetry := c.clock.Now().Add(delay)
 		message := fmt.Sprintf("Backing off from issuance due to previously failed issuance(s). Issuance will next be attempted at %v", nextIssuanceRetry)
@@ -204,18 +242,40 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 		return nil
 	}
 
-	if crt.Status.RenewalTime != nil {
-		// ensure a resync is scheduled in the future so that we re-check
-		// Certificate resources and trigger them near expiry time
-		c.scheduleRecheckOfCertificateIfRequired(log, key, crt.Status.RenewalTime.Time.Sub(c.clock.Now()))
+	// ensure a resync is scheduled in the future so that we re-check
+	// Certificate resources and trigger them near expiry time, or once their
+	// private key is older than the rotation max age
+	recheckTime := earliestFutureTime(c.clock.Now(), crt.Status.RenewalTime, internalcertificates.PrivateKeyRotationTime(crt, input.Secret))
+	if recheckTime != nil {
+		c.scheduleRecheckOfCertificateIfRequired(log, key, recheckTime.Time.Sub(c.clock.Now()))
 	}
 
 	reason, message, reissue := c.shouldReissue(input)
 	if !reissue {
+		// The renewal time on the Certificate's status was computed when
+		// the Certificate was last reconciled. If it has since passed
+		// without a maintenance window being open, re-check the
+		// Certificate at the start of the next maintenance window.
+		if crt.Status.RenewalTime == nil || !crt.Status.RenewalTime.Time.After(c.clock.Now()) {
+			if renewalTime := c.windowedRenewalTime(log, input); renewalTime != nil && (recheckTime == nil || renewalTime.Before(recheckTime)) {
+				c.scheduleRecheckOfCertificateIfRequired(log, key, renewalTime.Time.Sub(c.clock.Now()))
+			}
+		}
 		// no re-issuance required, return early
 		return nil
 	}
 
+	// Spread renewals of certificates that are due for renewal over time.
+	// Other re-issuances, e.g. because the Certificate's spec changed or the
+	// Secret is missing, are never delayed.
+	if c.renewalLimiter != nil && (reason == policies.Renewing || reason == policies.RenewalInfoSuggestsRenewal) {
+		if delay := c.renewalLimiter.wait(key, c.clock.Now()); delay > 0 {
+			log.V(logf.InfoLevel).Info("Delaying renewal of certificate to stay within the renewal rate limit", "delay", delay.String())
+			c.scheduleRecheckOfCertificateIfRequired(log, key, delay)
+			return nil
+		}
+	}
+
 	// Although the below recorder.Event already logs the event, the log
 	// line is quite unreadable (very long). Since this information is very
 	// important for the user and the operator, we log the following
@@ -232,6 +292,41 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	return nil
 }
 
+// earliestFutureTime returns the earliest of the given times which is not
+// before now, or nil if there is none.
+func earliestFutureTime(now time.Time, times ...*metav1.Time) *metav1.Time {
+	var earliest *metav1.Time
+	for _, t := range times {
+		if t == nil || t.Time.Before(now) {
+			continue
+		}
+		if earliest == nil || t.Before(earliest) {
+			earliest = t
+		}
+	}
+	return earliest
+}
+
+// windowedRenewalTime returns the renewal time of the certificate in the
+// Secret, moved into the Certificate's renewal window. It returns nil if the
+// Certificate has no renewal window, or the certificate cannot be decoded.
+func (c *controller) windowedRenewalTime(log logr.Logger, input policies.Input) *metav1.Time {
+	if input.RenewalWindow == nil || input.Secret == nil {
+		return nil
+	}
+	x509cert, err := pki.DecodeX509CertificateBytes(input.Secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return nil
+	}
+
+	renewalTime, _, err := internalcertificates.RenewalTime(input.Certificate, x509cert, input.RenewalWindow, c.defaultRenewalJitter, c.clock.Now(), pki.RenewalTime)
+	if err != nil {
+		log.Error(err, "ignoring invalid renewal window")
+		return nil
+	}
+	return renewalTime
+}
+
 // updateOrApplyStatus will update the controller status. If the
 // ServerSideApply feature is enabled, the managed fields will instead get
 // applied using the relevant Patch API call.
@@ -241,10 +336,11 @@ func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certifi
 		if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing); cond != nil {
 			conditions = []cmapi.CertificateCondition{*cond}
 		}
-		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
+		_, err := internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
 			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
 			Status:     cmapi.CertificateStatus{Conditions: conditions},
 		})
+		return err
 	} else {
 		_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
 		return err
@@ -263,7 +359,7 @@ func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certifi
 //
 // Note that the request can be left nil: in that case, the returned back-off
 // will be 0 since it means the CR must be created immediately.
-func shouldBackoffReissuingOnFailure(log logr.Logger, c clock.Clock, crt *cmapi.Certificate, nextCR *cmapi.CertificateRequest) (bool, time.Duration) {
+func shouldBackoffReissuingOnFailure(log logr.Logger, c clock.Clock, crt *cmapi.Certificate, iss cmapi.GenericIssuer, nextCR *cmapi.CertificateRequest) (bool, time.Duration) {
 	if crt.Status.LastFailureTime == nil {
 		return false, 0
 	}
@@ -286,6 +382,7 @@ func shouldBackoffReissuingOnFailure(log logr.Logger, c clock.Clock, crt *cmapi.
 			log.V(logf.InfoLevel).Info("next CertificateRequest cannot be decoded, skipping checking if Certificate matches the CertificateRequest")
 			return false, 0
 		}
+		mismatches = append(mismatches, internalcertificates.ACMEProfileViolations(nextCR, crt.Spec, iss)...)
 		if len(mismatches) > 0 {
 			log.V(logf.ExtendedInfoLevel).WithValues("mismatches", mismatches).Info("Certificate is failing but the Certificate differs from CertificateRequest, backoff is not required")
 			return false, 0
@@ -363,7 +460,7 @@ func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.Type
 
 	ctrl, queue, mustSync, err := NewController(log,
 		ctx,
-		policies.NewTriggerPolicyChain(ctx.Clock).Evaluate,
+		policies.NewTriggerPolicyChain(ctx.Clock, ctx.CertificateOptions.RenewalJitter).Evaluate,
 	)
 	c.controller = ctrl
 
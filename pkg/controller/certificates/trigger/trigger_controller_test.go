@@ -818,10 +818,52 @@ func Test_shouldBackoffReissuingOnFailure(t *testing.T) {
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			gotBackoff, gotDelay := shouldBackoffReissuingOnFailure(logtesting.NewTestLogger(t), clock, test.givenCert, test.givenNextCR)
+			gotBackoff, gotDelay := shouldBackoffReissuingOnFailure(logtesting.NewTestLogger(t), clock, test.givenCert, nil, test.givenNextCR)
 			assert.Equal(t, test.wantBackoff, gotBackoff)
 			assert.Equal(t, test.wantDelay, gotDelay)
 		})
 
 	}
 }
+
+func Test_controller_windowedRenewalTime(t *testing.T) {
+	// 2024-03-04 is a Monday.
+	now := time.Date(2024, 3, 4, 12, 0, 0, 0, time.UTC)
+	c := &controller{clock: fakeclock.NewFakeClock(now)}
+
+	crt := gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateCommonName("example.com"),
+		gen.SetCertificateRenewBefore(&metav1.Duration{Duration: 31 * 24 * time.Hour}),
+	)
+	pk := testcrypto.MustCreatePEMPrivateKey(t)
+	secret := gen.Secret("secret-1", gen.SetSecretNamespace("testns"), gen.SetSecretData(map[string][]byte{
+		corev1.TLSPrivateKeyKey: pk,
+		// Due for renewal since a day ago.
+		corev1.TLSCertKey: testcrypto.MustCreateCertWithNotBeforeAfter(t, pk, crt, now.Add(-60*24*time.Hour), now.Add(30*24*time.Hour)),
+	}))
+	window := &cmapi.CertificateRenewalWindow{
+		Schedule: "0 2 * * sat",
+		Duration: metav1.Duration{Duration: 4 * time.Hour},
+	}
+
+	tests := map[string]struct {
+		input policies.Input
+		want  *metav1.Time
+	}{
+		"no renewal window": {
+			input: policies.Input{Certificate: crt, Secret: secret},
+		},
+		"no secret": {
+			input: policies.Input{Certificate: crt, RenewalWindow: window},
+		},
+		"renewal is moved to the next maintenance window": {
+			input: policies.Input{Certificate: crt, Secret: secret, RenewalWindow: window},
+			want:  &metav1.Time{Time: time.Date(2024, 3, 9, 2, 0, 0, 0, time.UTC)},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.want, c.windowedRenewalTime(logtesting.NewTestLogger(t), test.input))
+		})
+	}
+}
@@ -18,6 +18,7 @@ package clusterissuers
 
 import (
 	"context"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
@@ -28,14 +29,17 @@ import (
 	internalissuers "github.com/cert-manager/cert-manager/internal/controller/issuers"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller/globals"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 )
 
 const (
-	errorInitIssuer = "ErrInitIssuer"
+	errorInitIssuer     = "ErrInitIssuer"
+	errorFinalizeIssuer = "ErrFinalizeIssuer"
 
-	messageErrorInitIssuer = "Error initializing issuer: "
+	messageErrorInitIssuer     = "Error initializing issuer: "
+	messageErrorFinalizeIssuer = "Error cleaning up issuer: "
 )
 
 func (c *controller) Sync(ctx context.Context, iss *cmapi.ClusterIssuer) (err error) {
@@ -56,6 +60,17 @@ func (c *controller) Sync(ctx context.Context, iss *cmapi.ClusterIssuer) (err er
 		return err
 	}
 
+	if issuerCopy.DeletionTimestamp != nil {
+		if slices.Contains(issuerCopy.Finalizers, cmapi.IssuerCleanupFinalizer) {
+			return c.finalize(ctx, issuerCopy, i)
+		}
+	} else {
+		f, ok := i.(issuer.Finalizer)
+		if err := c.updateFinalizer(ctx, issuerCopy, ok && f.NeedsFinalizer()); err != nil {
+			return err
+		}
+	}
+
 	err = i.Setup(ctx)
 	if err != nil {
 		s := messageErrorInitIssuer + err.Error()
@@ -78,3 +93,42 @@ func (c *controller) updateIssuerStatus(ctx context.Context, oldIssuer, newIssue
 		return err
 	}
 }
+
+// finalize cleans up the external state of the ClusterIssuer and removes the
+// IssuerCleanupFinalizer once done.
+func (c *controller) finalize(ctx context.Context, iss *cmapi.ClusterIssuer, i issuer.Interface) error {
+	if f, ok := i.(issuer.Finalizer); ok && f.NeedsFinalizer() {
+		if err := f.Finalize(ctx); err != nil {
+			s := messageErrorFinalizeIssuer + err.Error()
+			logf.FromContext(ctx).Error(err, "error cleaning up issuer")
+			c.recorder.Event(iss, corev1.EventTypeWarning, errorFinalizeIssuer, s)
+			return err
+		}
+	}
+
+	return c.updateFinalizer(ctx, iss, false)
+}
+
+// updateFinalizer adds or removes the IssuerCleanupFinalizer from the
+// ClusterIssuer.
+func (c *controller) updateFinalizer(ctx context.Context, iss *cmapi.ClusterIssuer, present bool) error {
+	if slices.Contains(iss.Finalizers, cmapi.IssuerCleanupFinalizer) == present {
+		return nil
+	}
+
+	if present {
+		iss.Finalizers = append(iss.Finalizers, cmapi.IssuerCleanupFinalizer)
+	} else {
+		iss.Finalizers = slices.DeleteFunc(iss.Finalizers, func(f string) bool {
+			return f == cmapi.IssuerCleanupFinalizer
+		})
+	}
+
+	updated, err := c.cmClient.CertmanagerV1().ClusterIssuers().Update(ctx, iss, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+	// keep the resource version up to date for the status update
+	iss.ResourceVersion = updated.ResourceVersion
+	return nil
+}
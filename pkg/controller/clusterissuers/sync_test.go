@@ -18,6 +18,7 @@ package clusterissuers
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"runtime/debug"
 	"testing"
@@ -29,6 +30,8 @@ import (
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	issuerfake "github.com/cert-manager/cert-manager/pkg/issuer/fake"
 )
 
 func newFakeIssuerWithStatus(name string, status v1.IssuerStatus) *v1.ClusterIssuer {
@@ -44,6 +47,121 @@ func TestSync(t *testing.T) {
 
 }
 
+func TestSyncFinalizer(t *testing.T) {
+	now := metav1.Now()
+
+	tests := map[string]struct {
+		finalizers     []string
+		deleting       bool
+		needsFinalizer bool
+		finalizeErr    error
+
+		expectFinalizers []string
+		expectFinalize   bool
+		expectSetup      bool
+		expectErr        bool
+	}{
+		"add the finalizer if the issuer needs it": {
+			finalizers:       []string{"other"},
+			needsFinalizer:   true,
+			expectFinalizers: []string{"other", v1.IssuerCleanupFinalizer},
+			expectSetup:      true,
+		},
+		"remove the finalizer if the issuer no longer needs it": {
+			finalizers:       []string{"other", v1.IssuerCleanupFinalizer},
+			expectFinalizers: []string{"other"},
+			expectSetup:      true,
+		},
+		"do nothing if the finalizer is already present": {
+			finalizers:     []string{"other", v1.IssuerCleanupFinalizer},
+			needsFinalizer: true,
+			expectSetup:    true,
+		},
+		"finalize and remove the finalizer on deletion": {
+			finalizers:       []string{"other", v1.IssuerCleanupFinalizer},
+			deleting:         true,
+			needsFinalizer:   true,
+			expectFinalize:   true,
+			expectFinalizers: []string{"other"},
+		},
+		"keep the finalizer if finalizing fails": {
+			finalizers:     []string{"other", v1.IssuerCleanupFinalizer},
+			deleting:       true,
+			needsFinalizer: true,
+			finalizeErr:    errors.New("some error"),
+			expectFinalize: true,
+			expectErr:      true,
+		},
+		"remove the finalizer on deletion if the issuer no longer needs it": {
+			finalizers:       []string{"other", v1.IssuerCleanupFinalizer},
+			deleting:         true,
+			expectFinalizers: []string{"other"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			iss := newFakeIssuerWithStatus("test", v1.IssuerStatus{})
+			iss.Finalizers = test.finalizers
+			if test.deleting {
+				iss.DeletionTimestamp = &now
+			}
+
+			b := &testpkg.Builder{
+				T:                  t,
+				CertManagerObjects: []runtime.Object{iss},
+			}
+			b.Init()
+			defer b.Stop()
+
+			c := &controller{}
+			if _, _, err := c.Register(b.Context); err != nil {
+				t.Fatalf("failed to register context against controller: %v", err)
+			}
+
+			setupCalled, finalizeCalled := false, false
+			c.issuerFactory = &issuerfake.Factory{
+				IssuerForFunc: func(v1.GenericIssuer) (issuer.Interface, error) {
+					return &issuerfake.Issuer{
+						SetupFunc: func(context.Context) error {
+							setupCalled = true
+							return nil
+						},
+						NeedsFinalizerFunc: func() bool {
+							return test.needsFinalizer
+						},
+						FinalizeFunc: func(context.Context) error {
+							finalizeCalled = true
+							return test.finalizeErr
+						},
+					}, nil
+				},
+			}
+
+			b.Start()
+
+			err := c.Sync(context.TODO(), iss)
+			if (err != nil) != test.expectErr {
+				t.Fatalf("expected error: %v, got: %v", test.expectErr, err)
+			}
+			if setupCalled != test.expectSetup {
+				t.Errorf("expected Setup to be called: %v, was called: %v", test.expectSetup, setupCalled)
+			}
+			if finalizeCalled != test.expectFinalize {
+				t.Errorf("expected Finalize to be called: %v, was called: %v", test.expectFinalize, finalizeCalled)
+			}
+
+			var updatedFinalizers []string
+			for _, action := range filter(b.FakeCMClient().Actions()) {
+				if action.GetVerb() != "update" || action.GetSubresource() != "" {
+					continue
+				}
+				updatedFinalizers = assertIsClusterIssuer(t, errorf, assertIsUpdateAction(t, errorf, action).GetObject()).Finalizers
+			}
+			assertDeepEqual(t, errorf, test.expectFinalizers, updatedFinalizers)
+		})
+	}
+}
+
 func TestUpdateIssuerStatus(t *testing.T) {
 	b := &testpkg.Builder{
 		T: t,
@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/kubernetes"
@@ -197,6 +198,13 @@ type ACMEOptions struct {
 	// for ACME HTTP01 validations.
 	HTTP01SolverNameservers []string
 
+	// HTTP01SharedSolverService is the Service of a shared, long-running
+	// acmesolver Deployment used to solve ACME HTTP01 challenges. If the name
+	// is empty, a dedicated solver pod and service is created for each
+	// challenge. Challenges solved using a Gateway API HTTPRoute, or by editing
+	// an existing Ingress, always use a dedicated solver pod and service.
+	HTTP01SharedSolverService types.NamespacedName
+
 	// DNS01CheckAuthoritative is a flag for controlling if auth nss are used
 	// for checking propagation of an RR. This is the ideal scenario
 	DNS01CheckAuthoritative bool
@@ -205,6 +213,11 @@ type ACMEOptions struct {
 	// for ACME DNS01 validations.
 	DNS01Nameservers []string
 
+	// DNS01EmbeddedServerZones are the zones the embedded DNS server is
+	// authoritative for. Challenges solved by the embedded DNS01 provider
+	// must have their record within one of them.
+	DNS01EmbeddedServerZones []string
+
 	// AccountRegistry is used as a cache of ACME accounts between various
 	// components of cert-manager
 	AccountRegistry accounts.Registry
@@ -230,12 +243,34 @@ type CertificateOptions struct {
 	// CopiedAnnotationPrefixes defines which annotations should be copied
 	// Certificate -> CertificateRequest, CertificateRequest -> Order.
 	CopiedAnnotationPrefixes []string
+	// RenewalJitter is the default window within which the renewals of
+	// Certificates that do not set spec.renewalJitter are spread.
+	RenewalJitter time.Duration
+	// RenewalQPS is the maximum average number of Certificate renewals per
+	// second triggered by the controller. If zero, renewals are not rate
+	// limited.
+	RenewalQPS float32
+	// RenewalBurst is the maximum number of Certificate renewals triggered at
+	// once when renewals are rate limited.
+	RenewalBurst int
+	// HistoryLimit is the maximum number of issued certificates recorded in
+	// the status.history of each Certificate. If zero, no issuances are
+	// recorded.
+	HistoryLimit int
 }
 
 type SchedulerOptions struct {
 	// MaxConcurrentChallenges determines the maximum number of challenges that can be
 	// scheduled as 'processing' at once.
 	MaxConcurrentChallenges int
+	// MaxConcurrentChallengesPerNamespace determines the maximum number of
+	// challenges in a single namespace that can be scheduled as 'processing'
+	// at once. If zero, only MaxConcurrentChallenges applies.
+	MaxConcurrentChallengesPerNamespace int
+	// ChallengeNamespaceWeights determines the share of the challenge slots
+	// that each namespace gets when they are shared between namespaces.
+	// Namespaces that are not listed have a weight of 1.
+	ChallengeNamespaceWeights map[string]int64
 }
 
 // ContextFactory is used for constructing new Contexts who's clients have been
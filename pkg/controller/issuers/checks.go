@@ -18,10 +18,12 @@ package issuers
 
 import (
 	"fmt"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 )
 
@@ -51,6 +53,19 @@ func (c *controller) issuersForSecret(secret *corev1.Secret) ([]*v1.Issuer, erro
 					continue
 				}
 			}
+			if iss.Spec.ACME.NextPrivateKey != nil {
+				if iss.Spec.ACME.NextPrivateKey.Name == secret.Name {
+					affected = append(affected, iss)
+					continue
+				}
+			}
+			if slices.ContainsFunc(iss.Spec.ACME.FallbackServers, func(fs cmacme.ACMEFallbackServer) bool {
+				return fs.PrivateKey.Name == secret.Name ||
+					(fs.ExternalAccountBinding != nil && fs.ExternalAccountBinding.Key.Name == secret.Name)
+			}) {
+				affected = append(affected, iss)
+				continue
+			}
 		case iss.Spec.CA != nil:
 			if iss.Spec.CA.SecretName == secret.Name {
 				affected = append(affected, iss)
@@ -23,11 +23,13 @@ import (
 
 	core "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
 	"github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/issuer"
 	"github.com/cert-manager/cert-manager/pkg/metrics"
@@ -41,6 +43,7 @@ type Acme struct {
 	issuer v1.GenericIssuer
 
 	secretsClient core.SecretsGetter
+	cmClient      cmclient.Interface
 	recorder      record.EventRecorder
 
 	// keyFromSecret returns a decoded account key from a Kubernetes secret.
@@ -60,6 +63,8 @@ type Acme struct {
 
 	// userAgent is the string used as the UserAgent when making HTTP calls.
 	userAgent string
+
+	clock clock.Clock
 }
 
 // New returns a new ACME issuer interface for the given issuer.
@@ -75,11 +80,13 @@ func New(ctx *controller.Context, issuer v1.GenericIssuer) (issuer.Interface, er
 		keyFromSecret:            newKeyFromSecret(secretsLister),
 		clientBuilder:            accounts.NewClient,
 		secretsClient:            ctx.Client.CoreV1(),
+		cmClient:                 ctx.CMClient,
 		recorder:                 ctx.Recorder,
 		clusterResourceNamespace: ctx.IssuerOptions.ClusterResourceNamespace,
 		accountRegistry:          ctx.ACMEOptions.AccountRegistry,
 		metrics:                  ctx.Metrics,
 		userAgent:                ctx.RESTConfig.UserAgent,
+		clock:                    ctx.Clock,
 	}
 
 	return a, nil
@@ -0,0 +1,236 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cpu/goacmedns"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const reasonAcmeDNSRegistered = "AcmeDNSRegistered"
+
+// ensureAcmeDNSAccount returns the acme-dns account used to solve the given
+// challenge. If the account Secret has no credentials for the challenge's
+// domain, a new account is registered with the acme-dns server and stored in
+// the Secret, which is created if it does not exist yet.
+// The Secret may be shared by the challenges of several domains, so the new
+// account is merged into the latest version of the Secret. If another
+// challenge has stored an account for the same domain in the meantime, that
+// account is used instead, as its CNAME record may already have been created.
+// The account is also recorded in the status of the issuer.
+func (s *Solver) ensureAcmeDNSAccount(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge, config *cmacme.ACMEIssuerDNS01ProviderAcmeDNS) (goacmedns.Account, error) {
+	log := logf.FromContext(ctx, "ensureAcmeDNSAccount")
+	domain := ch.Spec.DNSName
+
+	_, accounts, err := s.getAcmeDNSAccounts(ctx, s.ResourceNamespace(issuer), config)
+	if err != nil {
+		return goacmedns.Account{}, err
+	}
+
+	account, ok := accounts[domain]
+	if !ok {
+		registered, err := s.dnsProviderConstructors.acmeDNSRegister(config.Host)
+		if err != nil {
+			return goacmedns.Account{}, fmt.Errorf("error registering acme-dns account for domain %s: %w", domain, err)
+		}
+		log.V(logf.InfoLevel).Info("registered acme-dns account", "host", config.Host, "fulldomain", registered.FullDomain)
+
+		stored := false
+		err = retry.OnError(retry.DefaultRetry, isAcmeDNSAccountsConflict, func() error {
+			secret, accounts, err := s.getAcmeDNSAccounts(ctx, s.ResourceNamespace(issuer), config)
+			if err != nil {
+				return err
+			}
+			if existing, ok := accounts[domain]; ok {
+				account, stored = existing, false
+				return nil
+			}
+			accounts[domain] = registered
+			account, stored = registered, true
+			return s.storeAcmeDNSAccounts(ctx, issuer, secret, config, accounts)
+		})
+		if err != nil {
+			return goacmedns.Account{}, err
+		}
+
+		if !stored {
+			log.V(logf.InfoLevel).Info("using the acme-dns account stored for the domain by another challenge", "fulldomain", account.FullDomain)
+		} else {
+			fqdn, err := s.challengeFQDN(ctx, ch, false)
+			if err != nil {
+				return goacmedns.Account{}, err
+			}
+			s.Recorder.Eventf(ch, corev1.EventTypeNormal, reasonAcmeDNSRegistered, "Registered an acme-dns account, create a CNAME record %q pointing to %q to solve the challenge", fqdn, account.FullDomain)
+		}
+	}
+
+	if err := s.recordAcmeDNSRegistration(ctx, issuer, domain, account.FullDomain); err != nil {
+		return goacmedns.Account{}, fmt.Errorf("error recording acme-dns registration in the issuer status: %w", err)
+	}
+
+	return account, nil
+}
+
+// getAcmeDNSAccounts returns the acme-dns account Secret referenced by the
+// given config, and the accounts stored in it. The Secret is nil if it does
+// not exist yet.
+// The Secret is read from the API server rather than the informer cache, so
+// that an account registered for another challenge moments ago is not missed.
+func (s *Solver) getAcmeDNSAccounts(ctx context.Context, namespace string, config *cmacme.ACMEIssuerDNS01ProviderAcmeDNS) (*corev1.Secret, map[string]goacmedns.Account, error) {
+	accounts := map[string]goacmedns.Account{}
+
+	secret, err := s.Client.CoreV1().Secrets(namespace).Get(ctx, config.AccountSecret.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, accounts, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting acmedns accounts secret: %s", err)
+	}
+
+	if data, ok := secret.Data[config.AccountSecret.Key]; ok {
+		if err := json.Unmarshal(data, &accounts); err != nil {
+			return nil, nil, fmt.Errorf("error unmarshalling acmedns accounts secret: %s", err)
+		}
+	}
+
+	return secret, accounts, nil
+}
+
+// isAcmeDNSAccountsConflict returns true if storing the acme-dns accounts
+// failed because the Secret was created or modified concurrently.
+func isAcmeDNSAccountsConflict(err error) bool {
+	return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+}
+
+// storeAcmeDNSAccounts writes the given accounts to the acme-dns account
+// Secret. If the Secret does not exist yet it is created and owned by the
+// issuer.
+func (s *Solver) storeAcmeDNSAccounts(ctx context.Context, issuer v1.GenericIssuer, secret *corev1.Secret, config *cmacme.ACMEIssuerDNS01ProviderAcmeDNS, accounts map[string]goacmedns.Account) error {
+	data, err := json.Marshal(accounts)
+	if err != nil {
+		return fmt.Errorf("error marshalling acmedns accounts: %s", err)
+	}
+
+	if secret == nil {
+		kind := v1.IssuerKind
+		if issuer.GetObjectMeta().Namespace == "" {
+			kind = v1.ClusterIssuerKind
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      config.AccountSecret.Name,
+				Namespace: s.ResourceNamespace(issuer),
+				Labels: map[string]string{
+					v1.PartOfCertManagerControllerLabelKey: "true",
+				},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: v1.SchemeGroupVersion.String(),
+					Kind:       kind,
+					Name:       issuer.GetObjectMeta().Name,
+					UID:        issuer.GetObjectMeta().UID,
+				}},
+			},
+			Data: map[string][]byte{config.AccountSecret.Key: data},
+		}
+		_, err := s.Client.CoreV1().Secrets(secret.Namespace).Create(ctx, secret, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("error creating acmedns accounts secret: %w", err)
+		}
+		return nil
+	}
+
+	secret = secret.DeepCopy()
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[config.AccountSecret.Key] = data
+	if _, err := s.Client.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("error updating acmedns accounts secret: %w", err)
+	}
+	return nil
+}
+
+// recordAcmeDNSRegistration adds the acme-dns account registered for the
+// given domain to the status of the issuer, if it is not recorded yet.
+func (s *Solver) recordAcmeDNSRegistration(ctx context.Context, issuer v1.GenericIssuer, domain, fullDomain string) error {
+	// check a copy of the status as the issuer is shared with the informer cache
+	if !setAcmeDNSRegistration(issuer.GetStatus().DeepCopy(), domain, fullDomain) {
+		return nil
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		switch iss := issuer.(type) {
+		case *v1.Issuer:
+			latest, err := s.CMClient.CertmanagerV1().Issuers(iss.Namespace).Get(ctx, iss.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			if !setAcmeDNSRegistration(&latest.Status, domain, fullDomain) {
+				return nil
+			}
+			_, err = s.CMClient.CertmanagerV1().Issuers(iss.Namespace).UpdateStatus(ctx, latest, metav1.UpdateOptions{})
+			return err
+		case *v1.ClusterIssuer:
+			latest, err := s.CMClient.CertmanagerV1().ClusterIssuers().Get(ctx, iss.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			if !setAcmeDNSRegistration(&latest.Status, domain, fullDomain) {
+				return nil
+			}
+			_, err = s.CMClient.CertmanagerV1().ClusterIssuers().UpdateStatus(ctx, latest, metav1.UpdateOptions{})
+			return err
+		default:
+			return fmt.Errorf("unsupported issuer type %T", issuer)
+		}
+	})
+}
+
+// setAcmeDNSRegistration sets the acme-dns registration of the given domain
+// in the issuer status. It returns false if the status was already up to
+// date.
+func setAcmeDNSRegistration(status *v1.IssuerStatus, domain, fullDomain string) bool {
+	if status.ACME == nil {
+		status.ACME = &cmacme.ACMEIssuerStatus{}
+	}
+	for i, r := range status.ACME.AcmeDNSRegistrations {
+		if r.Domain != domain {
+			continue
+		}
+		if r.FullDomain == fullDomain {
+			return false
+		}
+		status.ACME.AcmeDNSRegistrations[i].FullDomain = fullDomain
+		return true
+	}
+	status.ACME.AcmeDNSRegistrations = append(status.ACME.AcmeDNSRegistrations, cmacme.ACMEAcmeDNSRegistration{
+		Domain:     domain,
+		FullDomain: fullDomain,
+	})
+	return true
+}
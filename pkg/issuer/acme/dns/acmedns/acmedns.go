@@ -66,6 +66,11 @@ func NewDNSProviderHostBytes(host string, accountJSON []byte, dns01Nameservers [
 	}, nil
 }
 
+// RegisterAccount registers a new account with the acme-dns server at host.
+func RegisterAccount(host string) (goacmedns.Account, error) {
+	return goacmedns.NewClient(host).RegisterAccount(nil)
+}
+
 // Present creates a TXT record to fulfil the dns-01 challenge
 func (c *DNSProvider) Present(_ context.Context, domain, fqdn, value string) error {
 	if account, exists := c.accounts[domain]; exists {
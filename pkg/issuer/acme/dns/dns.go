@@ -19,16 +19,20 @@ package dns
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/cpu/goacmedns"
 	authv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	"github.com/cert-manager/cert-manager/pkg/acme"
 	"github.com/cert-manager/cert-manager/pkg/acme/webhook"
 	whapi "github.com/cert-manager/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
@@ -41,6 +45,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/clouddns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/cloudflare"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/digitalocean"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/embedded"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/rfc2136"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/route53"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
@@ -48,6 +53,10 @@ import (
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
 
+// ErrPropagationTimeout is returned by Check if the self check of a challenge
+// has not passed within the propagation timeout configured on its solver.
+var ErrPropagationTimeout = errors.New("timed out waiting for the DNS01 record to propagate")
+
 // solver is the old solver type interface.
 // All new solvers should be implemented using the new webhook.Solver interface.
 type solver interface {
@@ -59,12 +68,13 @@ type solver interface {
 // It is useful for mocking out a given provider since an alternate set of
 // constructors may be set.
 type dnsProviderConstructors struct {
-	cloudDNS     func(ctx context.Context, project string, serviceAccount []byte, dns01Nameservers []string, ambient bool, hostedZoneName string) (*clouddns.DNSProvider, error)
-	cloudFlare   func(email, apikey, apiToken string, dns01Nameservers []string, userAgent string) (*cloudflare.DNSProvider, error)
-	route53      func(ctx context.Context, accessKey, secretKey, hostedZoneID, region, role, webIdentityToken string, ambient bool, dns01Nameservers []string, userAgent string) (*route53.DNSProvider, error)
-	azureDNS     func(environment, clientID, clientSecret, subscriptionID, tenantID, resourceGroupName, hostedZoneName string, dns01Nameservers []string, ambient bool, managedIdentity *cmacme.AzureManagedIdentity) (*azuredns.DNSProvider, error)
-	acmeDNS      func(host string, accountJson []byte, dns01Nameservers []string) (*acmedns.DNSProvider, error)
-	digitalOcean func(token string, dns01Nameservers []string, userAgent string) (*digitalocean.DNSProvider, error)
+	cloudDNS        func(ctx context.Context, project string, serviceAccount []byte, dns01Nameservers []string, ambient bool, hostedZoneName string) (*clouddns.DNSProvider, error)
+	cloudFlare      func(email, apikey, apiToken string, dns01Nameservers []string, userAgent string) (*cloudflare.DNSProvider, error)
+	route53         func(ctx context.Context, accessKey, secretKey, hostedZoneID, region, role, webIdentityToken string, ambient bool, dns01Nameservers []string, userAgent string) (*route53.DNSProvider, error)
+	azureDNS        func(environment, clientID, clientSecret, subscriptionID, tenantID, resourceGroupName, hostedZoneName string, dns01Nameservers []string, ambient bool, managedIdentity *cmacme.AzureManagedIdentity) (*azuredns.DNSProvider, error)
+	acmeDNS         func(host string, accountJson []byte, dns01Nameservers []string) (*acmedns.DNSProvider, error)
+	acmeDNSRegister func(host string) (goacmedns.Account, error)
+	digitalOcean    func(token string, dns01Nameservers []string, userAgent string) (*digitalocean.DNSProvider, error)
 }
 
 // Solver is a solver for the acme dns01 challenge.
@@ -91,34 +101,90 @@ func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 		return webhookSolver.Present(req)
 	}
 
+	var acmeDNSAccount *goacmedns.Account
+	if dns01 := ch.Spec.Solver.DNS01; dns01 != nil && dns01.AcmeDNS != nil && dns01.AcmeDNS.AutoRegister {
+		account, err := s.ensureAcmeDNSAccount(ctx, issuer, ch, dns01.AcmeDNS)
+		if err != nil {
+			return err
+		}
+		acmeDNSAccount = &account
+	}
+
 	slv, providerConfig, err := s.solverForChallenge(ctx, issuer, ch)
 	if err != nil {
 		return err
 	}
 
-	fqdn, err := util.DNS01LookupFQDN(ctx, ch.Spec.DNSName, followCNAME(providerConfig.CNAMEStrategy), s.DNS01Nameservers...)
+	fqdn, err := s.challengeFQDN(ctx, ch, followCNAME(providerConfig.CNAMEStrategy))
 	if err != nil {
 		return err
 	}
 
+	if providerConfig.Embedded != nil {
+		if err := embedded.CheckChallenge(ch, fqdn, s.DNS01EmbeddedServerZones); err != nil {
+			return err
+		}
+	}
+
 	log.V(logf.DebugLevel).Info("presenting DNS01 challenge for domain")
 
-	return slv.Present(ctx, ch.Spec.DNSName, fqdn, ch.Spec.Key)
+	if err := slv.Present(ctx, ch.Spec.DNSName, fqdn, ch.Spec.Key); err != nil {
+		return err
+	}
+	switch {
+	case providerConfig.Manual != nil:
+		s.publishManualRecord(ch, fqdn)
+	case providerConfig.Embedded != nil:
+		// the embedded DNS server serves the record from the challenge status
+		publishRecord(ch, fqdn)
+	case acmeDNSAccount != nil:
+		fqdn, err := s.challengeFQDN(ctx, ch, false)
+		if err != nil {
+			return err
+		}
+		publishRecord(ch, fqdn)
+		ch.Status.DNS01Record.CNAME = acmeDNSAccount.FullDomain
+	}
+	return nil
 }
 
 // Check verifies that the DNS records for the ACME challenge have propagated.
 func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
 	log := logf.WithResource(logf.FromContext(ctx, "Check"), ch).WithValues("domain", ch.Spec.DNSName)
 
-	fqdn, err := util.DNS01LookupFQDN(ctx, ch.Spec.DNSName, false, s.DNS01Nameservers...)
+	fqdn, err := s.challengeFQDN(ctx, ch, false)
 	if err != nil {
 		return err
 	}
 
-	log.V(logf.DebugLevel).Info("checking DNS propagation", "nameservers", s.Context.DNS01Nameservers)
+	nameservers := s.nameserversForChallenge(ch)
+	checkAuthoritative := s.Context.DNS01CheckAuthoritative
+	selfCheck := selfCheckConfig(ch)
+	if selfCheck != nil && selfCheck.RecursiveNameserversOnly != nil {
+		checkAuthoritative = !*selfCheck.RecursiveNameserversOnly
+	}
 
-	ok, err := util.PreCheckDNS(ctx, fqdn, ch.Spec.Key, s.Context.DNS01Nameservers,
-		s.Context.DNS01CheckAuthoritative)
+	var ok bool
+	if selfCheck != nil && len(selfCheck.Perspectives) > 0 {
+		log.V(logf.DebugLevel).Info("checking DNS propagation from multiple perspectives", "perspectives", len(selfCheck.Perspectives))
+		err = s.checkPerspectives(ctx, ch, fqdn, selfCheck)
+		ok = err == nil
+	} else {
+		log.V(logf.DebugLevel).Info("checking DNS propagation", "nameservers", nameservers, "authoritative", checkAuthoritative)
+		ok, err = util.PreCheckDNS(ctx, fqdn, ch.Spec.Key, nameservers, checkAuthoritative)
+	}
+	if err != nil || !ok {
+		if err := s.checkManualTimeout(ch, fqdn); err != nil {
+			return err
+		}
+		if selfCheck != nil && selfCheck.PropagationTimeout != nil &&
+			s.Clock.Since(presentedTime(ch)) > selfCheck.PropagationTimeout.Duration {
+			if err == nil {
+				err = fmt.Errorf("DNS record for %q not yet propagated", ch.Spec.DNSName)
+			}
+			return fmt.Errorf("%w: TXT record %q has not propagated within %s: %v", ErrPropagationTimeout, fqdn, selfCheck.PropagationTimeout.Duration, err)
+		}
+	}
 	if err != nil {
 		return err
 	}
@@ -134,6 +200,38 @@ func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.
 	return nil
 }
 
+// presentedTime returns the time at which the challenge record was first
+// presented, falling back to the creation time of Challenges presented before
+// it was recorded.
+func presentedTime(ch *cmacme.Challenge) time.Time {
+	if ch.Status.PresentedTime != nil {
+		return ch.Status.PresentedTime.Time
+	}
+	return ch.CreationTimestamp.Time
+}
+
+// checkPerspectives checks that the challenge record has propagated using the
+// recursive nameservers of each of the perspectives of the given self check.
+// The record is only looked up from the perspective's own nameservers, as
+// querying the authoritative nameservers would give the same answer from
+// every perspective.
+func (s *Solver) checkPerspectives(ctx context.Context, ch *cmacme.Challenge, fqdn string, selfCheck *cmacme.ACMEChallengeSolverDNS01SelfCheck) error {
+	names := make([]string, len(selfCheck.Perspectives))
+	for i, perspective := range selfCheck.Perspectives {
+		names[i] = perspective.Name
+	}
+	return acme.CheckPerspectives(ctx, ch, names, selfCheck.Quorum, func(ctx context.Context, i int) error {
+		ok, err := util.PreCheckDNS(ctx, fqdn, ch.Spec.Key, selfCheck.Perspectives[i].RecursiveNameservers, false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("DNS record for %q not yet propagated", ch.Spec.DNSName)
+		}
+		return nil
+	})
+}
+
 // CleanUp removes DNS records which are no longer needed after
 // certificate issuance.
 func (s *Solver) CleanUp(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
@@ -154,7 +252,7 @@ func (s *Solver) CleanUp(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 		return err
 	}
 
-	fqdn, err := util.DNS01LookupFQDN(ctx, ch.Spec.DNSName, followCNAME(providerConfig.CNAMEStrategy), s.DNS01Nameservers...)
+	fqdn, err := s.challengeFQDN(ctx, ch, followCNAME(providerConfig.CNAMEStrategy))
 	if err != nil {
 		return err
 	}
@@ -162,6 +260,42 @@ func (s *Solver) CleanUp(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 	return slv.CleanUp(ctx, ch.Spec.DNSName, fqdn, ch.Spec.Key)
 }
 
+// challengeFQDN returns the DNS name at which the TXT record for the given
+// challenge must be presented.
+func (s *Solver) challengeFQDN(ctx context.Context, ch *cmacme.Challenge, followCNAME bool) (string, error) {
+	if ch.Spec.Type == cmacme.ACMEChallengeTypeDNSAccount01 {
+		if ch.Spec.AccountURI == "" {
+			return "", fmt.Errorf("no ACME account URI found for dns-account-01 challenge")
+		}
+		return util.DNSAccount01LookupFQDN(ctx, ch.Spec.DNSName, ch.Spec.AccountURI, followCNAME, s.nameserversForChallenge(ch)...)
+	}
+	return util.DNS01LookupFQDN(ctx, ch.Spec.DNSName, followCNAME, s.nameserversForChallenge(ch)...)
+}
+
+// nameserversForChallenge returns the recursive nameservers used to resolve
+// DNS names for the given challenge. The nameservers configured on the
+// challenge's solver take precedence over the controller wide nameservers.
+func (s *Solver) nameserversForChallenge(ch *cmacme.Challenge) []string {
+	return NameserversForChallenge(ch, s.DNS01Nameservers)
+}
+
+// NameserversForChallenge returns the recursive nameservers configured on the
+// DNS01 solver of the given challenge, or the given default nameservers if
+// none are configured.
+func NameserversForChallenge(ch *cmacme.Challenge, defaultNameservers []string) []string {
+	if selfCheck := selfCheckConfig(ch); selfCheck != nil && len(selfCheck.RecursiveNameservers) > 0 {
+		return selfCheck.RecursiveNameservers
+	}
+	return defaultNameservers
+}
+
+func selfCheckConfig(ch *cmacme.Challenge) *cmacme.ACMEChallengeSolverDNS01SelfCheck {
+	if ch.Spec.Solver.DNS01 == nil {
+		return nil
+	}
+	return ch.Spec.Solver.DNS01.SelfCheck
+}
+
 func followCNAME(strategy cmacme.CNAMEStrategy) bool {
 	return strategy == cmacme.FollowStrategy
 }
@@ -183,6 +317,7 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 
 	resourceNamespace := s.ResourceNamespace(issuer)
 	canUseAmbientCredentials := s.CanUseAmbientCredentials(issuer)
+	nameservers := s.nameserversForChallenge(ch)
 
 	providerConfig, err := extractChallengeSolverConfig(ch)
 	if err != nil {
@@ -213,7 +348,7 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 			string(clientToken),
 			string(clientSecret),
 			string(accessToken),
-			s.DNS01Nameservers)
+			nameservers)
 		if err != nil {
 			return nil, nil, fmt.Errorf("error instantiating akamai challenge solver: %w", err)
 		}
@@ -238,7 +373,7 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 		}
 
 		// attempt to construct the cloud dns provider
-		impl, err = s.dnsProviderConstructors.cloudDNS(ctx, providerConfig.CloudDNS.Project, keyData, s.DNS01Nameservers, s.CanUseAmbientCredentials(issuer), providerConfig.CloudDNS.HostedZoneName)
+		impl, err = s.dnsProviderConstructors.cloudDNS(ctx, providerConfig.CloudDNS.Project, keyData, nameservers, s.CanUseAmbientCredentials(issuer), providerConfig.CloudDNS.HostedZoneName)
 		if err != nil {
 			return nil, nil, fmt.Errorf("error instantiating google clouddns challenge solver: %s", err)
 		}
@@ -275,7 +410,7 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 		}
 
 		email := providerConfig.Cloudflare.Email
-		impl, err = s.dnsProviderConstructors.cloudFlare(email, apiKey, apiToken, s.DNS01Nameservers, s.RESTConfig.UserAgent)
+		impl, err = s.dnsProviderConstructors.cloudFlare(email, apiKey, apiToken, nameservers, s.RESTConfig.UserAgent)
 		if err != nil {
 			return nil, nil, fmt.Errorf("error instantiating cloudflare challenge solver: %s", err)
 		}
@@ -288,7 +423,7 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 
 		apiToken := string(apiTokenSecret.Data[providerConfig.DigitalOcean.Token.Key])
 
-		impl, err = s.dnsProviderConstructors.digitalOcean(strings.TrimSpace(apiToken), s.DNS01Nameservers, s.RESTConfig.UserAgent)
+		impl, err = s.dnsProviderConstructors.digitalOcean(strings.TrimSpace(apiToken), nameservers, s.RESTConfig.UserAgent)
 		if err != nil {
 			return nil, nil, fmt.Errorf("error instantiating digitalocean challenge solver: %s", err.Error())
 		}
@@ -374,7 +509,7 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 			providerConfig.Route53.Role,
 			webIdentityToken,
 			canUseAmbientCredentials,
-			s.DNS01Nameservers,
+			nameservers,
 			s.RESTConfig.UserAgent,
 		)
 		if err != nil {
@@ -405,7 +540,7 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 			providerConfig.AzureDNS.TenantID,
 			providerConfig.AzureDNS.ResourceGroupName,
 			providerConfig.AzureDNS.HostedZoneName,
-			s.DNS01Nameservers,
+			nameservers,
 			canUseAmbientCredentials,
 			providerConfig.AzureDNS.ManagedIdentity,
 		)
@@ -414,7 +549,13 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 		}
 	case providerConfig.AcmeDNS != nil:
 		dbg.Info("preparing to create ACMEDNS provider")
-		accountSecret, err := s.secretLister.Secrets(resourceNamespace).Get(providerConfig.AcmeDNS.AccountSecret.Name)
+		var accountSecret *corev1.Secret
+		if providerConfig.AcmeDNS.AutoRegister {
+			// the secret may have been written by ensureAcmeDNSAccount moments ago
+			accountSecret, err = s.Client.CoreV1().Secrets(resourceNamespace).Get(ctx, providerConfig.AcmeDNS.AccountSecret.Name, metav1.GetOptions{})
+		} else {
+			accountSecret, err = s.secretLister.Secrets(resourceNamespace).Get(providerConfig.AcmeDNS.AccountSecret.Name)
+		}
 		if err != nil {
 			return nil, nil, fmt.Errorf("error getting acmedns accounts secret: %s", err)
 		}
@@ -427,11 +568,17 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 		impl, err = s.dnsProviderConstructors.acmeDNS(
 			providerConfig.AcmeDNS.Host,
 			accountSecretBytes,
-			s.DNS01Nameservers,
+			nameservers,
 		)
 		if err != nil {
 			return nil, providerConfig, fmt.Errorf("error instantiating acmedns challenge solver: %s", err)
 		}
+	case providerConfig.Manual != nil:
+		dbg.Info("preparing to create manual provider")
+		impl = statusProvider{}
+	case providerConfig.Embedded != nil:
+		dbg.Info("preparing to create embedded provider")
+		impl = statusProvider{}
 	default:
 		return nil, providerConfig, fmt.Errorf("no dns provider config specified for challenge")
 	}
@@ -450,12 +597,12 @@ func (s *Solver) prepareChallengeRequest(ctx context.Context, issuer v1.GenericI
 		return nil, nil, err
 	}
 
-	fqdn, err := util.DNS01LookupFQDN(ctx, ch.Spec.DNSName, followCNAME(dns01Config.CNAMEStrategy), s.DNS01Nameservers...)
+	fqdn, err := s.challengeFQDN(ctx, ch, followCNAME(dns01Config.CNAMEStrategy))
 	if err != nil {
 		return nil, nil, err
 	}
 
-	zone, err := util.FindZoneByFqdn(ctx, fqdn, s.DNS01Nameservers)
+	zone, err := util.FindZoneByFqdn(ctx, fqdn, s.nameserversForChallenge(ch))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -471,8 +618,13 @@ func (s *Solver) prepareChallengeRequest(ctx context.Context, issuer v1.GenericI
 		return nil, nil, err
 	}
 
+	challengeType := "dns-01"
+	if ch.Spec.Type == cmacme.ACMEChallengeTypeDNSAccount01 {
+		challengeType = "dns-account-01"
+	}
+
 	req := &whapi.ChallengeRequest{
-		Type:                    "dns-01",
+		Type:                    challengeType,
 		ResolvedFQDN:            fqdn,
 		ResolvedZone:            zone,
 		AllowAmbientCredentials: canUseAmbientCredentials,
@@ -541,6 +693,7 @@ func NewSolver(ctx *controller.Context) (*Solver, error) {
 			route53.NewDNSProvider,
 			azuredns.NewDNSProviderCredentials,
 			acmedns.NewDNSProviderHostBytes,
+			acmedns.RegisterAccount,
 			digitalocean.NewDNSProviderCredentials,
 		},
 		webhookSolvers: initialized,
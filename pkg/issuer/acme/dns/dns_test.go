@@ -18,13 +18,21 @@ package dns
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/cpu/goacmedns"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
+	fakeclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -655,3 +663,458 @@ func TestRoute53AssumeRole(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckSelfCheck(t *testing.T) {
+	now := time.Now()
+	fakeClock := fakeclock.NewFakeClock(now)
+
+	type preCheckCall struct {
+		nameservers   []string
+		authoritative bool
+	}
+
+	tests := map[string]struct {
+		selfCheck *cmacme.ACMEChallengeSolverDNS01SelfCheck
+		created   time.Time
+		presented *metav1.Time
+
+		expectedCall  preCheckCall
+		expectErr     bool
+		expectTimeout bool
+	}{
+		"uses the controller wide settings if no self check is configured": {
+			created:      now,
+			expectedCall: preCheckCall{nameservers: []string{"8.8.8.8:53"}, authoritative: true},
+			expectErr:    true,
+		},
+		"uses the nameservers configured on the solver": {
+			selfCheck: &cmacme.ACMEChallengeSolverDNS01SelfCheck{
+				RecursiveNameservers:     []string{"10.0.0.53:53"},
+				RecursiveNameserversOnly: ptr.To(true),
+			},
+			created:      now,
+			expectedCall: preCheckCall{nameservers: []string{"10.0.0.53:53"}, authoritative: false},
+			expectErr:    true,
+		},
+		"fails while the propagation timeout has not elapsed": {
+			selfCheck: &cmacme.ACMEChallengeSolverDNS01SelfCheck{
+				PropagationTimeout: &metav1.Duration{Duration: time.Hour},
+			},
+			created:      now.Add(-time.Minute),
+			expectedCall: preCheckCall{nameservers: []string{"8.8.8.8:53"}, authoritative: true},
+			expectErr:    true,
+		},
+		"fails with a timeout once the propagation timeout has elapsed": {
+			selfCheck: &cmacme.ACMEChallengeSolverDNS01SelfCheck{
+				PropagationTimeout: &metav1.Duration{Duration: time.Hour},
+			},
+			created:       now.Add(-2 * time.Hour),
+			expectedCall:  preCheckCall{nameservers: []string{"8.8.8.8:53"}, authoritative: true},
+			expectErr:     true,
+			expectTimeout: true,
+		},
+		"measures the propagation timeout from when the record was presented": {
+			selfCheck: &cmacme.ACMEChallengeSolverDNS01SelfCheck{
+				PropagationTimeout: &metav1.Duration{Duration: time.Hour},
+			},
+			created:      now.Add(-2 * time.Hour),
+			presented:    ptr.To(metav1.NewTime(now.Add(-time.Minute))),
+			expectedCall: preCheckCall{nameservers: []string{"8.8.8.8:53"}, authoritative: true},
+			expectErr:    true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls []preCheckCall
+			origPreCheckDNS := util.PreCheckDNS
+			defer func() { util.PreCheckDNS = origPreCheckDNS }()
+			util.PreCheckDNS = func(ctx context.Context, fqdn, value string, nameservers []string, useAuthoritative bool) (bool, error) {
+				calls = append(calls, preCheckCall{nameservers: nameservers, authoritative: useAuthoritative})
+				return false, nil
+			}
+
+			s := &Solver{
+				Context: &controller.Context{
+					ContextOptions: controller.ContextOptions{
+						Clock: fakeClock,
+						ACMEOptions: controller.ACMEOptions{
+							DNS01Nameservers:        []string{"8.8.8.8:53"},
+							DNS01CheckAuthoritative: true,
+						},
+					},
+				},
+			}
+			ch := &cmacme.Challenge{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(test.created)},
+				Spec: cmacme.ChallengeSpec{
+					Type:    cmacme.ACMEChallengeTypeDNS01,
+					DNSName: "example.com",
+					Key:     "key",
+					Solver: cmacme.ACMEChallengeSolver{
+						DNS01: &cmacme.ACMEChallengeSolverDNS01{SelfCheck: test.selfCheck},
+					},
+				},
+				Status: cmacme.ChallengeStatus{PresentedTime: test.presented},
+			}
+
+			err := s.Check(context.Background(), newIssuer(), ch)
+			if (err != nil) != test.expectErr {
+				t.Errorf("expected error %t, got: %v", test.expectErr, err)
+			}
+			if errors.Is(err, ErrPropagationTimeout) != test.expectTimeout {
+				t.Errorf("expected timeout error %t, got: %v", test.expectTimeout, err)
+			}
+			if len(calls) != 1 || !reflect.DeepEqual(calls[0], test.expectedCall) {
+				t.Errorf("expected PreCheckDNS to be called with %+v, got: %+v", test.expectedCall, calls)
+			}
+		})
+	}
+}
+
+func TestManual(t *testing.T) {
+	now := time.Now()
+	fakeClock := fakeclock.NewFakeClock(now)
+
+	newChallenge := func(created time.Time, presented *metav1.Time, timeout *metav1.Duration) *cmacme.Challenge {
+		return &cmacme.Challenge{
+			ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+			Spec: cmacme.ChallengeSpec{
+				Type:    cmacme.ACMEChallengeTypeDNS01,
+				DNSName: "example.com",
+				Key:     "key",
+				Solver: cmacme.ACMEChallengeSolver{
+					DNS01: &cmacme.ACMEChallengeSolverDNS01{
+						Manual: &cmacme.ACMEIssuerDNS01ProviderManual{Timeout: timeout},
+					},
+				},
+			},
+			Status: cmacme.ChallengeStatus{PresentedTime: presented},
+		}
+	}
+
+	t.Run("Present publishes the record in the challenge status", func(t *testing.T) {
+		recorder := new(test.FakeRecorder)
+		s := &Solver{
+			Context: &controller.Context{
+				Recorder:       recorder,
+				ContextOptions: controller.ContextOptions{Clock: fakeClock},
+			},
+		}
+		ch := newChallenge(now, nil, nil)
+		if err := s.Present(context.Background(), newIssuer(), ch); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := &cmacme.ChallengeDNS01Record{FQDN: "_acme-challenge.example.com.", Value: "key"}
+		if !reflect.DeepEqual(ch.Status.DNS01Record, expected) {
+			t.Errorf("expected DNS01 record %+v, got %+v", expected, ch.Status.DNS01Record)
+		}
+		if len(recorder.Events) != 1 {
+			t.Errorf("expected one event, got %v", recorder.Events)
+		}
+	})
+
+	tests := map[string]struct {
+		created   time.Time
+		presented *metav1.Time
+		timeout   *metav1.Duration
+
+		expectTimeout bool
+	}{
+		"waits for the record if no timeout is configured": {
+			created: now.Add(-24 * time.Hour),
+		},
+		"waits for the record while the timeout has not elapsed": {
+			created: now.Add(-time.Minute),
+			timeout: &metav1.Duration{Duration: time.Hour},
+		},
+		"fails once the timeout has elapsed": {
+			created:       now.Add(-2 * time.Hour),
+			timeout:       &metav1.Duration{Duration: time.Hour},
+			expectTimeout: true,
+		},
+		"measures the timeout from when the record was presented": {
+			created:   now.Add(-2 * time.Hour),
+			presented: ptr.To(metav1.NewTime(now.Add(-time.Minute))),
+			timeout:   &metav1.Duration{Duration: time.Hour},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			origPreCheckDNS := util.PreCheckDNS
+			defer func() { util.PreCheckDNS = origPreCheckDNS }()
+			util.PreCheckDNS = func(ctx context.Context, fqdn, value string, nameservers []string, useAuthoritative bool) (bool, error) {
+				return false, nil
+			}
+
+			s := &Solver{
+				Context: &controller.Context{
+					ContextOptions: controller.ContextOptions{Clock: fakeClock},
+				},
+			}
+
+			err := s.Check(context.Background(), newIssuer(), newChallenge(test.created, test.presented, test.timeout))
+			if err == nil {
+				t.Fatalf("expected an error as the record has not propagated")
+			}
+			if errors.Is(err, ErrManualRecordTimeout) != test.expectTimeout {
+				t.Errorf("expected timeout error %t, got: %v", test.expectTimeout, err)
+			}
+		})
+	}
+}
+
+func TestAcmeDNSAutoRegister(t *testing.T) {
+	var registrations int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/register":
+			registrations++
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"fulldomain":"d420c923.auth.example.org","subdomain":"d420c923","username":"user","password":"pass"}`))
+		case "/update":
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	accountSecretRef := cmmeta.SecretKeySelector{
+		LocalObjectReference: cmmeta.LocalObjectReference{Name: "acme-dns"},
+		Key:                  "acmedns.json",
+	}
+	newChallenge := func() *cmacme.Challenge {
+		return &cmacme.Challenge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: cmacme.ChallengeSpec{
+				Type:    cmacme.ACMEChallengeTypeDNS01,
+				DNSName: "example.com",
+				Key:     "key",
+				Solver: cmacme.ACMEChallengeSolver{
+					DNS01: &cmacme.ACMEChallengeSolverDNS01{
+						AcmeDNS: &cmacme.ACMEIssuerDNS01ProviderAcmeDNS{
+							Host:          server.URL,
+							AccountSecret: accountSecretRef,
+							AutoRegister:  true,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	dnsProviders := newFakeDNSProviders()
+	dnsProviders.constructors.acmeDNS = acmedns.NewDNSProviderHostBytes
+	dnsProviders.constructors.acmeDNSRegister = acmedns.RegisterAccount
+
+	issuer := newIssuer()
+	f := &solverFixture{
+		Builder: &test.Builder{
+			CertManagerObjects: []runtime.Object{issuer},
+		},
+		Issuer:       issuer,
+		dnsProviders: dnsProviders,
+	}
+	f.Setup(t)
+	defer f.Finish(t)
+	ctx := context.Background()
+
+	ch := newChallenge()
+	if err := f.Solver.Present(ctx, f.Issuer, ch); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedRecord := &cmacme.ChallengeDNS01Record{FQDN: "_acme-challenge.example.com.", Value: "key", CNAME: "d420c923.auth.example.org"}
+	if !reflect.DeepEqual(ch.Status.DNS01Record, expectedRecord) {
+		t.Errorf("expected DNS01 record %+v, got %+v", expectedRecord, ch.Status.DNS01Record)
+	}
+
+	secret, err := f.Client.CoreV1().Secrets("default").Get(ctx, "acme-dns", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected the account secret to be created: %v", err)
+	}
+	if len(secret.OwnerReferences) != 1 || secret.OwnerReferences[0].Kind != v1.IssuerKind {
+		t.Errorf("expected the account secret to be owned by the issuer, got %+v", secret.OwnerReferences)
+	}
+	if _, err := acmedns.NewDNSProviderHostBytes(server.URL, secret.Data["acmedns.json"], nil); err != nil {
+		t.Errorf("expected the account secret to contain valid accounts: %v", err)
+	}
+
+	latest, err := f.CMClient.CertmanagerV1().Issuers("default").Get(ctx, issuer.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedRegistrations := []cmacme.ACMEAcmeDNSRegistration{{Domain: "example.com", FullDomain: "d420c923.auth.example.org"}}
+	if latest.Status.ACME == nil || !reflect.DeepEqual(latest.Status.ACME.AcmeDNSRegistrations, expectedRegistrations) {
+		t.Errorf("expected issuer status to record %+v, got %+v", expectedRegistrations, latest.Status.ACME)
+	}
+
+	// presenting another challenge for the same domain reuses the account
+	if err := f.Solver.Present(ctx, latest, newChallenge()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if registrations != 1 {
+		t.Errorf("expected a single registration, got %d", registrations)
+	}
+	if events := f.Events(); len(events) != 1 {
+		t.Errorf("expected a single event, got %v", events)
+	}
+}
+
+func TestAcmeDNSAutoRegisterConcurrentWriter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	accountSecretRef := cmmeta.SecretKeySelector{
+		LocalObjectReference: cmmeta.LocalObjectReference{Name: "acme-dns"},
+		Key:                  "acmedns.json",
+	}
+	registered := goacmedns.Account{FullDomain: "registered.auth.example.org", SubDomain: "registered", Username: "user", Password: "pass"}
+	stored := goacmedns.Account{FullDomain: "stored.auth.example.org", SubDomain: "stored", Username: "user", Password: "pass"}
+
+	tests := map[string]struct {
+		// concurrentDomain is the domain the concurrent writer stores an
+		// account for while the challenge's account is being registered
+		concurrentDomain string
+
+		expectedCNAME    string
+		expectedAccounts map[string]goacmedns.Account
+		expectEvent      bool
+	}{
+		"merge the registered account with an account stored for another domain": {
+			concurrentDomain: "example.org",
+			expectedCNAME:    "registered.auth.example.org",
+			expectedAccounts: map[string]goacmedns.Account{"example.com": registered, "example.org": stored},
+			expectEvent:      true,
+		},
+		"use the account stored for the same domain by another writer": {
+			concurrentDomain: "example.com",
+			expectedCNAME:    "stored.auth.example.org",
+			expectedAccounts: map[string]goacmedns.Account{"example.com": stored},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			issuer := newIssuer()
+			f := &solverFixture{
+				Builder: &test.Builder{
+					CertManagerObjects: []runtime.Object{issuer},
+				},
+				Issuer:       issuer,
+				dnsProviders: newFakeDNSProviders(),
+			}
+			f.dnsProviders.constructors.acmeDNS = acmedns.NewDNSProviderHostBytes
+			f.dnsProviders.constructors.acmeDNSRegister = func(string) (goacmedns.Account, error) {
+				data, err := json.Marshal(map[string]goacmedns.Account{tc.concurrentDomain: stored})
+				if err != nil {
+					return goacmedns.Account{}, err
+				}
+				_, err = f.Client.CoreV1().Secrets("default").Create(context.Background(), &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "acme-dns", Namespace: "default"},
+					Data:       map[string][]byte{"acmedns.json": data},
+				}, metav1.CreateOptions{})
+				return registered, err
+			}
+			f.Setup(t)
+			defer f.Finish(t)
+			ctx := context.Background()
+
+			ch := &cmacme.Challenge{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Spec: cmacme.ChallengeSpec{
+					Type:    cmacme.ACMEChallengeTypeDNS01,
+					DNSName: "example.com",
+					Key:     "key",
+					Solver: cmacme.ACMEChallengeSolver{
+						DNS01: &cmacme.ACMEChallengeSolverDNS01{
+							AcmeDNS: &cmacme.ACMEIssuerDNS01ProviderAcmeDNS{
+								Host:          server.URL,
+								AccountSecret: accountSecretRef,
+								AutoRegister:  true,
+							},
+						},
+					},
+				},
+			}
+			if err := f.Solver.Present(ctx, f.Issuer, ch); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ch.Status.DNS01Record == nil || ch.Status.DNS01Record.CNAME != tc.expectedCNAME {
+				t.Errorf("expected the record to be a CNAME to %q, got %+v", tc.expectedCNAME, ch.Status.DNS01Record)
+			}
+
+			secret, err := f.Client.CoreV1().Secrets("default").Get(ctx, "acme-dns", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var accounts map[string]goacmedns.Account
+			if err := json.Unmarshal(secret.Data["acmedns.json"], &accounts); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(accounts, tc.expectedAccounts) {
+				t.Errorf("expected accounts %+v, got %+v", tc.expectedAccounts, accounts)
+			}
+			if events := f.Events(); (len(events) > 0) != tc.expectEvent {
+				t.Errorf("expected an event: %t, got %v", tc.expectEvent, events)
+			}
+		})
+	}
+}
+
+func TestCheckSelfCheckPerspectives(t *testing.T) {
+	origPreCheckDNS := util.PreCheckDNS
+	defer func() { util.PreCheckDNS = origPreCheckDNS }()
+	util.PreCheckDNS = func(ctx context.Context, fqdn, value string, nameservers []string, useAuthoritative bool) (bool, error) {
+		// The authoritative nameservers give the same answer from every
+		// perspective, while only the "eu" resolvers see the record.
+		if useAuthoritative {
+			return true, nil
+		}
+		return nameservers[0] == "10.0.0.53:53", nil
+	}
+
+	s := &Solver{
+		Context: &controller.Context{
+			ContextOptions: controller.ContextOptions{
+				Clock: fakeclock.NewFakeClock(time.Now()),
+				ACMEOptions: controller.ACMEOptions{
+					DNS01CheckAuthoritative: true,
+				},
+			},
+		},
+	}
+	ch := &cmacme.Challenge{
+		Spec: cmacme.ChallengeSpec{
+			Type:    cmacme.ACMEChallengeTypeDNS01,
+			DNSName: "example.com",
+			Key:     "key",
+			Solver: cmacme.ACMEChallengeSolver{
+				DNS01: &cmacme.ACMEChallengeSolverDNS01{
+					SelfCheck: &cmacme.ACMEChallengeSolverDNS01SelfCheck{
+						Perspectives: []cmacme.ACMEChallengeSolverDNS01SelfCheckPerspective{
+							{Name: "eu", RecursiveNameservers: []string{"10.0.0.53:53"}},
+							{Name: "us", RecursiveNameservers: []string{"10.1.0.53:53"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if err := s.Check(context.Background(), newIssuer(), ch); err == nil {
+		t.Errorf("expected an error as the record has not propagated to all perspectives")
+	}
+
+	expected := []cmacme.ChallengeSelfCheckPerspective{
+		{Name: "eu", Passed: true},
+		{Name: "us", Passed: false, Reason: `DNS record for "example.com" not yet propagated`},
+	}
+	if !reflect.DeepEqual(ch.Status.SelfCheckPerspectives, expected) {
+		t.Errorf("expected perspectives %+v, got %+v", expected, ch.Status.SelfCheckPerspectives)
+	}
+}
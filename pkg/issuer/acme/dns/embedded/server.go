@@ -0,0 +1,250 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package embedded implements the authoritative DNS server embedded in the
+// cert-manager controller, which serves the TXT records of DNS01 challenges
+// solved by the embedded DNS01 provider straight from Challenge resources.
+package embedded
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/miekg/dns"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmacmelisters "github.com/cert-manager/cert-manager/pkg/client/listers/acme/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// recordTTL is the TTL of the records served by the embedded DNS server. It
+// is kept short, as challenge records change with every issuance and must
+// not be cached by recursive resolvers.
+const recordTTL = 1
+
+// Server is an authoritative DNS server which serves the TXT records of the
+// DNS01 challenges solved by the embedded DNS01 provider. The records are
+// read from the status of the Challenge resources, so that every replica of
+// the controller serves the same records. Only the records allowed by
+// CheckChallenge are served.
+type Server struct {
+	challenges cmacmelisters.ChallengeLister
+	nameserver string
+	zones      []string
+	log        logr.Logger
+}
+
+// NewServer returns a Server which serves the records of the Challenges in
+// the given lister.
+// The nameserver is the fully qualified domain name of the server, used in
+// the SOA and NS records it serves. The server is authoritative for the
+// given zones.
+func NewServer(ctx context.Context, challenges cmacmelisters.ChallengeLister, nameserver string, zones []string) *Server {
+	s := &Server{
+		challenges: challenges,
+		nameserver: dns.CanonicalName(nameserver),
+		log:        logf.FromContext(ctx, "embeddedDNSServer"),
+	}
+	for _, zone := range zones {
+		s.zones = append(s.zones, dns.CanonicalName(zone))
+	}
+	return s
+}
+
+// Serve serves DNS requests received on the given UDP and TCP listeners until
+// the context is cancelled.
+func (s *Server) Serve(ctx context.Context, pc net.PacketConn, ln net.Listener) error {
+	udpStarted, tcpStarted := make(chan struct{}), make(chan struct{})
+	udp := &dns.Server{PacketConn: pc, Handler: s, NotifyStartedFunc: func() { close(udpStarted) }}
+	tcp := &dns.Server{Listener: ln, Handler: s, NotifyStartedFunc: func() { close(tcpStarted) }}
+
+	udpDone, tcpDone := make(chan struct{}), make(chan struct{})
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		defer close(udpDone)
+		return udp.ActivateAndServe()
+	})
+	g.Go(func() error {
+		defer close(tcpDone)
+		return tcp.ActivateAndServe()
+	})
+	g.Go(func() error {
+		<-ctx.Done()
+		return utilerrors.NewAggregate([]error{
+			shutdown(udp, udpStarted, udpDone),
+			shutdown(tcp, tcpStarted, tcpDone),
+		})
+	})
+	return g.Wait()
+}
+
+// shutdown shuts the given server down once it has started. A server must
+// have started before it can be shut down, but a server which failed to start
+// has already returned, so it is not waited for.
+func shutdown(srv *dns.Server, started, done <-chan struct{}) error {
+	select {
+	case <-started:
+		return srv.Shutdown()
+	case <-done:
+		return nil
+	}
+}
+
+// CheckChallenge returns an error if the record with the given FQDN of the
+// given challenge must not be served by an embedded DNS server that is
+// authoritative for the given zones.
+// Only the records of challenges of ClusterIssuers are served, as anyone who
+// can create an Issuer could otherwise solve challenges for, and obtain
+// certificates for, every domain delegated to the server. The records must
+// also be within the zones of the server.
+func CheckChallenge(ch *cmacme.Challenge, fqdn string, zones []string) error {
+	ref := ch.Spec.IssuerRef
+	if ref.Kind != cmapi.ClusterIssuerKind || (ref.Group != "" && ref.Group != certmanager.GroupName) {
+		return fmt.Errorf("the embedded DNS01 provider may only be used by ClusterIssuers")
+	}
+	if zoneFor(zones, dns.CanonicalName(fqdn)) == "" {
+		return fmt.Errorf("the challenge record %q is not within the zones %v of the embedded DNS server", fqdn, zones)
+	}
+	return nil
+}
+
+// ServeDNS implements github.com/miekg/dns.Handler
+func (s *Server) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	m := s.handle(req)
+	if err := w.WriteMsg(m); err != nil {
+		s.log.Error(err, "failed to write DNS response")
+	}
+}
+
+func (s *Server) handle(req *dns.Msg) *dns.Msg {
+	m := new(dns.Msg)
+	m.SetReply(req)
+	if req.Opcode != dns.OpcodeQuery {
+		m.Rcode = dns.RcodeNotImplemented
+		return m
+	}
+	if len(req.Question) != 1 {
+		m.Rcode = dns.RcodeFormatError
+		return m
+	}
+
+	q := req.Question[0]
+	name := dns.CanonicalName(q.Name)
+	log := s.log.WithValues("name", name, "type", dns.TypeToString[q.Qtype])
+
+	zone := zoneFor(s.zones, name)
+	if zone == "" {
+		log.V(logf.DebugLevel).Info("refusing query for a name outside of the zones of the server")
+		m.Rcode = dns.RcodeRefused
+		return m
+	}
+
+	values, err := s.txtRecords(name)
+	if err != nil {
+		log.Error(err, "failed to list challenges")
+		m.Rcode = dns.RcodeServerFailure
+		return m
+	}
+	m.Authoritative = true
+
+	switch {
+	case q.Qclass != dns.ClassINET:
+		m.Rcode = dns.RcodeRefused
+	case q.Qtype == dns.TypeTXT && len(values) > 0:
+		for _, value := range values {
+			m.Answer = append(m.Answer, &dns.TXT{
+				Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: recordTTL},
+				Txt: []string{value},
+			})
+		}
+	case q.Qtype == dns.TypeSOA && name == zone:
+		m.Answer = append(m.Answer, s.soa(zone))
+	case q.Qtype == dns.TypeNS && name == zone:
+		m.Answer = append(m.Answer, &dns.NS{
+			Hdr: dns.RR_Header{Name: zone, Rrtype: dns.TypeNS, Class: dns.ClassINET, Ttl: recordTTL},
+			Ns:  s.nameserver,
+		})
+	case len(values) == 0 && name != zone:
+		m.Rcode = dns.RcodeNameError
+		m.Ns = append(m.Ns, s.soa(zone))
+	default:
+		m.Ns = append(m.Ns, s.soa(zone))
+	}
+
+	log.V(logf.DebugLevel).Info("answering query", "rcode", dns.RcodeToString[m.Rcode], "answers", len(m.Answer))
+	return m
+}
+
+// txtRecords returns the values of the TXT records with the given name of
+// the challenges solved by the embedded DNS01 provider, skipping the records
+// that must not be served.
+func (s *Server) txtRecords(name string) ([]string, error) {
+	challenges, err := s.challenges.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var values []string
+	for _, ch := range challenges {
+		if ch.Spec.Solver.DNS01 == nil || ch.Spec.Solver.DNS01.Embedded == nil || ch.Status.DNS01Record == nil {
+			continue
+		}
+		if dns.CanonicalName(ch.Status.DNS01Record.FQDN) != name {
+			continue
+		}
+		if err := CheckChallenge(ch, name, s.zones); err != nil {
+			s.log.V(logf.DebugLevel).Info("not serving challenge record", "challenge", ch.Namespace+"/"+ch.Name, "reason", err.Error())
+			continue
+		}
+		values = append(values, ch.Status.DNS01Record.Value)
+	}
+	return values, nil
+}
+
+// zoneFor returns the most specific of the given zones that the given
+// canonical name belongs to, or an empty string if it is not within any of
+// them.
+func zoneFor(zones []string, name string) string {
+	zone := ""
+	for _, z := range zones {
+		z = dns.CanonicalName(z)
+		if dns.IsSubDomain(z, name) && len(z) > len(zone) {
+			zone = z
+		}
+	}
+	return zone
+}
+
+func (s *Server) soa(zone string) dns.RR {
+	return &dns.SOA{
+		Hdr:     dns.RR_Header{Name: zone, Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: recordTTL},
+		Ns:      s.nameserver,
+		Mbox:    "hostmaster." + s.nameserver,
+		Serial:  uint32(time.Now().Unix()), // #nosec G115 -- the serial wraps around as allowed by RFC 1982
+		Refresh: 3600,
+		Retry:   600,
+		Expire:  86400,
+		Minttl:  recordTTL,
+	}
+}
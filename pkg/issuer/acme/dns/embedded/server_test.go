@@ -0,0 +1,231 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package embedded
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmacmelisters "github.com/cert-manager/cert-manager/pkg/client/listers/acme/v1"
+)
+
+func challenge(name, issuerKind string, solver *cmacme.ACMEChallengeSolverDNS01, fqdn, value string) *cmacme.Challenge {
+	return &cmacme.Challenge{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: cmacme.ChallengeSpec{
+			IssuerRef: cmmeta.ObjectReference{Name: "issuer", Kind: issuerKind},
+			Solver:    cmacme.ACMEChallengeSolver{DNS01: solver},
+		},
+		Status: cmacme.ChallengeStatus{
+			DNS01Record: &cmacme.ChallengeDNS01Record{FQDN: fqdn, Value: value},
+		},
+	}
+}
+
+func TestServer(t *testing.T) {
+	embeddedSolver := &cmacme.ACMEChallengeSolverDNS01{Embedded: &cmacme.ACMEIssuerDNS01ProviderEmbedded{}}
+	manualSolver := &cmacme.ACMEChallengeSolverDNS01{Manual: &cmacme.ACMEIssuerDNS01ProviderManual{}}
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, ch := range []*cmacme.Challenge{
+		challenge("delegated", cmapi.ClusterIssuerKind, embeddedSolver, "_acme-challenge.example.com.", "delegated-value"),
+		challenge("cname", cmapi.ClusterIssuerKind, embeddedSolver, "Example-Org.acme.example.net.", "cname-value"),
+		challenge("namespaced", cmapi.IssuerKind, embeddedSolver, "tenant.acme.example.net.", "namespaced-value"),
+		challenge("outside", cmapi.ClusterIssuerKind, embeddedSolver, "_acme-challenge.example.org.", "outside-value"),
+		challenge("manual", cmapi.ClusterIssuerKind, manualSolver, "manual.acme.example.net.", "manual-value"),
+	} {
+		if err := indexer.Add(ch); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s := NewServer(ctx, cmacmelisters.NewChallengeLister(indexer), "ns.acme.example.net", []string{"acme.example.net", "_acme-challenge.example.com"})
+	errCh := make(chan error, 1)
+	go func() { errCh <- s.Serve(ctx, pc, ln) }()
+	defer func() {
+		cancel()
+		if err := <-errCh; err != nil {
+			t.Errorf("unexpected error shutting down the server: %v", err)
+		}
+	}()
+
+	tests := map[string]struct {
+		name  string
+		qtype uint16
+
+		expectedRcode   int
+		expectedAnswers []string
+		expectSOA       bool
+	}{
+		"serves the TXT record of a delegated _acme-challenge name": {
+			name:            "_acme-challenge.example.com.",
+			qtype:           dns.TypeTXT,
+			expectedRcode:   dns.RcodeSuccess,
+			expectedAnswers: []string{"_acme-challenge.example.com.\t1\tIN\tTXT\t\"delegated-value\""},
+		},
+		"serves the SOA record of a delegated _acme-challenge name": {
+			name:          "_acme-challenge.example.com.",
+			qtype:         dns.TypeSOA,
+			expectedRcode: dns.RcodeSuccess,
+			expectSOA:     true,
+		},
+		"serves the TXT record of a name in a configured zone case-insensitively": {
+			name:            "example-org.acme.example.net.",
+			qtype:           dns.TypeTXT,
+			expectedRcode:   dns.RcodeSuccess,
+			expectedAnswers: []string{"example-org.acme.example.net.\t1\tIN\tTXT\t\"cname-value\""},
+		},
+		"serves the NS record of a configured zone": {
+			name:            "acme.example.net.",
+			qtype:           dns.TypeNS,
+			expectedRcode:   dns.RcodeSuccess,
+			expectedAnswers: []string{"acme.example.net.\t1\tIN\tNS\tns.acme.example.net."},
+		},
+		"answers NXDOMAIN for unknown names in a configured zone": {
+			name:          "unknown.acme.example.net.",
+			qtype:         dns.TypeTXT,
+			expectedRcode: dns.RcodeNameError,
+		},
+		"refuses queries for names outside of its zones, even if a challenge has a record there": {
+			name:          "_acme-challenge.example.org.",
+			qtype:         dns.TypeTXT,
+			expectedRcode: dns.RcodeRefused,
+		},
+		"does not serve records of challenges of namespaced Issuers": {
+			name:          "tenant.acme.example.net.",
+			qtype:         dns.TypeTXT,
+			expectedRcode: dns.RcodeNameError,
+		},
+		"does not serve records of challenges solved by other providers": {
+			name:          "manual.acme.example.net.",
+			qtype:         dns.TypeTXT,
+			expectedRcode: dns.RcodeNameError,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, network := range []string{"udp", "tcp"} {
+				addr := pc.LocalAddr().String()
+				if network == "tcp" {
+					addr = ln.Addr().String()
+				}
+				c := &dns.Client{Net: network}
+				req := new(dns.Msg)
+				req.SetQuestion(test.name, test.qtype)
+				resp, _, err := c.Exchange(req, addr)
+				if err != nil {
+					t.Fatalf("%s: unexpected error: %v", network, err)
+				}
+				if resp.Rcode != test.expectedRcode {
+					t.Errorf("%s: expected rcode %s, got %s", network, dns.RcodeToString[test.expectedRcode], dns.RcodeToString[resp.Rcode])
+				}
+				if test.expectSOA {
+					if len(resp.Answer) != 1 || resp.Answer[0].Header().Rrtype != dns.TypeSOA {
+						t.Errorf("%s: expected a SOA answer, got %v", network, resp.Answer)
+					}
+					continue
+				}
+				var answers []string
+				for _, rr := range resp.Answer {
+					answers = append(answers, rr.String())
+				}
+				if !reflect.DeepEqual(answers, test.expectedAnswers) {
+					t.Errorf("%s: expected answers %v, got %v", network, test.expectedAnswers, answers)
+				}
+			}
+		})
+	}
+}
+
+func TestServeFailsToStart(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the UDP server fails before it has started
+	pc.Close()
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	s := NewServer(context.Background(), cmacmelisters.NewChallengeLister(indexer), "ns.acme.example.net", nil)
+	errCh := make(chan error, 1)
+	go func() { errCh <- s.Serve(context.Background(), pc, ln) }()
+
+	select {
+	case err := <-errCh:
+		if err == nil {
+			t.Errorf("expected an error as the UDP server failed to start")
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("Serve did not return after the UDP server failed to start")
+	}
+}
+
+func TestCheckChallenge(t *testing.T) {
+	zones := []string{"acme.example.net"}
+	tests := map[string]struct {
+		kind      string
+		fqdn      string
+		expectErr bool
+	}{
+		"allows records of ClusterIssuers within the zones": {
+			kind: cmapi.ClusterIssuerKind,
+			fqdn: "example-org.ACME.example.net.",
+		},
+		"rejects records of namespaced Issuers": {
+			kind:      cmapi.IssuerKind,
+			fqdn:      "example-org.acme.example.net.",
+			expectErr: true,
+		},
+		"rejects records outside of the zones": {
+			kind:      cmapi.ClusterIssuerKind,
+			fqdn:      "_acme-challenge.example.com.",
+			expectErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ch := challenge("test", test.kind, nil, test.fqdn, "value")
+			if err := CheckChallenge(ch, test.fqdn, zones); (err != nil) != test.expectErr {
+				t.Errorf("expected error %t, got: %v", test.expectErr, err)
+			}
+		})
+	}
+}
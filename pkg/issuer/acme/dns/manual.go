@@ -0,0 +1,78 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+)
+
+const reasonCreateRecord = "CreateRecord"
+
+// ErrManualRecordTimeout is returned by Check if the TXT record of a
+// challenge solved by the manual DNS01 provider has not propagated within the
+// provider's timeout.
+var ErrManualRecordTimeout = errors.New("timed out waiting for the DNS01 record to be created")
+
+// statusProvider is the provider of the manual and the embedded DNS01
+// solvers. It does not manage any DNS records, as they are published in the
+// Challenge status instead.
+type statusProvider struct{}
+
+func (statusProvider) Present(ctx context.Context, domain, fqdn, value string) error {
+	return nil
+}
+
+func (statusProvider) CleanUp(ctx context.Context, domain, fqdn, value string) error {
+	return nil
+}
+
+// publishRecord records the TXT record that must be presented to solve the
+// given challenge in its status.
+func publishRecord(ch *cmacme.Challenge, fqdn string) {
+	ch.Status.DNS01Record = &cmacme.ChallengeDNS01Record{
+		FQDN:  fqdn,
+		Value: ch.Spec.Key,
+	}
+}
+
+// publishManualRecord records the TXT record that must be created to solve
+// the given challenge in its status and as an Event.
+func (s *Solver) publishManualRecord(ch *cmacme.Challenge, fqdn string) {
+	publishRecord(ch, fqdn)
+	s.Recorder.Eventf(ch, corev1.EventTypeNormal, reasonCreateRecord, "Create a TXT record %q with value %q to solve the challenge", fqdn, ch.Spec.Key)
+}
+
+// checkManualTimeout returns an error wrapping ErrManualRecordTimeout if the
+// given challenge is solved by the manual DNS01 provider and its TXT record
+// has not propagated within the provider's timeout, measured from when the
+// record was first presented.
+func (s *Solver) checkManualTimeout(ch *cmacme.Challenge, fqdn string) error {
+	if ch.Spec.Solver.DNS01 == nil || ch.Spec.Solver.DNS01.Manual == nil {
+		return nil
+	}
+	timeout := ch.Spec.Solver.DNS01.Manual.Timeout
+	if timeout == nil || s.Clock.Since(presentedTime(ch)) <= timeout.Duration {
+		return nil
+	}
+	return fmt.Errorf("%w: TXT record %q with value %q has not propagated within %s", ErrManualRecordTimeout, fqdn, ch.Spec.Key, timeout.Duration)
+}
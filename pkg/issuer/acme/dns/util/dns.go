@@ -10,7 +10,10 @@ package util
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base32"
 	"fmt"
+	"strings"
 
 	"github.com/miekg/dns"
 )
@@ -19,8 +22,25 @@ import (
 // challenge
 // TODO: move this into the pkg/acme package
 func DNS01LookupFQDN(ctx context.Context, domain string, followCNAME bool, nameservers ...string) (string, error) {
-	fqdn := fmt.Sprintf("_acme-challenge.%s.", domain)
+	return lookupFQDN(ctx, fmt.Sprintf("_acme-challenge.%s.", domain), followCNAME, nameservers)
+}
+
+// DNSAccount01LookupFQDN returns a DNS name which will be updated to solve the
+// dns-account-01 challenge of the ACME account with the given URI
+func DNSAccount01LookupFQDN(ctx context.Context, domain, accountURI string, followCNAME bool, nameservers ...string) (string, error) {
+	return lookupFQDN(ctx, fmt.Sprintf("%s._acme-challenge.%s.", DNSAccount01Label(accountURI), domain), followCNAME, nameservers)
+}
+
+// DNSAccount01Label returns the account specific label that is prepended to
+// the _acme-challenge name for dns-account-01 challenges, i.e. an underscore
+// followed by the lowercase base32 encoding of the first 10 bytes of the
+// SHA-256 digest of the account URI.
+func DNSAccount01Label(accountURI string) string {
+	digest := sha256.Sum256([]byte(accountURI))
+	return "_" + strings.ToLower(base32.StdEncoding.EncodeToString(digest[:10]))
+}
 
+func lookupFQDN(ctx context.Context, fqdn string, followCNAME bool, nameservers []string) (string, error) {
 	// Check if the domain has CNAME then return that
 	if followCNAME {
 		var err error
@@ -3,6 +3,7 @@
 package util
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -96,3 +97,10 @@ func TestLongestMatches(t *testing.T) {
 		})
 	}
 }
+
+func TestDNSAccount01LookupFQDN(t *testing.T) {
+	// Example taken from draft-ietf-acme-dns-account-label
+	fqdn, err := DNSAccount01LookupFQDN(context.TODO(), "example.org", "https://example.com/acme/acct/ExampleAccount", false)
+	assert.NoError(t, err)
+	assert.Equal(t, "_ujmmovf2vn55tgye._acme-challenge.example.org.", fqdn)
+}
@@ -11,10 +11,12 @@ package util
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -45,6 +47,27 @@ const defaultResolvConf = "/etc/resolv.conf"
 const issueTag = "issue"
 const issuewildTag = "issuewild"
 
+// RFC 8657 parameters of the issue and issuewild CAA properties
+const (
+	accountURIParam        = "accounturi"
+	validationMethodsParam = "validationmethods"
+)
+
+var (
+	// ErrCAAIssuer is returned by ValidateCAA if the CAA records of a domain
+	// do not permit the issuer to issue certificates for it.
+	ErrCAAIssuer = errors.New("CAA record does not match issuer")
+
+	// ErrCAAAccountURI is returned by ValidateCAA if the CAA records of a
+	// domain permit the issuer, but restrict issuance to other ACME accounts.
+	ErrCAAAccountURI = errors.New("CAA record does not permit the ACME account")
+
+	// ErrCAAValidationMethod is returned by ValidateCAA if the CAA records of
+	// a domain permit the issuer, but restrict issuance to other validation
+	// methods.
+	ErrCAAValidationMethod = errors.New("CAA record does not permit the validation method")
+)
+
 var defaultNameservers = []string{
 	"8.8.8.8:53",
 	"8.8.4.4:53",
@@ -270,7 +293,11 @@ func (c *httpDNSClient) Exchange(ctx context.Context, m *dns.Msg, a string) (r *
 	return r, rtt, nil
 }
 
-func ValidateCAA(ctx context.Context, domain string, issuerID []string, iswildcard bool, nameservers []string) error {
+// ValidateCAA checks that the CAA records of the given domain permit one of
+// the issuerIDs to issue a certificate for it. If set, the accountURI and the
+// validationMethod must also be permitted by the RFC 8657 accounturi and
+// validationmethods parameters of the CAA record.
+func ValidateCAA(ctx context.Context, domain string, issuerID []string, accountURI, validationMethod string, iswildcard bool, nameservers []string) error {
 	// see https://tools.ietf.org/html/rfc6844#section-4
 	// for more information about how CAA lookup is performed
 	fqdn := ToFqdn(domain)
@@ -345,31 +372,67 @@ func ValidateCAA(ctx context.Context, domain string, issuerID []string, iswildca
 		}
 	}
 
-	if !matchCAA(caas, issuerSet, iswildcard) {
-		// TODO(dmo): better error message
-		return fmt.Errorf("CAA record does not match issuer")
-	}
-	return nil
+	return matchCAA(caas, issuerSet, accountURI, validationMethod, iswildcard)
 }
 
-func matchCAA(caas []*dns.CAA, issuerIDs map[string]bool, iswildcard bool) bool {
-	matches := false
+// matchCAA returns nil if one of the given CAA records permits issuance. If
+// none does, the error explains why the records matching one of the
+// issuerIDs do not permit issuance, or it is ErrCAAIssuer if there are none.
+func matchCAA(caas []*dns.CAA, issuerIDs map[string]bool, accountURI, validationMethod string, iswildcard bool) error {
+	// if we require a wildcard certificate, we must prioritize any issuewild
+	// tags - only if one of them matches (regardless of any other entries) can
+	// we issue a wildcard certificate. Otherwise, issue tags allow any
+	// certificate.
+	tag := issueTag
+	if iswildcard {
+		for _, caa := range caas {
+			if caa.Tag == issuewildTag {
+				tag = issuewildTag
+				break
+			}
+		}
+	}
+
+	err := ErrCAAIssuer
 	for _, caa := range caas {
-		// if we require a wildcard certificate, we must prioritize any issuewild
-		// tags - only if it matches (regardless of any other entries) can we
-		// issue a wildcard certificate
-		if iswildcard && caa.Tag == issuewildTag {
-			return issuerIDs[caa.Value]
+		if caa.Tag != tag {
+			continue
 		}
 
-		// issue tags allow any certificate, we perform a check which will only
-		// be returned if we do not need a wildcard certificate, or if we need
-		// a wildcard certificate and no issuewild entries are present
-		if caa.Tag == issueTag {
-			matches = matches || issuerIDs[caa.Value]
+		issuerID, params := parseCAAIssuerValue(caa.Value)
+		if !issuerIDs[issuerID] {
+			continue
+		}
+
+		if uri, ok := params[accountURIParam]; ok && accountURI != "" && uri != accountURI {
+			err = fmt.Errorf("%w: CAA record %q only permits account %q, not %q", ErrCAAAccountURI, caa.Value, uri, accountURI)
+			continue
+		}
+
+		if methods, ok := params[validationMethodsParam]; ok && validationMethod != "" && !slices.Contains(strings.Split(methods, ","), validationMethod) {
+			err = fmt.Errorf("%w: CAA record %q only permits %q, not %q", ErrCAAValidationMethod, caa.Value, methods, validationMethod)
+			continue
+		}
+
+		return nil
+	}
+	return err
+}
+
+// parseCAAIssuerValue parses the value of an issue or issuewild CAA property,
+// as defined in RFC 8659 section 4.2, into the issuer domain name and its
+// parameters.
+func parseCAAIssuerValue(value string) (string, map[string]string) {
+	parts := strings.Split(value, ";")
+	params := make(map[string]string, len(parts)-1)
+	for _, part := range parts[1:] {
+		tag, val, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
 		}
+		params[strings.ToLower(strings.TrimSpace(tag))] = strings.TrimSpace(val)
 	}
-	return matches
+	return strings.TrimSpace(parts[0]), params
 }
 
 // lookupNameservers returns the authoritative nameservers for the given fqdn.
@@ -10,6 +10,7 @@ package util
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"sort"
@@ -98,10 +99,13 @@ var checkResolvConfServersTests = []struct {
 
 func TestMatchCAA(t *testing.T) {
 	tests := map[string]struct {
-		caas       []*dns.CAA
-		issuerIDs  map[string]bool
-		isWildcard bool
-		matches    bool
+		caas             []*dns.CAA
+		issuerIDs        map[string]bool
+		accountURI       string
+		validationMethod string
+		isWildcard       bool
+		matches          bool
+		err              error
 	}{
 		"matches with a single 'issue' caa for a non-wildcard domain": {
 			caas:       []*dns.CAA{{Tag: issueTag, Value: "example-ca"}},
@@ -160,14 +164,71 @@ func TestMatchCAA(t *testing.T) {
 			isWildcard: true,
 			matches:    false,
 		},
+		"matches if the issuer domain name has parameters": {
+			caas:       []*dns.CAA{{Tag: issueTag, Value: "example-ca; cansignhttpexchanges=yes"}},
+			issuerIDs:  map[string]bool{"example-ca": true},
+			isWildcard: false,
+			matches:    true,
+		},
+		"matches if the accounturi parameter matches the account": {
+			caas:       []*dns.CAA{{Tag: issueTag, Value: "example-ca; accounturi=https://example-ca/acct/1"}},
+			issuerIDs:  map[string]bool{"example-ca": true},
+			accountURI: "https://example-ca/acct/1",
+			isWildcard: false,
+			matches:    true,
+		},
+		"does not match if the accounturi parameter does not match the account": {
+			caas:       []*dns.CAA{{Tag: issueTag, Value: "example-ca; accounturi=https://example-ca/acct/1"}},
+			issuerIDs:  map[string]bool{"example-ca": true},
+			accountURI: "https://example-ca/acct/2",
+			isWildcard: false,
+			matches:    false,
+			err:        ErrCAAAccountURI,
+		},
+		"matches if another record permits the account": {
+			caas: []*dns.CAA{
+				{Tag: issueTag, Value: "example-ca; accounturi=https://example-ca/acct/1"},
+				{Tag: issueTag, Value: "example-ca; accounturi=https://example-ca/acct/2"},
+			},
+			issuerIDs:  map[string]bool{"example-ca": true},
+			accountURI: "https://example-ca/acct/2",
+			isWildcard: false,
+			matches:    true,
+		},
+		"matches if the validationmethods parameter contains the validation method": {
+			caas:             []*dns.CAA{{Tag: issueTag, Value: "example-ca; validationmethods=http-01,dns-01"}},
+			issuerIDs:        map[string]bool{"example-ca": true},
+			validationMethod: "dns-01",
+			isWildcard:       false,
+			matches:          true,
+		},
+		"does not match if the validationmethods parameter does not contain the validation method": {
+			caas:             []*dns.CAA{{Tag: issueTag, Value: "example-ca; validationmethods=http-01"}},
+			issuerIDs:        map[string]bool{"example-ca": true},
+			validationMethod: "dns-01",
+			isWildcard:       false,
+			matches:          false,
+			err:              ErrCAAValidationMethod,
+		},
+		"reports the issuer mismatch if no record matches the issuer": {
+			caas:             []*dns.CAA{{Tag: issueTag, Value: "not-example-ca; validationmethods=dns-01"}},
+			issuerIDs:        map[string]bool{"example-ca": true},
+			validationMethod: "http-01",
+			isWildcard:       false,
+			matches:          false,
+			err:              ErrCAAIssuer,
+		},
 	}
 
 	for n, test := range tests {
 		t.Run(n, func(t *testing.T) {
-			m := matchCAA(test.caas, test.issuerIDs, test.isWildcard)
-			if test.matches != m {
+			err := matchCAA(test.caas, test.issuerIDs, test.accountURI, test.validationMethod, test.isWildcard)
+			if m := err == nil; test.matches != m {
 				t.Errorf("expected match to equal %t but got %t", test.matches, m)
 			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Errorf("expected error %v but got %v", test.err, err)
+			}
 		})
 	}
 }
@@ -286,25 +347,25 @@ func TestValidateCAA(t *testing.T) {
 		// google installs a CAA record at google.com
 		// ask for the www.google.com record to test that
 		// we recurse up the labels
-		err := ValidateCAA(context.TODO(), "www.google.com", []string{"letsencrypt", "pki.goog"}, false, nameservers)
+		err := ValidateCAA(context.TODO(), "www.google.com", []string{"letsencrypt", "pki.goog"}, "", "", false, nameservers)
 		if err != nil {
 			t.Fatalf("unexpected error: %s", err)
 		}
 		// now ask, expecting a CA that won't match
-		err = ValidateCAA(context.TODO(), "www.google.com", []string{"daniel.homebrew.ca"}, false, nameservers)
+		err = ValidateCAA(context.TODO(), "www.google.com", []string{"daniel.homebrew.ca"}, "", "", false, nameservers)
 		if err == nil {
 			t.Fatalf("expected err, got success")
 		}
 		// if the CAA record allows non-wildcards then it has an `issue` tag,
 		// and it is known that it has no issuewild tags, then wildcard certificates
 		// will also be allowed
-		err = ValidateCAA(context.TODO(), "www.google.com", []string{"pki.goog"}, true, nameservers)
+		err = ValidateCAA(context.TODO(), "www.google.com", []string{"pki.goog"}, "", "", true, nameservers)
 		if err != nil {
 			t.Fatalf("unexpected error: %s", err)
 		}
 		// ask for a domain you know does not have CAA records.
 		// it should succeed
-		err = ValidateCAA(context.TODO(), "www.example.org", []string{"daniel.homebrew.ca"}, false, nameservers)
+		err = ValidateCAA(context.TODO(), "www.example.org", []string{"daniel.homebrew.ca"}, "", "", false, nameservers)
 		if err != nil {
 			t.Fatalf("expected err, got %s", err)
 		}
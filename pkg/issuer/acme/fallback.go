@@ -0,0 +1,182 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"github.com/cert-manager/cert-manager/pkg/acme"
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/errors"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
+)
+
+const (
+	errorFallbackAccountRegistrationFailed = "ErrRegisterACMEFallbackAccount"
+
+	messageTemplateFallbackAccountRegistrationFailed = "Failed to register ACME account with fallback server %q: %v"
+)
+
+// setupFallbackServers registers an ACME account with each of the issuer's
+// fallback servers and stores a client for each of them in the account
+// registry, so that Orders can fail over to them.
+// Failing to set up a fallback server does not affect the readiness of the
+// issuer. Errors that may be resolved by retrying are returned so that the
+// issuer is set up again later.
+func (a *Acme) setupFallbackServers(ctx context.Context, httpClient *http.Client, ns string) error {
+	log := logf.FromContext(ctx)
+
+	spec := a.issuer.GetSpec().ACME
+	status := a.issuer.GetStatus().ACMEStatus()
+	uid := string(a.issuer.GetUID())
+
+	previous := make(map[string]cmacme.ACMEFallbackAccountStatus, len(status.FallbackAccounts))
+	for _, acc := range status.FallbackAccounts {
+		previous[acc.Server] = acc
+	}
+
+	var fallbackAccounts []cmacme.ACMEFallbackAccountStatus
+	var errs []error
+	for _, fs := range spec.FallbackServers {
+		prev, hasPrev := previous[fs.Server]
+		delete(previous, fs.Server)
+
+		acc, err := a.setupFallbackServer(ctx, httpClient, ns, fs, prev)
+		if err != nil {
+			msg := fmt.Sprintf(messageTemplateFallbackAccountRegistrationFailed, fs.Server, err)
+			log.Error(err, "failed to register ACME account with fallback server", "server", fs.Server)
+			a.recorder.Event(a.issuer, corev1.EventTypeWarning, errorFallbackAccountRegistrationFailed, msg)
+			a.accountRegistry.RemoveClient(accounts.ClientID(uid, spec, fs.Server))
+
+			// keep the existing account details, so that the account is
+			// not registered again unnecessarily once the server recovers.
+			if hasPrev {
+				fallbackAccounts = append(fallbackAccounts, prev)
+			}
+			if !isPermanentSetupError(err) {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		fallbackAccounts = append(fallbackAccounts, acc)
+	}
+
+	// remove the clients of fallback servers that are no longer configured
+	for server := range previous {
+		if server != spec.Server {
+			a.accountRegistry.RemoveClient(accounts.ClientID(uid, spec, server))
+		}
+	}
+
+	status.FallbackAccounts = fallbackAccounts
+	return utilerrors.NewAggregate(errs)
+}
+
+// setupFallbackServer verifies or registers the ACME account with the given
+// fallback server and adds a client for it to the account registry.
+// If the account details stored in the issuer status are up to date, the
+// ACME server is not contacted.
+func (a *Acme) setupFallbackServer(ctx context.Context, httpClient *http.Client, ns string, fs cmacme.ACMEFallbackServer, prev cmacme.ACMEFallbackAccountStatus) (cmacme.ACMEFallbackAccountStatus, error) {
+	config := fallbackServerConfig(a.issuer.GetSpec().ACME, fs)
+
+	sel := acme.PrivateKeySelector(fs.PrivateKey)
+	pk, err := a.keyFromSecret(ctx, ns, sel.Name, sel.Key)
+	if !config.DisableAccountKeyGeneration && apierrors.IsNotFound(err) {
+		pk, err = a.createAccountPrivateKey(ctx, sel, config.AccountPrivateKey, ns)
+	}
+	if err != nil {
+		return cmacme.ACMEFallbackAccountStatus{}, err
+	}
+	if !isSupportedAccountKey(pk) {
+		return cmacme.ACMEFallbackAccountStatus{}, errors.NewInvalidData(messageTemplateUnsupportedKey, sel.Name)
+	}
+
+	checksum, err := accounts.PrivateKeyChecksum(pk)
+	if err != nil {
+		return cmacme.ACMEFallbackAccountStatus{}, err
+	}
+
+	clientID := accounts.ClientID(string(a.issuer.GetUID()), a.issuer.GetSpec().ACME, fs.Server)
+	if prev.URI != "" &&
+		prev.LastPrivateKeyHash == checksum &&
+		prev.LastRegisteredEmail == config.Email {
+		a.accountRegistry.AddClient(httpClient, clientID, config, pk, a.userAgent)
+		return prev, nil
+	}
+
+	var eabAccount *acmeapi.ExternalAccountBinding
+	if fs.ExternalAccountBinding != nil {
+		eabKey, err := a.getEABKey(ctx, ns, fs.ExternalAccountBinding.Key)
+		if err != nil {
+			return cmacme.ACMEFallbackAccountStatus{}, err
+		}
+		eabAccount = &acmeapi.ExternalAccountBinding{
+			KID: fs.ExternalAccountBinding.KeyID,
+			Key: eabKey,
+		}
+	}
+
+	cl := a.clientBuilder(httpClient, config, pk, a.userAgent)
+	account, err := a.registerAccount(ctx, cl, eabAccount)
+	if err != nil {
+		return cmacme.ACMEFallbackAccountStatus{}, err
+	}
+	account, registeredEmail, err := ensureEmailUpToDate(ctx, cl, account, config.Email)
+	if err != nil {
+		return cmacme.ACMEFallbackAccountStatus{}, err
+	}
+
+	a.accountRegistry.AddClient(httpClient, clientID, config, pk, a.userAgent)
+	return cmacme.ACMEFallbackAccountStatus{
+		Server:              fs.Server,
+		URI:                 account.URI,
+		LastRegisteredEmail: registeredEmail,
+		LastPrivateKeyHash:  checksum,
+	}, nil
+}
+
+// fallbackServerConfig returns the configuration used for the account with
+// the given fallback server. The fallback server shares all settings with the
+// issuer except for the server URL and the account credentials.
+func fallbackServerConfig(spec *cmacme.ACMEIssuer, fs cmacme.ACMEFallbackServer) cmacme.ACMEIssuer {
+	config := *spec
+	config.Server = fs.Server
+	config.PrivateKey = fs.PrivateKey
+	config.ExternalAccountBinding = fs.ExternalAccountBinding
+	config.NextPrivateKey = nil
+	config.FallbackServers = nil
+	return config
+}
+
+// isPermanentSetupError returns true if retrying will not resolve the error,
+// because the configuration is invalid or the ACME server rejected the
+// request.
+func isPermanentSetupError(err error) bool {
+	if apierrors.IsNotFound(err) || errors.IsInvalidData(err) {
+		return true
+	}
+	acmeErr, ok := err.(*acmeapi.Error)
+	return ok && acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500
+}
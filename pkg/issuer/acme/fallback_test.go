@@ -0,0 +1,194 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"crypto"
+	"net/http"
+	"reflect"
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	fakeregistry "github.com/cert-manager/cert-manager/pkg/acme/accounts/test"
+	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
+)
+
+func TestAcme_SetupFallbackServers(t *testing.T) {
+	const (
+		primary    = "https://primary.example.com/directory"
+		fallback   = "https://fallback.example.com/directory"
+		removed    = "https://removed.example.com/directory"
+		accountURI = "https://fallback.example.com/acct/1"
+		email      = "test@example.com"
+	)
+
+	key := mustGenerateEDCSAKey(t)
+	checksum, err := accounts.PrivateKeyChecksum(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fallbackServer := cmacme.ACMEFallbackServer{
+		Server: fallback,
+		PrivateKey: cmmeta.SecretKeySelector{
+			LocalObjectReference: cmmeta.LocalObjectReference{Name: "fallback-key"},
+		},
+	}
+	registered := cmacme.ACMEFallbackAccountStatus{
+		Server:              fallback,
+		URI:                 accountURI,
+		LastRegisteredEmail: email,
+		LastPrivateKeyHash:  checksum,
+	}
+
+	tests := map[string]struct {
+		previous    []cmacme.ACMEFallbackAccountStatus
+		kfsErr      error
+		registerErr error
+
+		expectErr      bool
+		expectRegister bool
+		expectAdded    []string
+		expectRemoved  []string
+		expectStatus   []cmacme.ACMEFallbackAccountStatus
+		expectEvent    bool
+	}{
+		"register an account with the fallback server": {
+			expectRegister: true,
+			expectAdded:    []string{fallback},
+			expectStatus:   []cmacme.ACMEFallbackAccountStatus{registered},
+		},
+		"do not register the account again if the status is up to date": {
+			previous:     []cmacme.ACMEFallbackAccountStatus{registered},
+			expectAdded:  []string{fallback},
+			expectStatus: []cmacme.ACMEFallbackAccountStatus{registered},
+		},
+		"remove the clients of fallback servers that are no longer configured": {
+			previous:      []cmacme.ACMEFallbackAccountStatus{registered, {Server: removed, URI: accountURI}},
+			expectAdded:   []string{fallback},
+			expectRemoved: []string{removed},
+			expectStatus:  []cmacme.ACMEFallbackAccountStatus{registered},
+		},
+		"keep the previous status and retry if the fallback server is unavailable": {
+			previous:       []cmacme.ACMEFallbackAccountStatus{{Server: fallback, URI: accountURI, LastRegisteredEmail: "old@example.com"}},
+			registerErr:    &acmeapi.Error{StatusCode: 503},
+			expectErr:      true,
+			expectRegister: true,
+			expectRemoved:  []string{fallback},
+			expectStatus:   []cmacme.ACMEFallbackAccountStatus{{Server: fallback, URI: accountURI, LastRegisteredEmail: "old@example.com"}},
+			expectEvent:    true,
+		},
+		"do not retry if the fallback server rejects the account": {
+			registerErr:    &acmeapi.Error{StatusCode: 400},
+			expectRegister: true,
+			expectRemoved:  []string{fallback},
+			expectEvent:    true,
+		},
+		"do not retry if the account key cannot be loaded and generation is disabled": {
+			kfsErr:        apierrors.NewNotFound(corev1.Resource("secrets"), "fallback-key"),
+			expectRemoved: []string{fallback},
+			expectEvent:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			iss := gen.Issuer("test",
+				gen.SetIssuerACME(cmacme.ACMEIssuer{
+					Server:                      primary,
+					Email:                       email,
+					DisableAccountKeyGeneration: true,
+					FallbackServers:             []cmacme.ACMEFallbackServer{fallbackServer},
+				}),
+			)
+			iss.Status.ACME = &cmacme.ACMEIssuerStatus{FallbackAccounts: test.previous}
+			spec := iss.GetSpec().ACME
+
+			var added, removed []string
+			ar := &fakeregistry.FakeRegistry{
+				AddClientFunc: func(id string, config cmacme.ACMEIssuer, _ crypto.Signer, _ string) {
+					if config.Server != fallback {
+						t.Errorf("expected client for server %q to be added, got %q", fallback, config.Server)
+					}
+					added = append(added, id)
+				},
+				RemoveClientFunc: func(id string) {
+					removed = append(removed, id)
+				},
+			}
+
+			registerCalled := false
+			cl := &acmecl.FakeACME{
+				FakeRegister: func(_ context.Context, acc *acmeapi.Account, _ func(string) bool) (*acmeapi.Account, error) {
+					registerCalled = true
+					if test.registerErr != nil {
+						return nil, test.registerErr
+					}
+					return &acmeapi.Account{URI: accountURI, Contact: acc.Contact}, nil
+				},
+			}
+
+			kfsCalled := false
+			recorder := new(controllertest.FakeRecorder)
+			a := Acme{
+				issuer:          iss,
+				accountRegistry: ar,
+				keyFromSecret:   keyFromSecretMockBuilder(&kfsCalled, key, test.kfsErr),
+				clientBuilder:   clientBuilderMock(cl),
+				recorder:        recorder,
+			}
+
+			err := a.setupFallbackServers(context.Background(), http.DefaultClient, "ns")
+			if (err != nil) != test.expectErr {
+				t.Errorf("expected error: %v, got: %v", test.expectErr, err)
+			}
+			if registerCalled != test.expectRegister {
+				t.Errorf("expected Register to be called: %v, was called: %v", test.expectRegister, registerCalled)
+			}
+
+			clientIDs := func(servers []string) []string {
+				var ids []string
+				for _, server := range servers {
+					ids = append(ids, accounts.ClientID(string(iss.UID), spec, server))
+				}
+				return ids
+			}
+			if !slices.Equal(added, clientIDs(test.expectAdded)) {
+				t.Errorf("expected clients %v to be added, got %v", clientIDs(test.expectAdded), added)
+			}
+			if !slices.Equal(removed, clientIDs(test.expectRemoved)) {
+				t.Errorf("expected clients %v to be removed, got %v", clientIDs(test.expectRemoved), removed)
+			}
+			if got := iss.Status.ACME.FallbackAccounts; !reflect.DeepEqual(got, test.expectStatus) {
+				t.Errorf("expected fallback account status %v, got %v", test.expectStatus, got)
+			}
+			if gotEvent := len(recorder.Events) > 0; gotEvent != test.expectEvent {
+				t.Errorf("expected an event to be recorded: %v, got: %v", test.expectEvent, recorder.Events)
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"github.com/cert-manager/cert-manager/pkg/acme"
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	cmerrors "github.com/cert-manager/cert-manager/pkg/util/errors"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
+)
+
+const (
+	successAccountDeactivated = "ACMEAccountDeactivated"
+	errorAccountDeactivation  = "ErrDeactivateACMEAccount"
+
+	messageTemplateAccountDeactivated          = "The ACME account with %q was deactivated"
+	messageTemplateAccountDeactivationGaveUp   = "Giving up deactivating the ACME account with %q: %v"
+	messageTemplateAccountDeactivationTimedOut = "Giving up deactivating the ACME account with %q after %s: %v"
+
+	// accountDeactivationTimeout is how long cert-manager keeps trying to
+	// deactivate an ACME account after its issuer has been deleted, before
+	// giving up so that the deletion of the issuer is not blocked forever.
+	accountDeactivationTimeout = time.Hour
+)
+
+var _ issuer.Finalizer = &Acme{}
+
+// NeedsFinalizer returns true if the ACME account should be deactivated when
+// the issuer is deleted.
+func (a *Acme) NeedsFinalizer() bool {
+	switch a.issuer.GetSpec().ACME.AccountDeletionPolicy {
+	case cmacme.DeactivateACMEAccountDeletionPolicy,
+		cmacme.DeactivateAndDeleteSecretACMEAccountDeletionPolicy:
+		return true
+	default:
+		return false
+	}
+}
+
+// finalizedAccount is an ACME account of the issuer, with either its primary
+// server or one of its fallback servers, that is cleaned up when the issuer is
+// deleted.
+type finalizedAccount struct {
+	// clientID is the ID of the account's client in the account registry.
+	clientID string
+	// config is the issuer configuration used for the account.
+	config cmacme.ACMEIssuer
+	// uri is the URI of the registered account, empty if the account has not
+	// been registered.
+	uri string
+}
+
+// Finalize deactivates the ACME accounts of the issuer, with its primary
+// server and any fallback servers, removes their clients from the account
+// registry and, depending on the account deletion policy, deletes the account
+// private key Secrets.
+// Accounts whose private key Secret is also used by other issuers are neither
+// deactivated nor deleted.
+func (a *Acme) Finalize(ctx context.Context) error {
+	spec := a.issuer.GetSpec().ACME
+	status := a.issuer.GetStatus().ACMEStatus()
+	uid := string(a.issuer.GetUID())
+
+	ns := a.issuer.GetObjectMeta().Namespace
+	if ns == "" {
+		ns = a.clusterResourceNamespace
+	}
+
+	finalizedAccounts := []finalizedAccount{{clientID: uid, config: *spec, uri: status.URI}}
+	for _, fs := range spec.FallbackServers {
+		acc := finalizedAccount{
+			clientID: accounts.ClientID(uid, spec, fs.Server),
+			config:   fallbackServerConfig(spec, fs),
+		}
+		for _, fa := range status.FallbackAccounts {
+			if fa.Server == fs.Server {
+				acc.uri = fa.URI
+			}
+		}
+		finalizedAccounts = append(finalizedAccounts, acc)
+	}
+
+	var errs []error
+	for _, acc := range finalizedAccounts {
+		if err := a.finalizeAccount(ctx, ns, acc); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// finalizeAccount deactivates the given ACME account, removes its client from
+// the account registry and, depending on the account deletion policy, deletes
+// its private key Secret.
+func (a *Acme) finalizeAccount(ctx context.Context, ns string, acc finalizedAccount) error {
+	log := logf.FromContext(ctx).WithValues("server", acc.config.Server)
+	sel := acme.PrivateKeySelector(acc.config.PrivateKey)
+
+	shared, err := a.accountKeyShared(ctx, ns, sel.Name)
+	if err != nil {
+		return err
+	}
+	if shared {
+		log.V(logf.InfoLevel).Info("not deactivating ACME account as its private key secret is used by other issuers")
+		a.accountRegistry.RemoveClient(acc.clientID)
+		return nil
+	}
+
+	if acc.uri != "" {
+		if err := a.deactivateAccount(logf.NewContext(ctx, log), ns, sel, acc); err != nil {
+			return err
+		}
+	}
+	a.accountRegistry.RemoveClient(acc.clientID)
+
+	if acc.config.AccountDeletionPolicy == cmacme.DeactivateAndDeleteSecretACMEAccountDeletionPolicy && !acc.config.DisableAccountKeyGeneration {
+		err := a.secretsClient.Secrets(ns).Delete(ctx, sel.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		log.V(logf.InfoLevel).Info("deleted ACME account private key secret", "secret", sel.Name)
+	}
+
+	return nil
+}
+
+// deactivateAccount deactivates the given ACME account.
+// If the account private key can no longer be loaded, the account cannot be
+// used anymore either and is left as is. If the ACME server rejects the
+// request, or the account could not be deactivated within
+// accountDeactivationTimeout of the issuer's deletion, cert-manager gives up
+// and leaves the account as is so that the issuer can still be deleted.
+func (a *Acme) deactivateAccount(ctx context.Context, ns string, sel cmmeta.SecretKeySelector, acc finalizedAccount) error {
+	log := logf.FromContext(ctx)
+
+	cl, err := a.accountRegistry.GetClient(acc.clientID)
+	switch {
+	case errors.Is(err, accounts.ErrNotFound):
+		pk, err := a.keyFromSecret(ctx, ns, sel.Name, sel.Key)
+		if apierrors.IsNotFound(err) || cmerrors.IsInvalidData(err) {
+			log.V(logf.InfoLevel).Info("not deactivating ACME account as its private key is not available", "error", err.Error())
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		httpClient := accounts.BuildHTTPClientWithCABundle(a.metrics, acc.config.SkipTLSVerify, acc.config.CABundle)
+		cl = a.clientBuilder(httpClient, acc.config, pk, a.userAgent)
+	case err != nil:
+		return err
+	}
+
+	err = cl.DeactivateReg(ctx)
+	switch {
+	case err == nil:
+		log.V(logf.InfoLevel).Info("deactivated ACME account")
+		a.recorder.Eventf(a.issuer, corev1.EventTypeNormal, successAccountDeactivated, messageTemplateAccountDeactivated, acc.config.Server)
+		return nil
+
+	case errors.Is(err, acmeapi.ErrNoAccount):
+		// the account has already been deactivated, e.g. by a previous
+		// attempt whose response was lost
+		return nil
+
+	case isPermanentDeactivationError(err):
+		log.Error(err, "giving up deactivating ACME account as the ACME server rejected the request")
+		a.recorder.Eventf(a.issuer, corev1.EventTypeWarning, errorAccountDeactivation, messageTemplateAccountDeactivationGaveUp, acc.config.Server, err)
+		return nil
+	}
+
+	if deletionTimestamp := a.issuer.GetObjectMeta().DeletionTimestamp; deletionTimestamp != nil &&
+		a.clock.Since(deletionTimestamp.Time) >= accountDeactivationTimeout {
+		log.Error(err, "giving up deactivating ACME account", "timeout", accountDeactivationTimeout)
+		a.recorder.Eventf(a.issuer, corev1.EventTypeWarning, errorAccountDeactivation, messageTemplateAccountDeactivationTimedOut, acc.config.Server, accountDeactivationTimeout, err)
+		return nil
+	}
+
+	return err
+}
+
+// isPermanentDeactivationError returns true if the ACME server rejected the
+// request to deactivate an account in a way that will not be resolved by
+// retrying, for example because the account does not exist anymore.
+func isPermanentDeactivationError(err error) bool {
+	acmeErr, ok := err.(*acmeapi.Error)
+	return ok && acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 &&
+		acmeErr.StatusCode != http.StatusTooManyRequests
+}
+
+// accountKeyShared returns true if an issuer other than this one, which is not
+// being deleted itself, uses the account private key Secret with the given
+// name in the given namespace, for its primary or any of its fallback servers.
+func (a *Acme) accountKeyShared(ctx context.Context, ns, secretName string) (bool, error) {
+	uses := func(iss v1.GenericIssuer) bool {
+		if iss.GetUID() == a.issuer.GetUID() ||
+			iss.GetObjectMeta().DeletionTimestamp != nil ||
+			iss.GetSpec().ACME == nil {
+			return false
+		}
+		if iss.GetSpec().ACME.PrivateKey.Name == secretName {
+			return true
+		}
+		for _, fs := range iss.GetSpec().ACME.FallbackServers {
+			if fs.PrivateKey.Name == secretName {
+				return true
+			}
+		}
+		return false
+	}
+
+	issuers, err := a.cmClient.CertmanagerV1().Issuers(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+	for i := range issuers.Items {
+		if uses(&issuers.Items[i]) {
+			return true, nil
+		}
+	}
+
+	if ns != a.clusterResourceNamespace {
+		return false, nil
+	}
+	clusterIssuers, err := a.cmClient.CertmanagerV1().ClusterIssuers().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+	for i := range clusterIssuers.Items {
+		if uses(&clusterIssuers.Items[i]) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
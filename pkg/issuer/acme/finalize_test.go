@@ -0,0 +1,333 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	fakeregistry "github.com/cert-manager/cert-manager/pkg/acme/accounts/test"
+	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmfake "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/fake"
+	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
+)
+
+func TestAcme_NeedsFinalizer(t *testing.T) {
+	tests := map[cmacme.ACMEAccountDeletionPolicy]bool{
+		"":                                     false,
+		cmacme.RetainACMEAccountDeletionPolicy: false,
+		cmacme.DeactivateACMEAccountDeletionPolicy:                true,
+		cmacme.DeactivateAndDeleteSecretACMEAccountDeletionPolicy: true,
+	}
+	for policy, expected := range tests {
+		a := Acme{issuer: gen.Issuer("test", gen.SetIssuerACMEAccountDeletionPolicy(policy))}
+		if got := a.NeedsFinalizer(); got != expected {
+			t.Errorf("policy %q: expected NeedsFinalizer to return %v, got %v", policy, expected, got)
+		}
+	}
+}
+
+func TestAcme_Finalize(t *testing.T) {
+	const (
+		namespace          = "test-ns"
+		secretName         = "account-key"
+		accountURI         = "https://acme.example.com/acct/1"
+		fallbackServer     = "https://fallback.example.com/directory"
+		fallbackSecretName = "fallback-account-key"
+		fallbackClientID   = "test/" + fallbackServer
+	)
+	var (
+		now     = time.Now()
+		someErr = errors.New("some error")
+
+		accountKeySecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: namespace},
+		}
+		fallbackAccountKeySecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: fallbackSecretName, Namespace: namespace},
+		}
+
+		baseIssuer = gen.Issuer("test-issuer",
+			gen.SetIssuerNamespace(namespace),
+			gen.SetIssuerACMEURL(acmev2Prod),
+			gen.SetIssuerACMEPrivKeyRef(secretName),
+			gen.SetIssuerACMEAccountURL(accountURI),
+			gen.SetIssuerACMEAccountDeletionPolicy(cmacme.DeactivateACMEAccountDeletionPolicy),
+			func(iss cmapi.GenericIssuer) {
+				iss.GetObjectMeta().DeletionTimestamp = &metav1.Time{Time: now}
+			})
+
+		deactivatedEvent = "Normal ACMEAccountDeactivated The ACME account with \"" + acmev2Prod + "\" was deactivated"
+	)
+
+	withFallbackServer := func(iss cmapi.GenericIssuer) {
+		iss.GetSpec().ACME.FallbackServers = []cmacme.ACMEFallbackServer{{
+			Server:     fallbackServer,
+			PrivateKey: cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: fallbackSecretName}},
+		}}
+		iss.GetStatus().ACMEStatus().FallbackAccounts = []cmacme.ACMEFallbackAccountStatus{{
+			Server: fallbackServer,
+			URI:    "https://fallback.example.com/acct/1",
+		}}
+	}
+
+	// otherIssuer uses the same account key Secret as baseIssuer
+	otherIssuer := baseIssuer.DeepCopy()
+	otherIssuer.Name = "other-issuer"
+	otherIssuer.UID = "other"
+	otherIssuer.DeletionTimestamp = nil
+
+	tests := map[string]struct {
+		issuer *cmapi.Issuer
+		// other issuers that exist in the cluster
+		otherIssuers []runtime.Object
+		// whether the account registry has clients for the issuer
+		clientCached bool
+		// error returned by the keyFromSecret stub
+		kfsErr        error
+		deactivateErr error
+
+		expectDeactivated  []string
+		expectRemoved      []string
+		expectSecretExists bool
+		expectEvents       []string
+		expectErr          bool
+	}{
+		"deactivate the account using the cached client": {
+			issuer:             baseIssuer,
+			clientCached:       true,
+			expectDeactivated:  []string{"test"},
+			expectRemoved:      []string{"test"},
+			expectSecretExists: true,
+			expectEvents:       []string{deactivatedEvent},
+		},
+		"deactivate the account using a new client if none is cached": {
+			issuer:             baseIssuer,
+			expectDeactivated:  []string{"test"},
+			expectRemoved:      []string{"test"},
+			expectSecretExists: true,
+			expectEvents:       []string{deactivatedEvent},
+		},
+		"deactivate the account and delete the account key secret": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEAccountDeletionPolicy(cmacme.DeactivateAndDeleteSecretACMEAccountDeletionPolicy)),
+			clientCached:      true,
+			expectDeactivated: []string{"test"},
+			expectRemoved:     []string{"test"},
+			expectEvents:      []string{deactivatedEvent},
+		},
+		"do not delete a user provided account key secret": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEAccountDeletionPolicy(cmacme.DeactivateAndDeleteSecretACMEAccountDeletionPolicy),
+				gen.SetIssuerACMEDisableAccountKeyGeneration(true)),
+			clientCached:       true,
+			expectDeactivated:  []string{"test"},
+			expectRemoved:      []string{"test"},
+			expectSecretExists: true,
+			expectEvents:       []string{deactivatedEvent},
+		},
+		"deactivate the accounts with fallback servers": {
+			issuer:             gen.IssuerFrom(baseIssuer, withFallbackServer),
+			clientCached:       true,
+			expectDeactivated:  []string{"test", fallbackClientID},
+			expectRemoved:      []string{"test", fallbackClientID},
+			expectSecretExists: true,
+			expectEvents: []string{
+				deactivatedEvent,
+				"Normal ACMEAccountDeactivated The ACME account with \"" + fallbackServer + "\" was deactivated",
+			},
+		},
+		"deactivate the accounts with fallback servers using new clients if none are cached": {
+			issuer:             gen.IssuerFrom(baseIssuer, withFallbackServer),
+			expectDeactivated:  []string{"test", fallbackClientID},
+			expectRemoved:      []string{"test", fallbackClientID},
+			expectSecretExists: true,
+			expectEvents: []string{
+				deactivatedEvent,
+				"Normal ACMEAccountDeactivated The ACME account with \"" + fallbackServer + "\" was deactivated",
+			},
+		},
+		"account has already been deactivated": {
+			issuer:             baseIssuer,
+			clientCached:       true,
+			deactivateErr:      acmeapi.ErrNoAccount,
+			expectDeactivated:  []string{"test"},
+			expectRemoved:      []string{"test"},
+			expectSecretExists: true,
+		},
+		"give up if the ACME server rejects the deactivation": {
+			issuer:             baseIssuer,
+			clientCached:       true,
+			deactivateErr:      &acmeapi.Error{StatusCode: http.StatusBadRequest, ProblemType: "urn:ietf:params:acme:error:accountDoesNotExist"},
+			expectDeactivated:  []string{"test"},
+			expectRemoved:      []string{"test"},
+			expectSecretExists: true,
+			expectEvents:       []string{"Warning ErrDeactivateACMEAccount Giving up deactivating the ACME account with \"" + acmev2Prod + "\": 400 urn:ietf:params:acme:error:accountDoesNotExist: "},
+		},
+		"deactivating the account fails": {
+			issuer:             baseIssuer,
+			clientCached:       true,
+			deactivateErr:      someErr,
+			expectDeactivated:  []string{"test"},
+			expectSecretExists: true,
+			expectErr:          true,
+		},
+		"deactivating the account is rate limited": {
+			issuer:             baseIssuer,
+			clientCached:       true,
+			deactivateErr:      &acmeapi.Error{StatusCode: http.StatusTooManyRequests},
+			expectDeactivated:  []string{"test"},
+			expectSecretExists: true,
+			expectErr:          true,
+		},
+		"give up deactivating the account once the timeout has passed": {
+			issuer: gen.IssuerFrom(baseIssuer, func(iss cmapi.GenericIssuer) {
+				iss.GetObjectMeta().DeletionTimestamp = &metav1.Time{Time: now.Add(-accountDeactivationTimeout)}
+			}),
+			clientCached:       true,
+			deactivateErr:      someErr,
+			expectDeactivated:  []string{"test"},
+			expectRemoved:      []string{"test"},
+			expectSecretExists: true,
+			expectEvents:       []string{"Warning ErrDeactivateACMEAccount Giving up deactivating the ACME account with \"" + acmev2Prod + "\" after 1h0m0s: some error"},
+		},
+		"deactivate the fallback account if deactivating the primary account fails": {
+			issuer:             gen.IssuerFrom(baseIssuer, withFallbackServer),
+			clientCached:       true,
+			deactivateErr:      someErr,
+			expectDeactivated:  []string{"test", fallbackClientID},
+			expectRemoved:      []string{fallbackClientID},
+			expectSecretExists: true,
+			expectEvents:       []string{"Normal ACMEAccountDeactivated The ACME account with \"" + fallbackServer + "\" was deactivated"},
+			expectErr:          true,
+		},
+		"account key is used by another issuer": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEAccountDeletionPolicy(cmacme.DeactivateAndDeleteSecretACMEAccountDeletionPolicy)),
+			otherIssuers:       []runtime.Object{otherIssuer},
+			clientCached:       true,
+			expectRemoved:      []string{"test"},
+			expectSecretExists: true,
+		},
+		"account is not registered": {
+			issuer:             gen.IssuerFrom(baseIssuer, gen.SetIssuerACMEAccountURL("")),
+			expectRemoved:      []string{"test"},
+			expectSecretExists: true,
+		},
+		"account key secret does not exist": {
+			issuer:             baseIssuer,
+			kfsErr:             apierrors.NewNotFound(corev1.Resource("secrets"), secretName),
+			expectRemoved:      []string{"test"},
+			expectSecretExists: true,
+		},
+		"loading the account key fails": {
+			issuer:             baseIssuer,
+			kfsErr:             someErr,
+			expectSecretExists: true,
+			expectErr:          true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			iss := test.issuer.DeepCopy()
+			iss.UID = "test"
+
+			var deactivated, removed []string
+			clientFor := func(clientID string) acmecl.Interface {
+				return &acmecl.FakeACME{
+					FakeDeactivateReg: func(context.Context) error {
+						deactivated = append(deactivated, clientID)
+						if clientID == fallbackClientID {
+							return nil
+						}
+						return test.deactivateErr
+					},
+				}
+			}
+
+			ar := &fakeregistry.FakeRegistry{
+				GetClientFunc: func(clientID string) (acmecl.Interface, error) {
+					if !test.clientCached {
+						return nil, accounts.ErrNotFound
+					}
+					return clientFor(clientID), nil
+				},
+				RemoveClientFunc: func(clientID string) {
+					removed = append(removed, clientID)
+				},
+			}
+
+			kubeClient := kubefake.NewSimpleClientset(accountKeySecret, fallbackAccountKeySecret)
+			recorder := new(controllertest.FakeRecorder)
+			a := Acme{
+				issuer:          iss,
+				secretsClient:   kubeClient.CoreV1(),
+				cmClient:        cmfake.NewSimpleClientset(append([]runtime.Object{iss}, test.otherIssuers...)...),
+				recorder:        recorder,
+				accountRegistry: ar,
+				keyFromSecret: func(context.Context, string, string, string) (crypto.Signer, error) {
+					if test.kfsErr != nil {
+						return nil, test.kfsErr
+					}
+					return mustGenerateEDCSAKey(t), nil
+				},
+				clientBuilder: func(_ *http.Client, config cmacme.ACMEIssuer, _ crypto.Signer, _ string) acmecl.Interface {
+					return clientFor(accounts.ClientID(string(iss.UID), iss.Spec.ACME, config.Server))
+				},
+				clock: fakeclock.NewFakeClock(now),
+			}
+
+			err := a.Finalize(context.Background())
+			if (err != nil) != test.expectErr {
+				t.Fatalf("expected error: %v, got: %v", test.expectErr, err)
+			}
+			if !slices.Equal(deactivated, test.expectDeactivated) {
+				t.Errorf("expected accounts %v to be deactivated, got %v", test.expectDeactivated, deactivated)
+			}
+			if !slices.Equal(removed, test.expectRemoved) {
+				t.Errorf("expected clients %v to be removed, got %v", test.expectRemoved, removed)
+			}
+
+			_, err = kubeClient.CoreV1().Secrets(namespace).Get(context.Background(), secretName, metav1.GetOptions{})
+			if secretExists := !apierrors.IsNotFound(err); secretExists != test.expectSecretExists {
+				t.Errorf("expected account key secret to exist: %v, exists: %v", test.expectSecretExists, secretExists)
+			}
+
+			if !slices.Equal(test.expectEvents, recorder.Events) {
+				t.Errorf("expected events %v, got %v", test.expectEvents, recorder.Events)
+			}
+		})
+	}
+}
@@ -34,6 +34,7 @@ import (
 	k8snet "k8s.io/utils/net"
 	gwapilisters "sigs.k8s.io/gateway-api/pkg/client/listers/apis/v1"
 
+	"github.com/cert-manager/cert-manager/pkg/acme"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller"
@@ -73,7 +74,7 @@ type Solver struct {
 	requiredPasses   int
 }
 
-type reachabilityTest func(ctx context.Context, url *url.URL, key string, dnsServers []string, userAgent string) error
+type reachabilityTest func(ctx context.Context, url *url.URL, key string, dnsServers []string, userAgent string, perspective *cmacme.ACMEChallengeSolverHTTP01SelfCheckPerspective) error
 
 // NewSolver returns a new ACME HTTP01 solver for the given *controller.Context.
 func NewSolver(ctx *controller.Context) (*Solver, error) {
@@ -103,16 +104,25 @@ func getServiceType(ch *cmacme.Challenge) (corev1.ServiceType, error) {
 	if ch.Spec.Solver.HTTP01 != nil && ch.Spec.Solver.HTTP01.GatewayHTTPRoute != nil {
 		return ch.Spec.Solver.HTTP01.GatewayHTTPRoute.ServiceType, nil
 	}
-	return "", fmt.Errorf("neither HTTP01 Ingress nor Gateway solvers were found")
+	if ch.Spec.Solver.TLSALPN01 != nil {
+		return ch.Spec.Solver.TLSALPN01.ServiceType, nil
+	}
+	return "", fmt.Errorf("neither HTTP01 Ingress nor Gateway nor TLS-ALPN-01 solvers were found")
 }
 
 // Present will realise the resources required to solve the given HTTP01
 // challenge validation in the apiserver. If those resources already exist, it
 // will return nil (i.e. this function is idempotent).
+// If a shared solver Service is configured, only a path on the shared ingress
+// is required and no solver pod or service is created for the challenge.
 func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
 	log := logf.FromContext(ctx).WithName(loggerName)
 	ctx = logf.NewContext(ctx, log)
 
+	if s.usesSharedSolver(ch) {
+		return s.ensureSharedIngress(ctx, ch)
+	}
+
 	podErr := s.ensurePod(ctx, ch)
 	svcName, svcErr := s.ensureService(ctx, ch)
 	if svcErr != nil {
@@ -169,7 +179,12 @@ func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.
 
 	log.V(logf.DebugLevel).Info("running self check multiple times to ensure challenge has propagated", "required_passes", s.requiredPasses)
 	for i := 0; i < s.requiredPasses; i++ {
-		err := s.testReachability(ctx, url, ch.Spec.Key, s.HTTP01SolverNameservers, s.Context.RESTConfig.UserAgent)
+		var err error
+		if selfCheck := http01SelfCheckConfig(ch); selfCheck != nil && len(selfCheck.Perspectives) > 0 {
+			err = s.checkPerspectives(ctx, ch, url, selfCheck)
+		} else {
+			err = s.testReachability(ctx, url, ch.Spec.Key, s.HTTP01SolverNameservers, s.Context.RESTConfig.UserAgent, nil)
+		}
 		if err != nil {
 			return err
 		}
@@ -186,13 +201,36 @@ func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.
 	return nil
 }
 
+// checkPerspectives tests the reachability of the challenge from each of the
+// perspectives of the given self check.
+func (s *Solver) checkPerspectives(ctx context.Context, ch *cmacme.Challenge, url *url.URL, selfCheck *cmacme.ACMEChallengeSolverHTTP01SelfCheck) error {
+	names := make([]string, len(selfCheck.Perspectives))
+	for i, perspective := range selfCheck.Perspectives {
+		names[i] = perspective.Name
+	}
+	return acme.CheckPerspectives(ctx, ch, names, selfCheck.Quorum, func(ctx context.Context, i int) error {
+		return s.testReachability(ctx, url, ch.Spec.Key, s.HTTP01SolverNameservers, s.Context.RESTConfig.UserAgent, &selfCheck.Perspectives[i])
+	})
+}
+
+func http01SelfCheckConfig(ch *cmacme.Challenge) *cmacme.ACMEChallengeSolverHTTP01SelfCheck {
+	if ch.Spec.Solver.HTTP01 == nil {
+		return nil
+	}
+	return ch.Spec.Solver.HTTP01.SelfCheck
+}
+
 // CleanUp will ensure the created service, ingress and pod are clean/deleted of any
-// cert-manager created data.
+// cert-manager created data. When the shared solver is used, the challenge path
+// is removed from the shared ingress.
 func (s *Solver) CleanUp(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
 	var errs []error
 	errs = append(errs, s.cleanupPods(ctx, ch))
 	errs = append(errs, s.cleanupServices(ctx, ch))
 	errs = append(errs, s.cleanupIngresses(ctx, ch))
+	if s.usesSharedSolver(ch) {
+		errs = append(errs, s.cleanupSharedIngress(ctx, ch))
+	}
 	return utilerrors.NewAggregate(errs)
 }
 
@@ -211,8 +249,9 @@ func (s *Solver) buildChallengeUrl(ch *cmacme.Challenge) *url.URL {
 }
 
 // testReachability will attempt to connect to the 'domain' with 'path' and
-// check if the returned body equals 'key'
-func testReachability(ctx context.Context, url *url.URL, key string, dnsServers []string, userAgent string) error {
+// check if the returned body equals 'key'. If a perspective is given, the
+// connection is made through its proxy and from its source address.
+func testReachability(ctx context.Context, url *url.URL, key string, dnsServers []string, userAgent string, perspective *cmacme.ACMEChallengeSolverHTTP01SelfCheckPerspective) error {
 	log := logf.FromContext(ctx)
 	log.V(logf.DebugLevel).Info("performing HTTP01 reachability check")
 
@@ -270,25 +309,24 @@ func testReachability(ctx context.Context, url *url.URL, key string, dnsServers
 		},
 	}
 
-	if len(dnsServers) != 0 {
-		transport.DialContext = func(ctx context.Context, network, addr string) (conn net.Conn, err error) {
-			// we need to increment a counter to iterate through the dns servers as the dialer will not
-			// return an error if the dns server is not responding.
-			counter := 0
-			dialer := &net.Dialer{
-				Timeout: 3 * time.Second,
-				Resolver: &net.Resolver{
-					PreferGo: true,
-					Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
-						d := net.Dialer{
-							Timeout: 3 * time.Second,
-						}
-						s := dnsServers[counter%len(dnsServers)]
-						counter++
-						return d.DialContext(ctx, network, s)
-					},
-				},
+	var sourceAddr net.Addr
+	if perspective != nil {
+		if perspective.ProxyURL != "" {
+			proxy, err := perspectiveProxy(perspective.ProxyURL)
+			if err != nil {
+				return err
 			}
+			transport.Proxy = proxy
+		}
+		if perspective.SourceAddress != "" {
+			sourceAddr = &net.TCPAddr{IP: net.ParseIP(perspective.SourceAddress)}
+		}
+	}
+
+	if len(dnsServers) != 0 || sourceAddr != nil {
+		transport.DialContext = func(ctx context.Context, network, addr string) (conn net.Conn, err error) {
+			dialer := nameserverDialer(dnsServers)
+			dialer.LocalAddr = sourceAddr
 			return dialer.DialContext(ctx, network, addr)
 		}
 	}
@@ -331,3 +369,40 @@ func testReachability(ctx context.Context, url *url.URL, key string, dnsServers
 
 	return nil
 }
+
+// perspectiveProxy returns the proxy function of an HTTP transport which sends
+// all requests through the given proxy URL.
+func perspectiveProxy(proxyURL string) (func(*http.Request) (*url.URL, error), error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid self check proxy URL %q: %v", proxyURL, err)
+	}
+	return http.ProxyURL(u), nil
+}
+
+// nameserverDialer returns a dialer which resolves names using the given DNS
+// servers, or using the system resolver if no DNS servers are given.
+func nameserverDialer(dnsServers []string) *net.Dialer {
+	dialer := &net.Dialer{
+		Timeout: 3 * time.Second,
+	}
+	if len(dnsServers) == 0 {
+		return dialer
+	}
+
+	// we need to increment a counter to iterate through the dns servers as the dialer will not
+	// return an error if the dns server is not responding.
+	counter := 0
+	dialer.Resolver = &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			d := net.Dialer{
+				Timeout: 3 * time.Second,
+			}
+			s := dnsServers[counter%len(dnsServers)]
+			counter++
+			return d.DialContext(ctx, network, s)
+		},
+	}
+	return dialer
+}
@@ -21,12 +21,14 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"reflect"
 	"strings"
 	"sync/atomic"
 	"testing"
 
 	"github.com/miekg/dns"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
 
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller"
@@ -35,9 +37,38 @@ import (
 // countReachabilityTestCalls is a wrapper function that allows us to count the number
 // of calls to a reachabilityTest.
 func countReachabilityTestCalls(counter *int, t reachabilityTest) reachabilityTest {
-	return func(ctx context.Context, url *url.URL, key string, dnsServers []string, userAgent string) error {
+	return func(ctx context.Context, url *url.URL, key string, dnsServers []string, userAgent string, perspective *cmacme.ACMEChallengeSolverHTTP01SelfCheckPerspective) error {
 		*counter++
-		return t(ctx, url, key, dnsServers, userAgent)
+		return t(ctx, url, key, dnsServers, userAgent, perspective)
+	}
+}
+
+// failPerspective returns a reachabilityTest which fails from the perspective
+// with the given name only.
+func failPerspective(name string) reachabilityTest {
+	return func(_ context.Context, _ *url.URL, _ string, _ []string, _ string, perspective *cmacme.ACMEChallengeSolverHTTP01SelfCheckPerspective) error {
+		if perspective != nil && perspective.Name == name {
+			return fmt.Errorf("failed")
+		}
+		return nil
+	}
+}
+
+func perspectivesChallenge(quorum *int32) *cmacme.Challenge {
+	return &cmacme.Challenge{
+		Spec: cmacme.ChallengeSpec{
+			Solver: cmacme.ACMEChallengeSolver{
+				HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+					SelfCheck: &cmacme.ACMEChallengeSolverHTTP01SelfCheck{
+						Perspectives: []cmacme.ACMEChallengeSolverHTTP01SelfCheckPerspective{
+							{Name: "eu", ProxyURL: "http://proxy.eu.example.com:3128"},
+							{Name: "us", SourceAddress: "10.0.0.1"},
+						},
+						Quorum: quorum,
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -47,22 +78,44 @@ func TestCheck(t *testing.T) {
 		reachabilityTest reachabilityTest
 		challenge        *cmacme.Challenge
 		expectedErr      bool
+
+		expectedPerspectives []cmacme.ChallengeSelfCheckPerspective
 	}
 	tests := []testT{
 		{
 			name: "should pass",
-			reachabilityTest: func(context.Context, *url.URL, string, []string, string) error {
+			reachabilityTest: func(context.Context, *url.URL, string, []string, string, *cmacme.ACMEChallengeSolverHTTP01SelfCheckPerspective) error {
 				return nil
 			},
 			expectedErr: false,
 		},
 		{
 			name: "should error",
-			reachabilityTest: func(context.Context, *url.URL, string, []string, string) error {
+			reachabilityTest: func(context.Context, *url.URL, string, []string, string, *cmacme.ACMEChallengeSolverHTTP01SelfCheckPerspective) error {
 				return fmt.Errorf("failed")
 			},
 			expectedErr: true,
 		},
+		{
+			name:             "should pass if the quorum of perspectives passes",
+			reachabilityTest: failPerspective("us"),
+			challenge:        perspectivesChallenge(ptr.To(int32(1))),
+			expectedPerspectives: []cmacme.ChallengeSelfCheckPerspective{
+				{Name: "eu", Passed: true},
+				{Name: "us", Passed: false, Reason: "failed"},
+			},
+			expectedErr: false,
+		},
+		{
+			name:             "should error if not all perspectives pass without a quorum",
+			reachabilityTest: failPerspective("us"),
+			challenge:        perspectivesChallenge(nil),
+			expectedPerspectives: []cmacme.ChallengeSelfCheckPerspective{
+				{Name: "eu", Passed: true},
+				{Name: "us", Passed: false, Reason: "failed"},
+			},
+			expectedErr: true,
+		},
 	}
 
 	for i := range tests {
@@ -88,10 +141,13 @@ func TestCheck(t *testing.T) {
 				t.Errorf("Expected error from Check, but got none")
 				return
 			}
-			if !test.expectedErr && calls != requiredCallsForPass {
+			if !test.expectedErr && test.expectedPerspectives == nil && calls != requiredCallsForPass {
 				t.Errorf("Expected Wait to verify reachability test passes %d times, but only checked %d", requiredCallsForPass, calls)
 				return
 			}
+			if !reflect.DeepEqual(test.challenge.Status.SelfCheckPerspectives, test.expectedPerspectives) {
+				t.Errorf("Expected perspectives %+v, but got %+v", test.expectedPerspectives, test.challenge.Status.SelfCheckPerspectives)
+			}
 		})
 	}
 }
@@ -191,7 +247,7 @@ func TestReachabilityCustomDnsServers(t *testing.T) {
 
 	for _, tt := range tests {
 		atomic.StoreInt32(&dnsServerCalled, 0)
-		err = testReachability(context.Background(), u, key, tt.dnsServers, "cert-manager-test")
+		err = testReachability(context.Background(), u, key, tt.dnsServers, "cert-manager-test", nil)
 		switch {
 		case err == nil:
 			t.Errorf("Expected error for testReachability, but got none")
@@ -69,6 +69,7 @@ func (s *Solver) ensurePod(ctx context.Context, ch *cmacme.Challenge) error {
 	}
 
 	log.V(logf.InfoLevel).Info("creating HTTP01 challenge solver pod")
+	s.recordSharedSolverNotUsed(ch)
 
 	_, err = s.createPod(ctx, ch)
 	return err
@@ -160,6 +161,10 @@ func (s *Solver) buildPod(ch *cmacme.Challenge) *corev1.Pod {
 				ch.Spec.Solver.HTTP01.GatewayHTTPRoute.PodTemplate)
 		}
 	}
+	if ch.Spec.Solver.TLSALPN01 != nil {
+		pod = s.mergePodObjectMetaWithPodTemplate(pod,
+			ch.Spec.Solver.TLSALPN01.PodTemplate)
+	}
 
 	return pod
 }
@@ -173,7 +178,7 @@ func (s *Solver) buildPod(ch *cmacme.Challenge) *corev1.Pod {
 func (s *Solver) buildDefaultPod(ch *cmacme.Challenge) *corev1.Pod {
 	podLabels := podLabels(ch)
 
-	return &corev1.Pod{
+	pod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: "cm-acme-http-solver-",
 			Namespace:    ch.Namespace,
@@ -240,6 +245,16 @@ func (s *Solver) buildDefaultPod(ch *cmacme.Challenge) *corev1.Pod {
 			},
 		},
 	}
+
+	// The same acmesolver image is used to solve TLS-ALPN-01 challenges,
+	// it serves the challenge certificate over TLS instead.
+	if ch.Spec.Type == cmacme.ACMEChallengeTypeTLSALPN01 {
+		container := &pod.Spec.Containers[0]
+		container.Args = append(container.Args, "--challenge-type=tls-alpn-01")
+		container.Ports[0].Name = "https"
+	}
+
+	return pod
 }
 
 // Merge object meta from the pod template. Fall back to default values.
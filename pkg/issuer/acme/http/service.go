@@ -131,7 +131,7 @@ func buildService(ch *cmacme.Challenge) (*corev1.Service, error) {
 		},
 	}
 
-	// checking for presence of http01 config and if set serviceType is set, override our default (NodePort)
+	// checking for presence of http01 or tlsALPN01 config and if set serviceType is set, override our default (NodePort)
 	serviceType, err := getServiceType(ch)
 	if err != nil {
 		return nil, err
@@ -140,6 +140,12 @@ func buildService(ch *cmacme.Challenge) (*corev1.Service, error) {
 		service.Spec.Type = serviceType
 	}
 
+	// ACME servers connect to port 443 when validating TLS-ALPN-01 challenges
+	if ch.Spec.Type == cmacme.ACMEChallengeTypeTLSALPN01 {
+		service.Spec.Ports[0].Name = "https"
+		service.Spec.Ports[0].Port = tlsALPN01Port
+	}
+
 	return service, nil
 }
 
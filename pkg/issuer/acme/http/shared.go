@@ -0,0 +1,316 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/adler32"
+	"net"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	reasonSharedSolverNotUsed = "SharedSolverNotUsed"
+)
+
+// usesSharedSolver returns true if the given challenge should be solved by the
+// shared, long-running acmesolver Deployment instead of a dedicated solver pod.
+// Only ingress based solvers which do not edit an existing Ingress are
+// supported, as an Ingress can only route traffic to Services in its own
+// namespace. Gateway API HTTPRoutes are not supported either, as routing to a
+// Service in another namespace would require a ReferenceGrant in the
+// namespace of the shared solver for every challenge namespace. All other
+// challenges fall back to a dedicated solver pod and Service, see
+// recordSharedSolverNotUsed.
+func (s *Solver) usesSharedSolver(ch *cmacme.Challenge) bool {
+	if s.ACMEOptions.HTTP01SharedSolverService.Name == "" {
+		return false
+	}
+	if ch.Spec.Type != cmacme.ACMEChallengeTypeHTTP01 {
+		return false
+	}
+	return ch.Spec.Solver.HTTP01 != nil &&
+		ch.Spec.Solver.HTTP01.Ingress != nil &&
+		ch.Spec.Solver.HTTP01.Ingress.Name == ""
+}
+
+// recordSharedSolverNotUsed records an event on the challenge if a shared
+// solver Service is configured but the challenge's solver configuration is not
+// supported by it, so that it is visible why a dedicated solver pod and
+// Service are created for the challenge. It is called when the dedicated
+// solver pod is created, so the event is only recorded once per challenge.
+func (s *Solver) recordSharedSolverNotUsed(ch *cmacme.Challenge) {
+	if s.ACMEOptions.HTTP01SharedSolverService.Name == "" || ch.Spec.Type != cmacme.ACMEChallengeTypeHTTP01 || ch.Spec.Solver.HTTP01 == nil {
+		return
+	}
+
+	var reason string
+	switch {
+	case ch.Spec.Solver.HTTP01.GatewayHTTPRoute != nil:
+		reason = "Gateway API HTTPRoute solvers are not supported"
+	case ch.Spec.Solver.HTTP01.Ingress != nil && ch.Spec.Solver.HTTP01.Ingress.Name != "":
+		reason = "solvers which edit an existing Ingress are not supported"
+	default:
+		return
+	}
+	s.Recorder.Eventf(ch, corev1.EventTypeNormal, reasonSharedSolverNotUsed,
+		"Not using the shared HTTP01 challenge solver as %s, creating a dedicated solver pod and service", reason)
+}
+
+// sharedIngressName returns the name of the Ingress used to route challenge
+// requests for the given ingress configuration to the shared solver. A
+// single Ingress is maintained for each ingress class and ingress template,
+// so that each challenge is routed through an Ingress carrying the labels
+// and annotations its solver asks for.
+func sharedIngressName(cfg *cmacme.ACMEChallengeSolverHTTP01Ingress) (string, error) {
+	key := "default"
+	switch {
+	case cfg.IngressClassName != nil:
+		key = "ingressClassName/" + *cfg.IngressClassName
+	case cfg.Class != nil:
+		key = "class/" + *cfg.Class
+	}
+	if cfg.IngressTemplate != nil {
+		template, err := json.Marshal(cfg.IngressTemplate)
+		if err != nil {
+			return "", err
+		}
+		key += "/ingressTemplate/" + string(template)
+	}
+	return fmt.Sprintf("cm-acme-http-solver-shared-%d", adler32.Checksum([]byte(key))), nil
+}
+
+// isSharedIngressConflict returns true if the shared Ingress was changed,
+// created or deleted concurrently, which happens regularly as the Ingress is
+// shared between all challenges using the same ingress class and template.
+func isSharedIngressConflict(err error) bool {
+	return k8sErrors.IsConflict(err) || k8sErrors.IsAlreadyExists(err) || k8sErrors.IsNotFound(err)
+}
+
+// ensureSharedIngress ensures the shared Ingress for the challenge's ingress
+// class and template exists and contains a rule routing the challenge path
+// to the shared solver Service.
+func (s *Solver) ensureSharedIngress(ctx context.Context, ch *cmacme.Challenge) error {
+	log := logf.FromContext(ctx).WithName("ensureSharedIngress")
+
+	httpDomainCfg, err := http01IngressCfgForChallenge(ch)
+	if err != nil {
+		return err
+	}
+	if httpDomainCfg.Class != nil && httpDomainCfg.IngressClassName != nil {
+		return fmt.Errorf("the fields ingressClassName and class cannot be set at the same time")
+	}
+
+	svc := s.ACMEOptions.HTTP01SharedSolverService
+	name, err := sharedIngressName(httpDomainCfg)
+	if err != nil {
+		return err
+	}
+	log = logf.WithRelatedResourceName(log, name, svc.Namespace, "Ingress")
+
+	// Avoid querying the API server if the cached Ingress already routes the
+	// challenge, as Present is called on every self check.
+	if cached, err := s.ingressLister.Ingresses(svc.Namespace).Get(name); err == nil && !addSharedIngressPath(cached.DeepCopy(), ch, svc.Name) {
+		log.V(logf.DebugLevel).Info("shared HTTP01 challenge solver ingress is up to date")
+		return nil
+	}
+
+	// The shared Ingress is modified concurrently for other challenges, so the
+	// latest version is read from the API server and the change is retried on
+	// conflicts.
+	return retry.OnError(retry.DefaultBackoff, isSharedIngressConflict, func() error {
+		existing, err := s.Client.NetworkingV1().Ingresses(svc.Namespace).Get(ctx, name, metav1.GetOptions{})
+		if k8sErrors.IsNotFound(err) {
+			log.V(logf.DebugLevel).Info("creating shared HTTP01 challenge solver ingress")
+			ing := s.buildSharedIngressResource(httpDomainCfg, svc.Namespace, name)
+			addSharedIngressPath(ing, ch, svc.Name)
+			_, err := s.Client.NetworkingV1().Ingresses(svc.Namespace).Create(ctx, ing, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		ing := existing.DeepCopy()
+		if !addSharedIngressPath(ing, ch, svc.Name) {
+			log.V(logf.DebugLevel).Info("shared HTTP01 challenge solver ingress is up to date")
+			return nil
+		}
+
+		log.V(logf.DebugLevel).Info("adding challenge path to shared HTTP01 challenge solver ingress")
+		_, err = s.Client.NetworkingV1().Ingresses(svc.Namespace).Update(ctx, ing, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// cleanupSharedIngress removes the challenge path from the shared Ingress for
+// the challenge's ingress class and template, deleting the Ingress once no
+// challenge paths remain.
+func (s *Solver) cleanupSharedIngress(ctx context.Context, ch *cmacme.Challenge) error {
+	log := logf.FromContext(ctx, "cleanupSharedIngress")
+
+	svc := s.ACMEOptions.HTTP01SharedSolverService
+	name, err := sharedIngressName(ch.Spec.Solver.HTTP01.Ingress)
+	if err != nil {
+		return err
+	}
+	log = logf.WithRelatedResourceName(log, name, svc.Namespace, "Ingress")
+
+	// The shared Ingress is modified concurrently for other challenges, so the
+	// latest version is read from the API server and the change is retried on
+	// conflicts.
+	return retry.OnError(retry.DefaultBackoff, k8sErrors.IsConflict, func() error {
+		existing, err := s.Client.NetworkingV1().Ingresses(svc.Namespace).Get(ctx, name, metav1.GetOptions{})
+		if k8sErrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		ing := existing.DeepCopy()
+		if !removeSharedIngressPath(ing, ch) {
+			return nil
+		}
+
+		if len(ing.Spec.Rules) == 0 {
+			log.V(logf.DebugLevel).Info("deleting shared HTTP01 challenge solver ingress as no challenges remain")
+			err := s.Client.NetworkingV1().Ingresses(svc.Namespace).Delete(ctx, name, metav1.DeleteOptions{
+				Preconditions: &metav1.Preconditions{ResourceVersion: &ing.ResourceVersion},
+			})
+			if k8sErrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		log.V(logf.DebugLevel).Info("removing challenge path from shared HTTP01 challenge solver ingress")
+		_, err = s.Client.NetworkingV1().Ingresses(svc.Namespace).Update(ctx, ing, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// buildSharedIngressResource builds the shared Ingress for the given ingress
+// configuration, including the labels and annotations of its ingress
+// template.
+func (s *Solver) buildSharedIngressResource(cfg *cmacme.ACMEChallengeSolverHTTP01Ingress, namespace, name string) *networkingv1.Ingress {
+	ingAnnotations := map[string]string{
+		"nginx.ingress.kubernetes.io/whitelist-source-range": "0.0.0.0/0,::/0",
+	}
+	if cfg.Class != nil {
+		ingAnnotations[annotationIngressClass] = *cfg.Class
+	}
+
+	ing := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				cmacme.SolverIdentificationLabelKey: "true",
+			},
+			Annotations: ingAnnotations,
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: cfg.IngressClassName,
+		},
+	}
+	return s.mergeIngressObjectMetaWithIngressResourceTemplate(ing, cfg.IngressTemplate)
+}
+
+// sharedIngressHost returns the host the challenge path should be routed for.
+// Challenges for IP addresses are routed for all hosts.
+func sharedIngressHost(ch *cmacme.Challenge) string {
+	if net.ParseIP(ch.Spec.DNSName) != nil {
+		return ""
+	}
+	return ch.Spec.DNSName
+}
+
+// addSharedIngressPath adds the path for the given challenge to the shared
+// Ingress. It returns false if the Ingress already contained the path.
+func addSharedIngressPath(ing *networkingv1.Ingress, ch *cmacme.Challenge, svcName string) bool {
+	host := sharedIngressHost(ch)
+	pathToAdd := ingressPath(ch.Spec.Token, svcName)
+
+	for i, rule := range ing.Spec.Rules {
+		if rule.Host != host || rule.HTTP == nil {
+			continue
+		}
+		for _, p := range rule.HTTP.Paths {
+			if p.Path == pathToAdd.Path &&
+				p.Backend.Service != nil &&
+				p.Backend.Service.Name == svcName {
+				return false
+			}
+		}
+		ing.Spec.Rules[i].HTTP.Paths = append(rule.HTTP.Paths, pathToAdd)
+		return true
+	}
+
+	ing.Spec.Rules = append(ing.Spec.Rules, networkingv1.IngressRule{
+		Host: host,
+		IngressRuleValue: networkingv1.IngressRuleValue{
+			HTTP: &networkingv1.HTTPIngressRuleValue{
+				Paths: []networkingv1.HTTPIngressPath{pathToAdd},
+			},
+		},
+	})
+	return true
+}
+
+// removeSharedIngressPath removes the path for the given challenge from the
+// shared Ingress, dropping any rule left without paths. It returns false if
+// the Ingress did not contain the path.
+func removeSharedIngressPath(ing *networkingv1.Ingress, ch *cmacme.Challenge) bool {
+	host := sharedIngressHost(ch)
+	pathToDel := solverPathFn(ch.Spec.Token)
+
+	removed := false
+	var rules []networkingv1.IngressRule
+	for _, rule := range ing.Spec.Rules {
+		if rule.Host != host || rule.HTTP == nil {
+			rules = append(rules, rule)
+			continue
+		}
+
+		var paths []networkingv1.HTTPIngressPath
+		for _, p := range rule.HTTP.Paths {
+			if p.Path == pathToDel {
+				removed = true
+				continue
+			}
+			paths = append(paths, p)
+		}
+		if len(paths) > 0 {
+			rule.HTTP.Paths = paths
+			rules = append(rules, rule)
+		}
+	}
+
+	ing.Spec.Rules = rules
+	return removed
+}
@@ -0,0 +1,301 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	coretesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+)
+
+func TestUsesSharedSolver(t *testing.T) {
+	challenge := func(http01 *cmacme.ACMEChallengeSolverHTTP01) *cmacme.Challenge {
+		return &cmacme.Challenge{Spec: cmacme.ChallengeSpec{
+			Type:   cmacme.ACMEChallengeTypeHTTP01,
+			Solver: cmacme.ACMEChallengeSolver{HTTP01: http01},
+		}}
+	}
+	tests := map[string]struct {
+		service types.NamespacedName
+		ch      *cmacme.Challenge
+		want    bool
+	}{
+		"ingress solver with a shared solver service": {
+			service: types.NamespacedName{Namespace: "cert-manager", Name: "acmesolver"},
+			ch:      challenge(&cmacme.ACMEChallengeSolverHTTP01{Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{}}),
+			want:    true,
+		},
+		"ingress solver without a shared solver service": {
+			ch: challenge(&cmacme.ACMEChallengeSolverHTTP01{Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{}}),
+		},
+		"ingress solver editing an existing Ingress": {
+			service: types.NamespacedName{Namespace: "cert-manager", Name: "acmesolver"},
+			ch:      challenge(&cmacme.ACMEChallengeSolverHTTP01{Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{Name: "existing"}}),
+		},
+		"gateway HTTPRoute solver": {
+			service: types.NamespacedName{Namespace: "cert-manager", Name: "acmesolver"},
+			ch:      challenge(&cmacme.ACMEChallengeSolverHTTP01{GatewayHTTPRoute: &cmacme.ACMEChallengeSolverHTTP01GatewayHTTPRoute{}}),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := &Solver{Context: &controller.Context{}}
+			s.ACMEOptions = controller.ACMEOptions{HTTP01SharedSolverService: test.service}
+			if got := s.usesSharedSolver(test.ch); got != test.want {
+				t.Errorf("usesSharedSolver() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSharedIngressName(t *testing.T) {
+	name := func(cfg *cmacme.ACMEChallengeSolverHTTP01Ingress) string {
+		name, err := sharedIngressName(cfg)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return name
+	}
+
+	nginx := name(&cmacme.ACMEChallengeSolverHTTP01Ingress{IngressClassName: ptr.To("nginx")})
+	if nginx != name(&cmacme.ACMEChallengeSolverHTTP01Ingress{IngressClassName: ptr.To("nginx")}) {
+		t.Errorf("expected the shared ingress name to be stable")
+	}
+
+	others := []*cmacme.ACMEChallengeSolverHTTP01Ingress{
+		{},
+		{IngressClassName: ptr.To("traefik")},
+		{Class: ptr.To("nginx")},
+		{
+			IngressClassName: ptr.To("nginx"),
+			IngressTemplate: &cmacme.ACMEChallengeSolverHTTP01IngressTemplate{
+				ACMEChallengeSolverHTTP01IngressObjectMeta: cmacme.ACMEChallengeSolverHTTP01IngressObjectMeta{
+					Annotations: map[string]string{"nginx.ingress.kubernetes.io/ssl-redirect": "false"},
+				},
+			},
+		},
+	}
+	for _, cfg := range others {
+		if name := name(cfg); name == nginx {
+			t.Errorf("expected a different shared ingress for %+v, got %q", cfg, name)
+		}
+	}
+}
+
+func TestBuildSharedIngressResource(t *testing.T) {
+	ing := (&Solver{}).buildSharedIngressResource(&cmacme.ACMEChallengeSolverHTTP01Ingress{
+		Class: ptr.To("nginx"),
+		IngressTemplate: &cmacme.ACMEChallengeSolverHTTP01IngressTemplate{
+			ACMEChallengeSolverHTTP01IngressObjectMeta: cmacme.ACMEChallengeSolverHTTP01IngressObjectMeta{
+				Labels:      map[string]string{"team": "a"},
+				Annotations: map[string]string{"nginx.ingress.kubernetes.io/whitelist-source-range": "10.0.0.0/8"},
+			},
+		},
+	}, "cert-manager", "shared")
+
+	if ing.Labels["team"] != "a" || ing.Labels[cmacme.SolverIdentificationLabelKey] != "true" {
+		t.Errorf("expected the ingress template labels to be merged, got %v", ing.Labels)
+	}
+	if ing.Annotations["nginx.ingress.kubernetes.io/whitelist-source-range"] != "10.0.0.0/8" || ing.Annotations[annotationIngressClass] != "nginx" {
+		t.Errorf("expected the ingress template annotations to be merged, got %v", ing.Annotations)
+	}
+}
+
+func TestSharedIngressRetriesOnConflict(t *testing.T) {
+	service := types.NamespacedName{Namespace: "cert-manager", Name: "acmesolver"}
+	chal := func(token string) *cmacme.Challenge {
+		return &cmacme.Challenge{
+			ObjectMeta: metav1.ObjectMeta{Namespace: defaultTestNamespace, Name: token},
+			Spec: cmacme.ChallengeSpec{
+				Type:    cmacme.ACMEChallengeTypeHTTP01,
+				DNSName: "example.com",
+				Token:   token,
+				Solver: cmacme.ACMEChallengeSolver{
+					HTTP01: &cmacme.ACMEChallengeSolverHTTP01{Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{}},
+				},
+			},
+		}
+	}
+	// conflictOnce fails the first request with the given verb with a conflict,
+	// as if another challenge changed the shared Ingress concurrently.
+	conflictOnce := func(b *testpkg.Builder, verb string) {
+		conflicted := false
+		b.FakeKubeClient().PrependReactor(verb, "ingresses", func(coretesting.Action) (bool, runtime.Object, error) {
+			if conflicted {
+				return false, nil, nil
+			}
+			conflicted = true
+			return true, nil, k8sErrors.NewConflict(schema.GroupResource{Group: "networking.k8s.io", Resource: "ingresses"}, "shared", nil)
+		})
+	}
+
+	f := &solverFixture{}
+	f.Setup(t)
+	defer f.Finish(t)
+	f.Solver.ACMEOptions.HTTP01SharedSolverService = service
+
+	name, err := sharedIngressName(chal("token1").Spec.Solver.HTTP01.Ingress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	paths := func() int {
+		ing, err := f.Builder.FakeKubeClient().NetworkingV1().Ingresses(service.Namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if k8sErrors.IsNotFound(err) {
+			return 0
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := 0
+		for _, rule := range ing.Spec.Rules {
+			n += len(rule.HTTP.Paths)
+		}
+		return n
+	}
+
+	if err := f.Solver.ensureSharedIngress(context.TODO(), chal("token1")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	conflictOnce(f.Builder, "update")
+	if err := f.Solver.ensureSharedIngress(context.TODO(), chal("token2")); err != nil {
+		t.Fatalf("expected the conflicting update to be retried, got: %v", err)
+	}
+	if n := paths(); n != 2 {
+		t.Errorf("expected 2 challenge paths on the shared ingress, got %d", n)
+	}
+
+	conflictOnce(f.Builder, "update")
+	if err := f.Solver.cleanupSharedIngress(context.TODO(), chal("token1")); err != nil {
+		t.Fatalf("expected the conflicting update to be retried, got: %v", err)
+	}
+	if n := paths(); n != 1 {
+		t.Errorf("expected 1 challenge path on the shared ingress, got %d", n)
+	}
+
+	conflictOnce(f.Builder, "delete")
+	if err := f.Solver.cleanupSharedIngress(context.TODO(), chal("token2")); err != nil {
+		t.Fatalf("expected the conflicting delete to be retried, got: %v", err)
+	}
+	if n := paths(); n != 0 {
+		t.Errorf("expected the shared ingress to be deleted, got %d challenge paths", n)
+	}
+}
+
+func TestRecordSharedSolverNotUsed(t *testing.T) {
+	challenge := func(http01 *cmacme.ACMEChallengeSolverHTTP01) *cmacme.Challenge {
+		return &cmacme.Challenge{Spec: cmacme.ChallengeSpec{
+			Type:   cmacme.ACMEChallengeTypeHTTP01,
+			Solver: cmacme.ACMEChallengeSolver{HTTP01: http01},
+		}}
+	}
+	tests := map[string]struct {
+		service   types.NamespacedName
+		ch        *cmacme.Challenge
+		wantEvent bool
+	}{
+		"gateway HTTPRoute solver": {
+			service:   types.NamespacedName{Namespace: "cert-manager", Name: "acmesolver"},
+			ch:        challenge(&cmacme.ACMEChallengeSolverHTTP01{GatewayHTTPRoute: &cmacme.ACMEChallengeSolverHTTP01GatewayHTTPRoute{}}),
+			wantEvent: true,
+		},
+		"ingress solver editing an existing Ingress": {
+			service:   types.NamespacedName{Namespace: "cert-manager", Name: "acmesolver"},
+			ch:        challenge(&cmacme.ACMEChallengeSolverHTTP01{Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{Name: "existing"}}),
+			wantEvent: true,
+		},
+		"gateway HTTPRoute solver without a shared solver service": {
+			ch: challenge(&cmacme.ACMEChallengeSolverHTTP01{GatewayHTTPRoute: &cmacme.ACMEChallengeSolverHTTP01GatewayHTTPRoute{}}),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			recorder := new(testpkg.FakeRecorder)
+			s := &Solver{Context: &controller.Context{Recorder: recorder}}
+			s.ACMEOptions = controller.ACMEOptions{HTTP01SharedSolverService: test.service}
+			s.recordSharedSolverNotUsed(test.ch)
+			if got := len(recorder.Events) == 1; got != test.wantEvent {
+				t.Errorf("expected an event to be recorded: %v, got events: %v", test.wantEvent, recorder.Events)
+			}
+		})
+	}
+}
+
+func TestSharedIngressPaths(t *testing.T) {
+	chal := func(dnsName, token string) *cmacme.Challenge {
+		return &cmacme.Challenge{Spec: cmacme.ChallengeSpec{DNSName: dnsName, Token: token}}
+	}
+	paths := func(ing *networkingv1.Ingress) map[string][]string {
+		out := map[string][]string{}
+		for _, rule := range ing.Spec.Rules {
+			for _, p := range rule.HTTP.Paths {
+				out[rule.Host] = append(out[rule.Host], p.Path)
+			}
+		}
+		return out
+	}
+
+	ing := (&Solver{}).buildSharedIngressResource(&cmacme.ACMEChallengeSolverHTTP01Ingress{}, "cert-manager", "shared")
+
+	if !addSharedIngressPath(ing, chal("example.com", "token1"), "acmesolver") {
+		t.Errorf("expected path to be added")
+	}
+	if !addSharedIngressPath(ing, chal("example.com", "token2"), "acmesolver") {
+		t.Errorf("expected path to be added")
+	}
+	if !addSharedIngressPath(ing, chal("10.0.0.1", "token3"), "acmesolver") {
+		t.Errorf("expected path to be added")
+	}
+	if addSharedIngressPath(ing, chal("example.com", "token1"), "acmesolver") {
+		t.Errorf("expected existing path not to be added again")
+	}
+
+	if got := paths(ing); len(got) != 2 || len(got["example.com"]) != 2 || len(got[""]) != 1 {
+		t.Errorf("unexpected paths on shared ingress: %v", got)
+	}
+
+	if removeSharedIngressPath(ing, chal("example.org", "token1")) {
+		t.Errorf("expected no path to be removed for a different host")
+	}
+	if !removeSharedIngressPath(ing, chal("example.com", "token1")) {
+		t.Errorf("expected path to be removed")
+	}
+	if !removeSharedIngressPath(ing, chal("10.0.0.1", "token3")) {
+		t.Errorf("expected path to be removed")
+	}
+	if got := paths(ing); len(got) != 1 || len(got["example.com"]) != 1 || got["example.com"][0] != solverPathFn("token2") {
+		t.Errorf("unexpected paths on shared ingress: %v", got)
+	}
+
+	if !removeSharedIngressPath(ing, chal("example.com", "token2")) {
+		t.Errorf("expected path to be removed")
+	}
+	if len(ing.Spec.Rules) != 0 {
+		t.Errorf("expected no rules to remain, got %v", ing.Spec.Rules)
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package solver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cert-manager/cert-manager/pkg/acme"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/cert-manager/cert-manager/pkg/client/informers/externalversions"
+)
+
+const (
+	// challengeKeyIndex indexes pending HTTP01 challenges by their domain
+	// and token.
+	challengeKeyIndex = "domainToken"
+
+	challengeResyncPeriod = 10 * time.Hour
+)
+
+// NewChallengeKeyLookup starts watching Challenge resources in the given
+// namespace, or in all namespaces if namespace is empty, and returns a
+// KeyLookup which serves the key of any pending HTTP01 challenge. It blocks
+// until the initial list of challenges has been observed.
+func NewChallengeKeyLookup(ctx context.Context, client cmclient.Interface, namespace string) (KeyLookup, error) {
+	factory := cminformers.NewSharedInformerFactoryWithOptions(client, challengeResyncPeriod, cminformers.WithNamespace(namespace))
+	informer := factory.Acme().V1().Challenges().Informer()
+	if err := informer.AddIndexers(cache.Indexers{challengeKeyIndex: indexChallengeKey}); err != nil {
+		return nil, err
+	}
+
+	factory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+		return nil, fmt.Errorf("timed out waiting for challenge caches to sync")
+	}
+
+	indexer := informer.GetIndexer()
+	return func(domain, token string) (string, bool) {
+		objs, err := indexer.ByIndex(challengeKeyIndex, challengeKey(domain, token))
+		if err != nil || len(objs) == 0 {
+			return "", false
+		}
+		return objs[0].(*cmacme.Challenge).Spec.Key, true
+	}, nil
+}
+
+// indexChallengeKey indexes HTTP01 challenges which are not yet in a final
+// state.
+func indexChallengeKey(obj interface{}) ([]string, error) {
+	ch, ok := obj.(*cmacme.Challenge)
+	if !ok {
+		return nil, nil
+	}
+	if ch.Spec.Type != cmacme.ACMEChallengeTypeHTTP01 || acme.IsFinalState(ch.Status.State) {
+		return nil, nil
+	}
+	return []string{challengeKey(ch.Spec.DNSName, ch.Spec.Token)}, nil
+}
+
+func challengeKey(domain, token string) string {
+	return domain + "/" + token
+}
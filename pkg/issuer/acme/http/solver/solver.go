@@ -37,6 +37,10 @@ const (
 	defaultReadHeaderTimeout = 32 * time.Second
 )
 
+// KeyLookup returns the key to respond with for the HTTP01 challenge with
+// the given domain and token, or false if no such challenge is pending.
+type KeyLookup func(domain, token string) (string, bool)
+
 type HTTP01Solver struct {
 	ListenPort int
 
@@ -44,16 +48,27 @@ type HTTP01Solver struct {
 	Token  string
 	Key    string
 
+	// Keys, if set, is used to look up the key for incoming requests instead
+	// of Domain, Token and Key. This allows a single solver to serve many
+	// challenges at once.
+	Keys KeyLookup
+
 	http.Server
 }
 
 func (h *HTTP01Solver) Listen(log logr.Logger) error {
-	log.Info("starting listener",
-		"expected_domain", h.Domain,
-		"expected_token", h.Token,
-		"expected_key", h.Key,
-		"listen_port", h.ListenPort,
-	)
+	lookup := h.Keys
+	if lookup == nil {
+		log.Info("starting listener",
+			"expected_domain", h.Domain,
+			"expected_token", h.Token,
+			"expected_key", h.Key,
+			"listen_port", h.ListenPort,
+		)
+		lookup = h.lookupKey
+	} else {
+		log.Info("starting shared listener", "listen_port", h.ListenPort)
+	}
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// extract vars from the request
@@ -81,17 +96,10 @@ func (h *HTTP01Solver) Listen(log logr.Logger) error {
 			return
 		}
 
-		log.Info("comparing host", "expected_host", h.Domain)
-		if h.Domain != host {
-			log.Info("invalid host", "expected_host", h.Domain)
-			http.NotFound(w, r)
-			return
-		}
-
-		log.Info("comparing token", "expected_token", h.Token)
-		if h.Token != token {
+		key, ok := lookup(host, token)
+		if !ok {
 			// if nothing else, we return a 404 here
-			log.Info("invalid token", "expected_token", h.Token)
+			log.Info("no challenge found for host and token")
 			http.NotFound(w, r)
 			return
 		}
@@ -99,7 +107,7 @@ func (h *HTTP01Solver) Listen(log logr.Logger) error {
 		log.Info("got successful challenge request, writing key")
 		w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
 		w.WriteHeader(http.StatusOK)
-		fmt.Fprint(w, h.Key)
+		fmt.Fprint(w, key)
 	})
 
 	h.Server = http.Server{
@@ -110,3 +118,12 @@ func (h *HTTP01Solver) Listen(log logr.Logger) error {
 
 	return h.Server.ListenAndServe()
 }
+
+// lookupKey returns the key of the single challenge the solver was configured
+// to serve.
+func (h *HTTP01Solver) lookupKey(domain, token string) (string, bool) {
+	if h.Domain != domain || h.Token != token {
+		return "", false
+	}
+	return h.Key, true
+}
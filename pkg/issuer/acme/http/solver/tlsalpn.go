@@ -0,0 +1,223 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package solver
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	// ACMETLS1Protocol is the ALPN protocol name used for tls-alpn-01
+	// challenge handshakes, as defined in RFC 8737.
+	ACMETLS1Protocol = "acme-tls/1"
+
+	// tlsALPN01CertValidity is the validity of the self-signed challenge
+	// certificate. ACME servers do not check the validity period of this
+	// certificate, it is only set to avoid expired certificates being
+	// reported by intermediaries.
+	tlsALPN01CertValidity = 365 * 24 * time.Hour
+)
+
+// idPeACMEIdentifier is the object identifier of the acmeIdentifier
+// extension which holds the SHA-256 digest of the key authorization.
+var idPeACMEIdentifier = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 1, 31}
+
+type TLSALPN01Solver struct {
+	ListenPort int
+
+	Domain string
+	Key    string
+
+	http.Server
+}
+
+// Listen serves the tls-alpn-01 challenge certificate for the configured
+// domain on TLS connections that negotiate the acme-tls/1 protocol.
+// Other TLS connections are served as HTTPS, which allows the solver to
+// respond to health checks.
+func (t *TLSALPN01Solver) Listen(log logr.Logger) error {
+	log.Info("starting tls-alpn-01 listener",
+		"expected_domain", t.Domain,
+		"expected_key", t.Key,
+		"listen_port", t.ListenPort,
+	)
+
+	challengeCert, err := TLSALPN01ChallengeCert(t.Domain, t.Key)
+	if err != nil {
+		return err
+	}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log.Info("responding OK to health check", "path", r.URL.EscapedPath())
+		w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	t.Server = http.Server{
+		Addr:              fmt.Sprintf(":%d", t.ListenPort),
+		Handler:           handler,
+		ReadHeaderTimeout: defaultReadHeaderTimeout, // Mitigation for G112: Potential slowloris attack
+		TLSConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			NextProtos: []string{ACMETLS1Protocol, "http/1.1"},
+			GetCertificate: func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+				log := log.WithValues("server_name", hello.ServerName)
+				if !slices.Contains(hello.SupportedProtos, ACMETLS1Protocol) {
+					return &challengeCert, nil
+				}
+				if hello.ServerName != TLSALPN01ServerName(t.Domain) {
+					log.Info("invalid server name", "expected_server_name", TLSALPN01ServerName(t.Domain))
+					return nil, fmt.Errorf("unexpected server name %q", hello.ServerName)
+				}
+				log.Info("got successful tls-alpn-01 challenge request, presenting challenge certificate")
+				return &challengeCert, nil
+			},
+		},
+		// acme-tls/1 connections are closed once the handshake has completed,
+		// no application data is exchanged.
+		TLSNextProto: map[string]func(*http.Server, *tls.Conn, http.Handler){
+			ACMETLS1Protocol: func(_ *http.Server, conn *tls.Conn, _ http.Handler) {
+				conn.Close()
+			},
+		},
+	}
+
+	return t.Server.ListenAndServeTLS("", "")
+}
+
+// TLSALPN01ChallengeCert returns a self-signed certificate for the given
+// domain containing the acmeIdentifier extension for the given key
+// authorization, as described in RFC 8737 section 3.
+func TLSALPN01ChallengeCert(domain, keyAuth string) (tls.Certificate, error) {
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	digest := sha256.Sum256([]byte(keyAuth))
+	extValue, err := asn1.Marshal(digest[:])
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: "ACME tls-alpn-01 challenge certificate"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(tlsALPN01CertValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		ExtraExtensions: []pkix.Extension{
+			{
+				Id:       idPeACMEIdentifier,
+				Critical: true,
+				Value:    extValue,
+			},
+		},
+	}
+	if ip := net.ParseIP(domain); ip != nil {
+		template.IPAddresses = []net.IP{ip}
+	} else {
+		template.DNSNames = []string{domain}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, pk.Public(), pk)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  pk,
+	}, nil
+}
+
+// VerifyTLSALPN01ChallengeCert checks that the given certificate is a valid
+// tls-alpn-01 challenge certificate for the given domain and key
+// authorization.
+func VerifyTLSALPN01ChallengeCert(cert *x509.Certificate, domain, keyAuth string) error {
+	if ip := net.ParseIP(domain); ip != nil {
+		if len(cert.DNSNames) != 0 || len(cert.IPAddresses) != 1 || !cert.IPAddresses[0].Equal(ip) {
+			return fmt.Errorf("challenge certificate must only contain the IP address %q", domain)
+		}
+	} else if len(cert.IPAddresses) != 0 || len(cert.DNSNames) != 1 || cert.DNSNames[0] != domain {
+		return fmt.Errorf("challenge certificate must only contain the DNS name %q", domain)
+	}
+
+	for _, ext := range cert.Extensions {
+		if !ext.Id.Equal(idPeACMEIdentifier) {
+			continue
+		}
+		if !ext.Critical {
+			return errors.New("acmeIdentifier extension of challenge certificate is not critical")
+		}
+		var presentedDigest []byte
+		if rest, err := asn1.Unmarshal(ext.Value, &presentedDigest); err != nil || len(rest) != 0 {
+			return errors.New("acmeIdentifier extension of challenge certificate is malformed")
+		}
+		digest := sha256.Sum256([]byte(keyAuth))
+		if !bytes.Equal(presentedDigest, digest[:]) {
+			return errors.New("acmeIdentifier extension of challenge certificate does not match the expected key authorization")
+		}
+		return nil
+	}
+
+	return errors.New("challenge certificate does not have an acmeIdentifier extension")
+}
+
+// TLSALPN01ServerName returns the TLS server name used by ACME servers when
+// validating the given identifier. IP address identifiers are validated
+// using their reverse DNS name (RFC 8738 section 6).
+func TLSALPN01ServerName(domain string) string {
+	ip := net.ParseIP(domain)
+	if ip == nil {
+		return domain
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return fmt.Sprintf("%d.%d.%d.%d.in-addr.arpa", ip4[3], ip4[2], ip4[1], ip4[0])
+	}
+	const hexDigits = "0123456789abcdef"
+	name := make([]byte, 0, len(ip)*4+len("ip6.arpa"))
+	for i := len(ip) - 1; i >= 0; i-- {
+		name = append(name, hexDigits[ip[i]&0xf], '.', hexDigits[ip[i]>>4], '.')
+	}
+	return string(append(name, "ip6.arpa"...))
+}
@@ -0,0 +1,62 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package solver
+
+import (
+	"crypto/x509"
+	"testing"
+)
+
+func TestTLSALPN01ChallengeCert(t *testing.T) {
+	const keyAuth = "token.thumbprint"
+
+	for _, domain := range []string{"example.com", "192.0.2.1", "2001:db8::1"} {
+		t.Run(domain, func(t *testing.T) {
+			tlsCert, err := TLSALPN01ChallengeCert(domain, keyAuth)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cert, err := x509.ParseCertificate(tlsCert.Certificate[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := VerifyTLSALPN01ChallengeCert(cert, domain, keyAuth); err != nil {
+				t.Errorf("expected challenge certificate to be valid, got: %v", err)
+			}
+			if err := VerifyTLSALPN01ChallengeCert(cert, domain, "other.thumbprint"); err == nil {
+				t.Error("expected challenge certificate to be invalid for a different key authorization")
+			}
+			if err := VerifyTLSALPN01ChallengeCert(cert, "example.org", keyAuth); err == nil {
+				t.Error("expected challenge certificate to be invalid for a different domain")
+			}
+		})
+	}
+}
+
+func TestTLSALPN01ServerName(t *testing.T) {
+	tests := map[string]string{
+		"example.com": "example.com",
+		"192.0.2.1":   "1.2.0.192.in-addr.arpa",
+		"2001:db8::1": "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa",
+	}
+	for domain, expected := range tests {
+		if got := TLSALPN01ServerName(domain); got != expected {
+			t.Errorf("TLSALPN01ServerName(%q): expected %q, got %q", domain, expected, got)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/http/solver"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	// TLSALPN01Timeout is the max amount of time to wait for a TLS-ALPN-01
+	// challenge to succeed
+	TLSALPN01Timeout = time.Minute * 15
+	// tlsALPN01Port is the port ACME servers connect to when validating
+	// TLS-ALPN-01 challenges
+	tlsALPN01Port = 443
+
+	tlsALPNLoggerName = "tlsalpn01"
+)
+
+// TLSALPNSolver is an implementation of the acme tls-alpn-01 challenge
+// solver protocol. It shares the solver pods and services with the HTTP01
+// solver, the acmesolver is run in tls-alpn-01 mode instead.
+type TLSALPNSolver struct {
+	*Solver
+
+	testTLSALPNReachability tlsALPNReachabilityTest
+}
+
+type tlsALPNReachabilityTest func(ctx context.Context, domain, key string, dnsServers []string) error
+
+// NewTLSALPNSolver returns a new ACME TLS-ALPN-01 solver for the given
+// *controller.Context.
+func NewTLSALPNSolver(ctx *controller.Context) (*TLSALPNSolver, error) {
+	s, err := NewSolver(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &TLSALPNSolver{
+		Solver:                  s,
+		testTLSALPNReachability: testTLSALPNReachability,
+	}, nil
+}
+
+// Present will realise the resources required to solve the given TLS-ALPN-01
+// challenge validation in the apiserver. If those resources already exist, it
+// will return nil (i.e. this function is idempotent).
+func (s *TLSALPNSolver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
+	log := logf.FromContext(ctx).WithName(tlsALPNLoggerName)
+	ctx = logf.NewContext(ctx, log)
+
+	if ch.Spec.Solver.TLSALPN01 == nil {
+		return fmt.Errorf("couldn't Present challenge %s/%s: no TLS-ALPN-01 solver was specified", ch.Namespace, ch.Name)
+	}
+
+	podErr := s.ensurePod(ctx, ch)
+	svcName, svcErr := s.ensureService(ctx, ch)
+	if svcErr != nil {
+		return utilerrors.NewAggregate([]error{podErr, svcErr})
+	}
+	var gatewayErr error
+	if ch.Spec.Solver.TLSALPN01.GatewayTLSRoute != nil {
+		if !s.GatewaySolverEnabled {
+			return fmt.Errorf("couldn't Present challenge %s/%s: gateway api is not enabled", ch.Namespace, ch.Name)
+		}
+		_, gatewayErr = s.ensureGatewayTLSRoute(ctx, ch, svcName)
+	}
+	return utilerrors.NewAggregate([]error{podErr, svcErr, gatewayErr})
+}
+
+func (s *TLSALPNSolver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
+	log := logf.FromContext(ctx, tlsALPNLoggerName, "selfCheck")
+	ctx = logf.NewContext(ctx, log)
+
+	// Present is idempotent and the state of the system may have changed
+	// since present was called by the controllers (killed pods, drained
+	// nodes). Call present again to be certain.
+	// if the listers are nil, that means we're in the present checks
+	// test
+	if s.podLister != nil && s.serviceLister != nil {
+		log.V(logf.DebugLevel).Info("calling Present function before running self check to ensure required resources exist")
+		err := s.Present(ctx, issuer, ch)
+		if err != nil {
+			log.V(logf.DebugLevel).Info("failed to call Present function", "error", err)
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, TLSALPN01Timeout)
+	defer cancel()
+
+	log.V(logf.DebugLevel).Info("running self check multiple times to ensure challenge has propagated", "required_passes", s.requiredPasses)
+	for i := 0; i < s.requiredPasses; i++ {
+		err := s.testTLSALPNReachability(ctx, ch.Spec.DNSName, ch.Spec.Key, s.HTTP01SolverNameservers)
+		if err != nil {
+			return err
+		}
+		log.V(logf.DebugLevel).Info("reachability test passed, re-checking in 2s time")
+
+		if i != s.requiredPasses-1 {
+			// sleep for 2s between checks
+			time.Sleep(time.Second * 2)
+		}
+	}
+
+	log.V(logf.DebugLevel).Info("self check succeeded")
+
+	return nil
+}
+
+// CleanUp will ensure the created service, TLSRoute and pod are clean/deleted
+// of any cert-manager created data.
+func (s *TLSALPNSolver) CleanUp(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
+	var errs []error
+	errs = append(errs, s.cleanupPods(ctx, ch))
+	errs = append(errs, s.cleanupServices(ctx, ch))
+	if s.GatewaySolverEnabled {
+		errs = append(errs, s.cleanupGatewayTLSRoutes(ctx, ch))
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// testTLSALPNReachability will attempt a TLS handshake negotiating the
+// acme-tls/1 protocol with port 443 of the given domain, and check that the
+// presented certificate is the challenge certificate for the given key
+// authorization.
+func testTLSALPNReachability(ctx context.Context, domain, key string, dnsServers []string) error {
+	return checkTLSALPNChallenge(ctx, net.JoinHostPort(domain, strconv.Itoa(tlsALPN01Port)), domain, key, dnsServers)
+}
+
+// checkTLSALPNChallenge performs the TLS-ALPN-01 reachability check for the
+// given domain against the given address.
+func checkTLSALPNChallenge(ctx context.Context, addr, domain, key string, dnsServers []string) error {
+	log := logf.FromContext(ctx)
+	log.V(logf.DebugLevel).Info("performing TLS-ALPN-01 reachability check")
+
+	dialer := &tls.Dialer{
+		NetDialer: nameserverDialer(dnsServers),
+		Config: &tls.Config{
+			ServerName: solver.TLSALPN01ServerName(domain),
+			NextProtos: []string{solver.ACMETLS1Protocol},
+			// The challenge certificate is self-signed, it is verified
+			// below by checking its acmeIdentifier extension.
+			InsecureSkipVerify: true, // #nosec G402
+		},
+	}
+	// Like for HTTP01, we use a static timeout of 10 seconds inspired by the
+	// timeouts Boulder uses when validating challenges.
+	dialCtx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	conn, err := dialer.DialContext(dialCtx, "tcp", addr)
+	if err != nil {
+		log.V(logf.DebugLevel).Info("failed to perform self check TLS handshake", "error", err)
+		return fmt.Errorf("failed to perform self check TLS handshake with %q: %v", domain, err)
+	}
+	defer conn.Close()
+
+	state := conn.(*tls.Conn).ConnectionState()
+	if state.NegotiatedProtocol != solver.ACMETLS1Protocol {
+		log.V(logf.DebugLevel).Info("server did not negotiate the acme-tls/1 protocol", "protocol", state.NegotiatedProtocol)
+		return fmt.Errorf("server did not negotiate the %q protocol", solver.ACMETLS1Protocol)
+	}
+	if len(state.PeerCertificates) == 0 {
+		return fmt.Errorf("server did not present a certificate")
+	}
+
+	if err := solver.VerifyTLSALPN01ChallengeCert(state.PeerCertificates[0], domain, key); err != nil {
+		log.V(logf.DebugLevel).Info("presented certificate is not the expected challenge certificate", "error", err)
+		return err
+	}
+
+	log.V(logf.DebugLevel).Info("reachability test succeeded")
+
+	return nil
+}
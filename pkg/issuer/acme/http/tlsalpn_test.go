@@ -0,0 +1,173 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"testing"
+
+	"k8s.io/client-go/rest"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/http/solver"
+)
+
+func TestTLSALPNCheck(t *testing.T) {
+	tests := map[string]struct {
+		reachabilityTest tlsALPNReachabilityTest
+		expectedErr      bool
+	}{
+		"should pass": {
+			reachabilityTest: func(context.Context, string, string, []string) error {
+				return nil
+			},
+		},
+		"should error": {
+			reachabilityTest: func(context.Context, string, string, []string) error {
+				return fmt.Errorf("failed")
+			},
+			expectedErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			requiredCallsForPass := 2
+			s := TLSALPNSolver{
+				Solver: &Solver{
+					Context:        &controller.Context{RESTConfig: new(rest.Config)},
+					requiredPasses: requiredCallsForPass,
+				},
+				testTLSALPNReachability: func(ctx context.Context, domain, key string, dnsServers []string) error {
+					calls++
+					return test.reachabilityTest(ctx, domain, key, dnsServers)
+				},
+			}
+
+			err := s.Check(context.Background(), nil, &cmacme.Challenge{})
+			if test.expectedErr != (err != nil) {
+				t.Fatalf("expected error: %v, got: %v", test.expectedErr, err)
+			}
+			if !test.expectedErr && calls != requiredCallsForPass {
+				t.Errorf("Expected Check to verify reachability test passes %d times, but only checked %d", requiredCallsForPass, calls)
+			}
+		})
+	}
+}
+
+func TestCheckTLSALPNChallenge(t *testing.T) {
+	const (
+		domain  = "example.com"
+		keyAuth = "token.thumbprint"
+	)
+
+	// serve starts a TLS listener which presents the given certificate on
+	// connections negotiating one of the given protocols.
+	serve := func(t *testing.T, cert tls.Certificate, protos []string) string {
+		listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			NextProtos:   protos,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { listener.Close() })
+		go func() {
+			for {
+				conn, err := listener.Accept()
+				if err != nil {
+					return
+				}
+				_ = conn.(*tls.Conn).Handshake()
+				conn.Close()
+			}
+		}()
+		return listener.Addr().String()
+	}
+
+	mustCert := func(domain, keyAuth string) tls.Certificate {
+		cert, err := solver.TLSALPN01ChallengeCert(domain, keyAuth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cert
+	}
+
+	tests := map[string]struct {
+		cert        tls.Certificate
+		protos      []string
+		expectedErr bool
+	}{
+		"valid challenge certificate": {
+			cert:   mustCert(domain, keyAuth),
+			protos: []string{solver.ACMETLS1Protocol},
+		},
+		"acme-tls/1 protocol is not negotiated": {
+			cert:        mustCert(domain, keyAuth),
+			protos:      []string{"http/1.1"},
+			expectedErr: true,
+		},
+		"challenge certificate for a different key authorization": {
+			cert:        mustCert(domain, "other.thumbprint"),
+			protos:      []string{solver.ACMETLS1Protocol},
+			expectedErr: true,
+		},
+		"challenge certificate for a different domain": {
+			cert:        mustCert("example.org", keyAuth),
+			protos:      []string{solver.ACMETLS1Protocol},
+			expectedErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			addr := serve(t, test.cert, test.protos)
+			err := checkTLSALPNChallenge(context.Background(), addr, domain, keyAuth, nil)
+			if test.expectedErr != (err != nil) {
+				t.Errorf("expected error: %v, got: %v", test.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestBuildServiceForTLSALPNChallenge(t *testing.T) {
+	ch := &cmacme.Challenge{
+		Spec: cmacme.ChallengeSpec{
+			Type:    cmacme.ACMEChallengeTypeTLSALPN01,
+			DNSName: "example.com",
+			Solver: cmacme.ACMEChallengeSolver{
+				TLSALPN01: &cmacme.ACMEChallengeSolverTLSALPN01{
+					ServiceType: "LoadBalancer",
+				},
+			},
+		},
+	}
+
+	svc, err := buildService(ch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if svc.Spec.Type != "LoadBalancer" {
+		t.Errorf("expected service type LoadBalancer, got %q", svc.Spec.Type)
+	}
+	port := svc.Spec.Ports[0]
+	if port.Port != tlsALPN01Port || port.TargetPort.IntValue() != acmeSolverListenPort {
+		t.Errorf("expected service to forward port %d to %d, got %d to %s", tlsALPN01Port, acmeSolverListenPort, port.Port, port.TargetPort.String())
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// ensureGatewayTLSRoute ensures that the TLSRoute needed to solve a
+// TLS-ALPN-01 challenge exists.
+// TLSRoutes are part of the experimental Gateway API channel, so they are
+// looked up using the API server instead of an informer, which would never
+// sync in clusters where the TLSRoute CRD is not installed.
+func (s *TLSALPNSolver) ensureGatewayTLSRoute(ctx context.Context, ch *cmacme.Challenge, svcName string) (*gwapiv1alpha2.TLSRoute, error) {
+	log := logf.FromContext(ctx).WithName("ensureGatewayTLSRoute")
+
+	tlsRoutes, err := s.getGatewayTLSRoutes(ctx, ch)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(tlsRoutes) {
+	case 0:
+		log.Info("creating TLSRoute for challenge", "name", ch.Name, "namespace", ch.Namespace)
+		return s.createGatewayTLSRoute(ctx, ch, svcName)
+	case 1:
+		log.Info("Found existing TLSRoute for challenge", "name", ch.Name, "namespace", ch.Namespace)
+		return s.checkAndUpdateGatewayTLSRoute(ctx, ch, svcName, &tlsRoutes[0])
+	default:
+		// It should not be possible for multiple TLSRoutes for this challenge to exist
+		// If we find this, try to delete them.
+		if err := s.cleanupGatewayTLSRoutes(ctx, ch); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("multiple existing TLSRoutes found and cleaned up. retrying challenge sync")
+	}
+}
+
+func (s *TLSALPNSolver) getGatewayTLSRoutes(ctx context.Context, ch *cmacme.Challenge) ([]gwapiv1alpha2.TLSRoute, error) {
+	log := logf.FromContext(ctx).WithName("getGatewayTLSRoutes")
+	log.V(logf.DebugLevel).Info("getting TLSRoutes for challenge", "name", ch.Name, "namespace", ch.Namespace)
+
+	tlsRouteList, err := s.GWClient.GatewayV1alpha2().TLSRoutes(ch.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set(podLabels(ch)).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var relevantTLSRoutes []gwapiv1alpha2.TLSRoute
+	for _, tlsRoute := range tlsRouteList.Items {
+		if !metav1.IsControlledBy(&tlsRoute, ch) {
+			continue
+		}
+		relevantTLSRoutes = append(relevantTLSRoutes, tlsRoute)
+	}
+	return relevantTLSRoutes, nil
+}
+
+func (s *TLSALPNSolver) createGatewayTLSRoute(ctx context.Context, ch *cmacme.Challenge, svcName string) (*gwapiv1alpha2.TLSRoute, error) {
+	tlsRoute := &gwapiv1alpha2.TLSRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName:    "cm-acme-tls-alpn-solver-",
+			Namespace:       ch.Namespace,
+			Labels:          tlsRouteLabels(ch),
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ch, challengeGvk)},
+		},
+		Spec: generateTLSRouteSpec(ch, svcName),
+	}
+	return s.GWClient.GatewayV1alpha2().TLSRoutes(ch.Namespace).Create(ctx, tlsRoute, metav1.CreateOptions{})
+}
+
+func (s *TLSALPNSolver) checkAndUpdateGatewayTLSRoute(ctx context.Context, ch *cmacme.Challenge, svcName string, tlsRoute *gwapiv1alpha2.TLSRoute) (*gwapiv1alpha2.TLSRoute, error) {
+	log := logf.FromContext(ctx, "checkAndUpdateGatewayTLSRoute")
+	expectedSpec := generateTLSRouteSpec(ch, svcName)
+	expectedLabels := tlsRouteLabels(ch)
+	if reflect.DeepEqual(expectedSpec, tlsRoute.Spec) && reflect.DeepEqual(expectedLabels, tlsRoute.Labels) {
+		return tlsRoute, nil
+	}
+	log.Info("TLSRoute is out of date, updating", "name", tlsRoute.Name, "namespace", tlsRoute.Namespace)
+	var ret *gwapiv1alpha2.TLSRoute
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		oldTLSRoute, err := s.GWClient.GatewayV1alpha2().TLSRoutes(tlsRoute.Namespace).Get(ctx, tlsRoute.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		newTLSRoute := oldTLSRoute.DeepCopy()
+		newTLSRoute.Spec = expectedSpec
+		newTLSRoute.Labels = expectedLabels
+		ret, err = s.GWClient.GatewayV1alpha2().TLSRoutes(newTLSRoute.Namespace).Update(ctx, newTLSRoute, metav1.UpdateOptions{})
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (s *TLSALPNSolver) cleanupGatewayTLSRoutes(ctx context.Context, ch *cmacme.Challenge) error {
+	log := logf.FromContext(ctx, "cleanupGatewayTLSRoutes")
+
+	tlsRoutes, err := s.getGatewayTLSRoutes(ctx, ch)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, tlsRoute := range tlsRoutes {
+		log := logf.WithRelatedResource(log, &tlsRoute)
+		log.V(logf.DebugLevel).Info("deleting TLSRoute resource")
+
+		err := s.GWClient.GatewayV1alpha2().TLSRoutes(tlsRoute.Namespace).Delete(ctx, tlsRoute.Name, metav1.DeleteOptions{})
+		if err != nil {
+			log.V(logf.WarnLevel).Info("failed to delete TLSRoute resource", "error", err)
+			errs = append(errs, err)
+			continue
+		}
+		log.V(logf.DebugLevel).Info("successfully deleted TLSRoute resource")
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+func tlsRouteLabels(ch *cmacme.Challenge) map[string]string {
+	labels := podLabels(ch)
+	for k, v := range ch.Spec.Solver.TLSALPN01.GatewayTLSRoute.Labels {
+		labels[k] = v
+	}
+	return labels
+}
+
+func generateTLSRouteSpec(ch *cmacme.Challenge, svcName string) gwapiv1alpha2.TLSRouteSpec {
+	return gwapiv1alpha2.TLSRouteSpec{
+		CommonRouteSpec: gwapi.CommonRouteSpec{
+			ParentRefs: ch.Spec.Solver.TLSALPN01.GatewayTLSRoute.ParentRefs,
+		},
+		Hostnames: []gwapi.Hostname{
+			gwapi.Hostname(ch.Spec.DNSName),
+		},
+		Rules: []gwapiv1alpha2.TLSRouteRule{
+			{
+				BackendRefs: []gwapi.BackendRef{
+					{
+						BackendObjectReference: gwapi.BackendObjectReference{
+							Group:     ptr.To(gwapi.Group("")),
+							Kind:      ptr.To(gwapi.Kind("Service")),
+							Name:      gwapi.ObjectName(svcName),
+							Namespace: ptr.To(gwapi.Namespace(ch.Namespace)),
+							Port:      ptr.To(gwapi.PortNumber(tlsALPN01Port)),
+						},
+						Weight: ptr.To(int32(1)),
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cert-manager/cert-manager/pkg/acme"
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
+)
+
+// errAlreadyRevoked is the ACME problem type returned when revoking a
+// certificate that has already been revoked (RFC 8555 section 6.7).
+const errAlreadyRevoked = "urn:ietf:params:acme:error:alreadyRevoked"
+
+var _ issuer.Revoker = &Acme{}
+
+// Revoke revokes the given certificate with the ACME server that issued it,
+// signing the request with the issuer's ACME account key for that server.
+func (a *Acme) Revoke(ctx context.Context, cert *x509.Certificate, reason v1.CertificateRevocationReason) error {
+	server, err := a.serverForCertificate(ctx, cert)
+	if err != nil {
+		return err
+	}
+
+	cl, err := a.accountRegistry.GetClient(accounts.ClientID(string(a.issuer.GetUID()), a.issuer.GetSpec().ACME, server))
+	if err != nil {
+		return err
+	}
+
+	err = cl.RevokeCert(ctx, nil, cert.Raw, acmeapi.CRLReasonCode(issuer.RevocationReasonCode(reason)))
+	if acmeErr, ok := err.(*acmeapi.Error); ok && acmeErr.ProblemType == errAlreadyRevoked {
+		// the certificate has been revoked before, e.g. by a previous
+		// attempt whose response was lost
+		return nil
+	}
+	return err
+}
+
+// serverForCertificate returns the ACME server that issued the given
+// certificate, as recorded on the Order that the certificate was issued for.
+// If the Order no longer exists, the issuer's primary server is assumed.
+func (a *Acme) serverForCertificate(ctx context.Context, cert *x509.Certificate) (string, error) {
+	if len(a.issuer.GetSpec().ACME.FallbackServers) == 0 {
+		return "", nil
+	}
+
+	// Orders are created in the namespace of their CertificateRequest, which
+	// can be any namespace for a ClusterIssuer.
+	orderList, err := a.cmClient.AcmeV1().Orders(a.issuer.GetNamespace()).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("error listing Orders: %w", err)
+	}
+
+	_, isClusterIssuer := a.issuer.(*v1.ClusterIssuer)
+	var orders []*cmacme.Order
+	for i := range orderList.Items {
+		o := &orderList.Items[i]
+		if o.Spec.IssuerRef.Name != a.issuer.GetName() || (o.Spec.IssuerRef.Kind == v1.ClusterIssuerKind) != isClusterIssuer {
+			continue
+		}
+		orders = append(orders, o)
+	}
+
+	server, _ := acme.ServerForCertificate(orders, cert)
+	return server, nil
+}
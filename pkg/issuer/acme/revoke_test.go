@@ -0,0 +1,119 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"crypto"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	fakeregistry "github.com/cert-manager/cert-manager/pkg/acme/accounts/test"
+	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmfake "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/fake"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	"github.com/cert-manager/cert-manager/third_party/forked/acme"
+)
+
+func TestAcme_Revoke(t *testing.T) {
+	const (
+		primaryServer  = "https://primary.example.com/directory"
+		fallbackServer = "https://fallback.example.com/directory"
+	)
+
+	pk := testcrypto.MustCreatePEMPrivateKey(t)
+	certPEM := testcrypto.MustCreateCert(t, pk, &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}})
+	cert, err := pki.DecodeX509CertificateBytes(certPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issuerWithFallback := gen.Issuer("test",
+		gen.SetIssuerNamespace("default"),
+		gen.SetIssuerACME(cmacme.ACMEIssuer{
+			Server:          primaryServer,
+			FallbackServers: []cmacme.ACMEFallbackServer{{Server: fallbackServer}},
+		}),
+	)
+	issuedBy := func(server string) *cmacme.Order {
+		return gen.Order("test",
+			gen.SetOrderNamespace("default"),
+			gen.SetOrderIssuer(cmmeta.ObjectReference{Name: "test", Kind: cmapi.IssuerKind}),
+			gen.SetOrderStatus(cmacme.OrderStatus{Server: server, Certificate: certPEM}),
+		)
+	}
+
+	tests := map[string]struct {
+		issuer         *cmapi.Issuer
+		orders         []runtime.Object
+		expectedClient string
+	}{
+		"revokes the certificate with the primary server if there are no fallback servers": {
+			issuer:         gen.Issuer("test", gen.SetIssuerNamespace("default"), gen.SetIssuerACMEURL(primaryServer)),
+			expectedClient: "test",
+		},
+		"revokes the certificate with the fallback server that issued it": {
+			issuer:         issuerWithFallback,
+			orders:         []runtime.Object{issuedBy(fallbackServer)},
+			expectedClient: "test/" + fallbackServer,
+		},
+		"revokes the certificate with the primary server that issued it": {
+			issuer:         issuerWithFallback,
+			orders:         []runtime.Object{issuedBy(primaryServer)},
+			expectedClient: "test",
+		},
+		"revokes the certificate with the primary server if its Order no longer exists": {
+			issuer:         issuerWithFallback,
+			expectedClient: "test",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			iss := test.issuer.DeepCopy()
+			iss.UID = "test"
+
+			var revokedWith string
+			a := Acme{
+				issuer:   iss,
+				cmClient: cmfake.NewSimpleClientset(test.orders...),
+				accountRegistry: &fakeregistry.FakeRegistry{
+					GetClientFunc: func(clientID string) (acmecl.Interface, error) {
+						return &acmecl.FakeACME{
+							FakeRevokeCert: func(context.Context, crypto.Signer, []byte, acme.CRLReasonCode) error {
+								revokedWith = clientID
+								return nil
+							},
+						}, nil
+					},
+				},
+			}
+
+			if err := a.Revoke(context.Background(), cert, cmapi.RevocationReasonKeyCompromise); err != nil {
+				t.Fatal(err)
+			}
+			if revokedWith != test.expectedClient {
+				t.Errorf("expected the certificate to be revoked with client %q, got %q", test.expectedClient, revokedWith)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/cert-manager/cert-manager/pkg/acme"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	cmerrors "github.com/cert-manager/cert-manager/pkg/util/errors"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
+)
+
+// rolloverAccountKey replaces the key of the ACME account registered at
+// accountURI with the key referenced by the issuer's nextPrivateKeySecretRef,
+// using the keyChange flow described in RFC 8555 section 7.3.5.
+// Once the ACME server has accepted the new key, the key stored in the
+// privateKeySecretRef Secret is replaced by the new key.
+//
+// It returns the new account key, or nil if the next key is already the
+// current account key and there is nothing to do.
+// Errors that cannot be resolved by retrying are returned as InvalidData errors.
+func (a *Acme) rolloverAccountKey(ctx context.Context, httpClient *http.Client, currentKey crypto.Signer, accountURI, ns string) (crypto.Signer, error) {
+	log := logf.FromContext(ctx)
+	spec := a.issuer.GetSpec().ACME
+	nextSel := acme.PrivateKeySelector(*spec.NextPrivateKey)
+
+	nextKey, err := a.keyFromSecret(ctx, ns, nextSel.Name, nextSel.Key)
+	switch {
+	case !spec.DisableAccountKeyGeneration && apierrors.IsNotFound(err):
+		log.V(logf.InfoLevel).Info("generating next acme account private key")
+		nextKey, err = a.createAccountPrivateKey(ctx, nextSel, spec.AccountPrivateKey, ns)
+		if err != nil {
+			return nil, err
+		}
+	case spec.DisableAccountKeyGeneration && apierrors.IsNotFound(err):
+		return nil, cmerrors.NewInvalidData("the ACME issuer config has 'disableAccountKeyGeneration' set to true, but the next private key secret was not found: %v", err)
+	case err != nil:
+		return nil, err
+	}
+
+	if !isSupportedAccountKey(nextKey) {
+		return nil, cmerrors.NewInvalidData(messageTemplateUnsupportedKey, nextSel.Name)
+	}
+
+	equal, err := pki.PublicKeysEqual(currentKey.Public(), nextKey.Public())
+	if err != nil {
+		return nil, err
+	}
+	if equal {
+		// The rollover has already been completed.
+		return nil, nil
+	}
+
+	// A previous rollover may have succeeded without the new key being stored
+	// in the account key Secret. In that case the current key is no longer
+	// bound to the account, so check whether the next key already is.
+	nextCl := a.clientBuilder(httpClient, *spec, nextKey, a.userAgent)
+	account, err := nextCl.GetReg(ctx, "")
+	switch {
+	case errors.Is(err, acmeapi.ErrNoAccount):
+		cl := a.clientBuilder(httpClient, *spec, currentKey, a.userAgent)
+		if err := cl.AccountKeyRollover(ctx, nextKey); err != nil {
+			return nil, err
+		}
+		log.V(logf.InfoLevel).Info("rolled over acme account key")
+	case err != nil:
+		return nil, err
+	case account.URI != accountURI:
+		return nil, cmerrors.NewInvalidData("the next private key is already registered to a different ACME account %q", account.URI)
+	default:
+		log.V(logf.InfoLevel).Info("next acme account key is already bound to the account")
+	}
+
+	if err := a.storeAccountPrivateKey(ctx, ns, nextKey); err != nil {
+		return nil, err
+	}
+
+	return nextKey, nil
+}
+
+// storeAccountPrivateKey replaces the key stored in the issuer's
+// privateKeySecretRef Secret with the given key.
+func (a *Acme) storeAccountPrivateKey(ctx context.Context, ns string, pk crypto.Signer) error {
+	sel := acme.PrivateKeySelector(a.issuer.GetSpec().ACME.PrivateKey)
+
+	keyData, err := encodeAccountPrivateKey(pk)
+	if err != nil {
+		return err
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"data": map[string][]byte{
+			sel.Key: keyData,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = a.secretsClient.Secrets(ns).Patch(ctx, sel.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to store the new ACME account key: %w", err)
+	}
+
+	return nil
+}
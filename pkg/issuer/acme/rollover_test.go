@@ -0,0 +1,220 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmerrors "github.com/cert-manager/cert-manager/pkg/util/errors"
+	"github.com/cert-manager/cert-manager/test/unit/coreclients"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
+)
+
+func TestAcme_rolloverAccountKey(t *testing.T) {
+	const (
+		currentSecretName = "current"
+		nextSecretName    = "next"
+		accountURI        = "https://acme.example.com/acct/1"
+	)
+	var (
+		currentKey = mustGenerateRSAKey(t)
+		nextKey    = mustGenerateEDCSAKey(t)
+		someErr    = errors.New("some error")
+		notFound   = apierrors.NewNotFound(corev1.Resource("secrets"), nextSecretName)
+
+		baseIssuer = gen.Issuer("test-issuer",
+			gen.SetIssuerACMEURL(acmev2Prod),
+			gen.SetIssuerACMEPrivKeyRef(currentSecretName),
+			gen.SetIssuerACMENextPrivKeyRef(nextSecretName))
+	)
+
+	tests := map[string]struct {
+		disableKeyGeneration bool
+
+		// keys returned by the keyFromSecret stub, by Secret name
+		keys map[string]crypto.Signer
+		// account returned by a GetReg call using the next key
+		nextAccount *acmeapi.Account
+		nextGetErr  error
+		rolloverErr error
+		createErr   error
+
+		expectRollover bool
+		expectPatch    bool
+		expectKey      bool
+		expectErr      bool
+		expectInvalid  bool
+	}{
+		"next key equals the current key, nothing to do": {
+			keys: map[string]crypto.Signer{currentSecretName: currentKey, nextSecretName: currentKey},
+		},
+		"next key is not bound to an account, rollover": {
+			keys:           map[string]crypto.Signer{currentSecretName: currentKey, nextSecretName: nextKey},
+			nextGetErr:     acmeapi.ErrNoAccount,
+			expectRollover: true,
+			expectPatch:    true,
+			expectKey:      true,
+		},
+		"next key is already bound to the account, store it without rollover": {
+			keys:        map[string]crypto.Signer{currentSecretName: currentKey, nextSecretName: nextKey},
+			nextAccount: &acmeapi.Account{URI: accountURI},
+			expectPatch: true,
+			expectKey:   true,
+		},
+		"next key is bound to a different account": {
+			keys:          map[string]crypto.Signer{currentSecretName: currentKey, nextSecretName: nextKey},
+			nextAccount:   &acmeapi.Account{URI: "https://acme.example.com/acct/2"},
+			expectErr:     true,
+			expectInvalid: true,
+		},
+		"checking the next key registration fails": {
+			keys:       map[string]crypto.Signer{currentSecretName: currentKey, nextSecretName: nextKey},
+			nextGetErr: someErr,
+			expectErr:  true,
+		},
+		"rollover fails": {
+			keys:           map[string]crypto.Signer{currentSecretName: currentKey, nextSecretName: nextKey},
+			nextGetErr:     acmeapi.ErrNoAccount,
+			rolloverErr:    &acmeapi.Error{StatusCode: 400},
+			expectRollover: true,
+			expectErr:      true,
+		},
+		"next key secret does not exist, generate it and rollover": {
+			keys:           map[string]crypto.Signer{currentSecretName: currentKey},
+			nextGetErr:     acmeapi.ErrNoAccount,
+			expectRollover: true,
+			expectPatch:    true,
+			expectKey:      true,
+		},
+		"next key secret does not exist, generating it fails": {
+			keys:      map[string]crypto.Signer{currentSecretName: currentKey},
+			createErr: someErr,
+			expectErr: true,
+		},
+		"next key secret does not exist and account key generation is disabled": {
+			disableKeyGeneration: true,
+			keys:                 map[string]crypto.Signer{currentSecretName: currentKey},
+			expectErr:            true,
+			expectInvalid:        true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			issuer := gen.IssuerFrom(baseIssuer)
+			if test.disableKeyGeneration {
+				issuer = gen.IssuerFrom(issuer, gen.SetIssuerACMEDisableAccountKeyGeneration(true))
+			}
+
+			var patchedSecret string
+			var patchedData []byte
+			secretsClient := coreclients.NewFakeSecretsGetter(
+				coreclients.SetFakeSecretsGetterCreate(nil, test.createErr),
+				coreclients.SetFakeSecretsGetterPatchFn(func(_ context.Context, name string, pt types.PatchType, data []byte, _ metav1.PatchOptions, _ ...string) (*corev1.Secret, error) {
+					if pt != types.MergePatchType {
+						t.Errorf("expected a merge patch, got %q", pt)
+					}
+					patchedSecret = name
+					patchedData = data
+					return nil, nil
+				}),
+			)
+			kfs := func(_ context.Context, _, name, _ string) (crypto.Signer, error) {
+				if key, ok := test.keys[name]; ok {
+					return key, nil
+				}
+				return nil, notFound
+			}
+
+			rolloverCalled := false
+			currentCl := &acmecl.FakeACME{
+				FakeAccountKeyRollover: func(_ context.Context, newKey crypto.Signer) error {
+					rolloverCalled = true
+					return test.rolloverErr
+				},
+			}
+			nextCl := &acmecl.FakeACME{
+				FakeGetReg: func(context.Context, string) (*acmeapi.Account, error) {
+					return test.nextAccount, test.nextGetErr
+				},
+			}
+
+			a := Acme{
+				issuer:        issuer,
+				secretsClient: secretsClient,
+				keyFromSecret: kfs,
+				clientBuilder: func(_ *http.Client, _ cmacme.ACMEIssuer, key crypto.Signer, _ string) acmecl.Interface {
+					if key == currentKey {
+						return currentCl
+					}
+					return nextCl
+				},
+			}
+
+			gotKey, err := a.rolloverAccountKey(context.Background(), http.DefaultClient, currentKey, accountURI, "ns")
+			if (err != nil) != test.expectErr {
+				t.Fatalf("expected error: %v, got: %v", test.expectErr, err)
+			}
+			if cmerrors.IsInvalidData(err) != test.expectInvalid {
+				t.Errorf("expected invalid data error: %v, got: %v", test.expectInvalid, err)
+			}
+			if (gotKey != nil) != test.expectKey {
+				t.Errorf("expected a new key to be returned: %v, got: %v", test.expectKey, gotKey)
+			}
+			if gotKey != nil && test.keys[nextSecretName] != nil && gotKey != test.keys[nextSecretName] {
+				t.Errorf("expected the next key to be returned")
+			}
+			if patchCalled := patchedData != nil; patchCalled != test.expectPatch {
+				t.Errorf("expected account key Secret to be patched: %v, was patched: %v", test.expectPatch, patchCalled)
+			}
+			if patchedData != nil {
+				if patchedSecret != currentSecretName {
+					t.Errorf("expected Secret %q to be patched, got %q", currentSecretName, patchedSecret)
+				}
+				keyData, err := encodeAccountPrivateKey(gotKey)
+				if err != nil {
+					t.Fatal(err)
+				}
+				var patch struct {
+					Data map[string][]byte `json:"data"`
+				}
+				if err := json.Unmarshal(patchedData, &patch); err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(patch.Data[corev1.TLSPrivateKeyKey], keyData) {
+					t.Errorf("expected the new key to be stored in the account key Secret")
+				}
+			}
+			if rolloverCalled != test.expectRollover {
+				t.Errorf("expected AccountKeyRollover to be called: %v, was called: %v", test.expectRollover, rolloverCalled)
+			}
+		})
+	}
+}
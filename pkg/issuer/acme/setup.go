@@ -18,15 +18,16 @@ package acme
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rsa"
-	"crypto/sha256"
-	"crypto/x509"
 	"encoding/base64"
 	"fmt"
 	"net/url"
 	"strings"
 
-	acmeapi "golang.org/x/crypto/acme"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -35,33 +36,40 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
 	"github.com/cert-manager/cert-manager/pkg/acme/client"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	"github.com/cert-manager/cert-manager/pkg/util/errors"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
 )
 
 const (
 	errorAccountRegistrationFailed = "ErrRegisterACMEAccount"
 	errorAccountVerificationFailed = "ErrVerifyACMEAccount"
 	errorAccountUpdateFailed       = "ErrUpdateACMEAccount"
+	errorAccountKeyRolloverFailed  = "ErrRolloverACMEAccountKey"
 	errorInvalidConfig             = "InvalidConfig"
 	errorInvalidURL                = "InvalidURL"
 
 	successAccountRegistered = "ACMEAccountRegistered"
 	successAccountVerified   = "ACMEAccountVerified"
+	successAccountKeyRolled  = "ACMEAccountKeyRolledOver"
 
 	messageAccountRegistrationFailed     = "Failed to register ACME account: "
 	messageAccountVerificationFailed     = "Failed to verify ACME account: "
 	messageAccountUpdateFailed           = "Failed to update ACME account:"
+	messageAccountKeyRolloverFailed      = "Failed to roll over ACME account key: "
+	messageProfileValidationFailed       = "Failed to validate ACME profile: "
+	messageAccountKeyRolled              = "The ACME account key was rolled over to the key in the next private key secret"
 	messageAccountRegistered             = "The ACME account was registered with the ACME server"
 	messageAccountVerified               = "The ACME account was verified with the ACME server"
 	messageNoSecretKeyGenerationDisabled = "the ACME issuer config has 'disableAccountKeyGeneration' set to true, but the secret was not found: "
 	messageInvalidPrivateKey             = "Account private key is invalid: "
 
 	messageTemplateUpdateToV2              = "Your ACME server URL is set to a v1 endpoint (%s). You should update the spec.acme.server field to %q"
-	messageTemplateNotRSA                  = "ACME private key in %q is not of type RSA"
+	messageTemplateUnsupportedKey          = "ACME private key in %q is not a supported RSA, ECDSA or Ed25519 key"
 	messageTemplateFailedToParseURL        = "Failed to parse existing ACME server URI %q: %v"
 	messageTemplateFailedToParseAccountURL = "Failed to parse existing ACME account URI %q: %v"
 	messageTemplateFailedToGetEABKey       = "failed to get External Account Binding key from secret: %v"
@@ -111,7 +119,7 @@ func (a *Acme) Setup(ctx context.Context) error {
 	switch {
 	case !a.issuer.GetSpec().ACME.DisableAccountKeyGeneration && apierrors.IsNotFound(err):
 		log.V(logf.InfoLevel).Info("generating acme account private key")
-		pk, err = a.createAccountPrivateKey(ctx, privateKeySelector, ns)
+		pk, err = a.createAccountPrivateKey(ctx, privateKeySelector, a.issuer.GetSpec().ACME.AccountPrivateKey, ns)
 		if err != nil {
 			msg = messageAccountRegistrationFailed + err.Error()
 			reason = errorAccountRegistrationFailed
@@ -141,15 +149,14 @@ func (a *Acme) Setup(ctx context.Context) error {
 		msg = messageAccountVerificationFailed + err.Error()
 		return fmt.Errorf(msg)
 	}
-	rsaPk, ok := pk.(*rsa.PrivateKey)
-	if !ok {
+	if !isSupportedAccountKey(pk) {
 		reason = errorAccountVerificationFailed
-		msg = fmt.Sprintf(messageTemplateNotRSA,
+		msg = fmt.Sprintf(messageTemplateUnsupportedKey,
 			a.issuer.GetSpec().ACME.PrivateKey.Name)
 		return nil
 	}
 
-	isPKChecksumSame := a.accountRegistry.IsKeyCheckSumCached(a.issuer.GetStatus().ACMEStatus().LastPrivateKeyHash, rsaPk)
+	isPKChecksumSame := a.accountRegistry.IsKeyCheckSumCached(a.issuer.GetStatus().ACMEStatus().LastPrivateKeyHash, pk)
 
 	// TODO: don't always clear the client cache.
 	//  In future we should intelligently manage items in the account cache
@@ -162,7 +169,7 @@ func (a *Acme) Setup(ctx context.Context) error {
 
 	httpClient := accounts.BuildHTTPClientWithCABundle(a.metrics, a.issuer.GetSpec().ACME.SkipTLSVerify, a.issuer.GetSpec().ACME.CABundle)
 
-	cl := a.clientBuilder(httpClient, *a.issuer.GetSpec().ACME, rsaPk, a.userAgent)
+	cl := a.clientBuilder(httpClient, *a.issuer.GetSpec().ACME, pk, a.userAgent)
 
 	// TODO: perform a complex check to determine whether we need to verify
 	// the existing registration with the ACME server.
@@ -191,11 +198,54 @@ func (a *Acme) Setup(ctx context.Context) error {
 		// absorb errors as retrying will not help resolve this error
 		return nil
 	}
+	// roll over the account key if a next key is configured for an account
+	// that is registered with the configured ACME server.
+	if a.issuer.GetSpec().ACME.NextPrivateKey != nil &&
+		rawAccountURL != "" &&
+		parsedAccountURL.Host == parsedServerURL.Host {
+		nextPk, err := a.rolloverAccountKey(ctx, httpClient, pk, rawAccountURL, ns)
+		if err != nil {
+			reason = errorAccountKeyRolloverFailed
+			msg = messageAccountKeyRolloverFailed + err.Error()
+			log.Error(err, "failed to roll over ACME account key")
+			a.recorder.Event(a.issuer, corev1.EventTypeWarning, errorAccountKeyRolloverFailed, msg)
+
+			// Do not retry if the configuration is invalid or the ACME server
+			// rejected the new key.
+			if errors.IsInvalidData(err) {
+				return nil
+			}
+			if acmeErr, ok := err.(*acmeapi.Error); ok && acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
+				return nil
+			}
+			return err
+		}
+		if nextPk != nil {
+			pk = nextPk
+			cl = a.clientBuilder(httpClient, *a.issuer.GetSpec().ACME, pk, a.userAgent)
+			isPKChecksumSame = false
+			a.recorder.Event(a.issuer, corev1.EventTypeNormal, successAccountKeyRolled, messageAccountKeyRolled)
+		}
+	}
+
 	hasReadyCondition := apiutil.IssuerHasCondition(a.issuer, v1.IssuerCondition{
 		Type:   v1.IssuerConditionReady,
 		Status: cmmeta.ConditionTrue,
 	})
 
+	// check that the configured profile is offered by the ACME server. This
+	// is only done if the issuer has changed since it last became ready, to
+	// avoid contacting the ACME server on every resync.
+	if !hasReadyCondition || readyObservedGeneration(a.issuer) != a.issuer.GetGeneration() {
+		if err := a.validateProfile(ctx, cl); err != nil {
+			reason = errorInvalidConfig
+			msg = messageProfileValidationFailed + err.Error()
+			a.recorder.Event(a.issuer, corev1.EventTypeWarning, errorInvalidConfig, msg)
+			// Return nil, because we do not want to re-queue an Issuer with an invalid spec.
+			return nil
+		}
+	}
+
 	// If the Host components of the server URL and the account URL match,
 	// and the cached email matches the registered email, then
 	// we skip re-checking the account status to save excess calls to the
@@ -217,8 +267,8 @@ func (a *Acme) Setup(ctx context.Context) error {
 		status = cmmeta.ConditionTrue
 
 		// ensure the cached client in the account registry is up to date
-		a.accountRegistry.AddClient(httpClient, string(a.issuer.GetUID()), *a.issuer.GetSpec().ACME, rsaPk, a.userAgent)
-		return nil
+		a.accountRegistry.AddClient(httpClient, string(a.issuer.GetUID()), *a.issuer.GetSpec().ACME, pk, a.userAgent)
+		return a.setupFallbackServers(ctx, httpClient, ns)
 	}
 
 	if parsedAccountURL.Host != parsedServerURL.Host {
@@ -229,7 +279,7 @@ func (a *Acme) Setup(ctx context.Context) error {
 
 	var eabAccount *acmeapi.ExternalAccountBinding
 	if eabObj := a.issuer.GetSpec().ACME.ExternalAccountBinding; eabObj != nil {
-		eabKey, err := a.getEABKey(ctx, ns)
+		eabKey, err := a.getEABKey(ctx, ns, eabObj.Key)
 		switch {
 		// Do not re-try if we fail to get the MAC key as it does not exist at the reference.
 		case apierrors.IsNotFound(err), errors.IsInvalidData(err):
@@ -316,16 +366,46 @@ func (a *Acme) Setup(ctx context.Context) error {
 	status = cmmeta.ConditionTrue
 	reason = successAccountRegistered
 	msg = messageAccountRegistered
-	privateKeyBytes := x509.MarshalPKCS1PrivateKey(rsaPk)
-	checksum := sha256.Sum256(privateKeyBytes)
-	checksumString := base64.StdEncoding.EncodeToString(checksum[:])
+	checksumString, err := accounts.PrivateKeyChecksum(pk)
+	if err != nil {
+		return err
+	}
 	a.issuer.GetStatus().ACMEStatus().URI = account.URI
 	a.issuer.GetStatus().ACMEStatus().LastRegisteredEmail = registeredEmail
 	a.issuer.GetStatus().ACMEStatus().LastPrivateKeyHash = checksumString
 	// ensure the cached client in the account registry is up to date
-	a.accountRegistry.AddClient(httpClient, string(a.issuer.GetUID()), *a.issuer.GetSpec().ACME, rsaPk, a.userAgent)
+	a.accountRegistry.AddClient(httpClient, string(a.issuer.GetUID()), *a.issuer.GetSpec().ACME, pk, a.userAgent)
 
-	return nil
+	return a.setupFallbackServers(ctx, httpClient, ns)
+}
+
+// validateProfile returns an error if the ACME server does not offer the
+// profile configured on the issuer. If the ACME server's directory cannot be
+// retrieved, the profile is not validated here so that the fallback servers
+// can still be set up; it is validated again when Orders are created.
+func (a *Acme) validateProfile(ctx context.Context, cl client.Interface) error {
+	profile := a.issuer.GetSpec().ACME.Profile
+	if profile == "" {
+		return nil
+	}
+
+	dir, err := cl.Discover(ctx)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "failed to retrieve the ACME directory, skipping validating the ACME profile")
+		return nil
+	}
+	return acme.ValidateProfile(dir, profile)
+}
+
+// readyObservedGeneration returns the generation of the issuer observed by
+// its Ready condition, or 0 if it does not have a Ready condition.
+func readyObservedGeneration(iss v1.GenericIssuer) int64 {
+	for _, cond := range iss.GetStatus().Conditions {
+		if cond.Type == v1.IssuerConditionReady {
+			return cond.ObservedGeneration
+		}
+	}
+	return 0
 }
 
 func ensureEmailUpToDate(ctx context.Context, cl client.Interface, acc *acmeapi.Account, specEmail string) (*acmeapi.Account, string, error) {
@@ -391,8 +471,7 @@ func (a *Acme) registerAccount(ctx context.Context, cl client.Interface, eabAcco
 	return acc, nil
 }
 
-func (a *Acme) getEABKey(ctx context.Context, ns string) ([]byte, error) {
-	eab := a.issuer.GetSpec().ACME.ExternalAccountBinding.Key
+func (a *Acme) getEABKey(ctx context.Context, ns string, eab cmmeta.SecretKeySelector) ([]byte, error) {
 	sec, err := a.secretsClient.Secrets(ns).Get(ctx, eab.Name, metav1.GetOptions{})
 	// Surface IsNotFound API error to not cause re-sync
 	if apierrors.IsNotFound(err) {
@@ -420,11 +499,68 @@ func (a *Acme) getEABKey(ctx context.Context, ns string) ([]byte, error) {
 	return keyData, nil
 }
 
-// createAccountPrivateKey will generate a new RSA private key, and create it
+// isSupportedAccountKey returns true if the given private key can be used to
+// sign requests to an ACME server.
+func isSupportedAccountKey(pk crypto.Signer) bool {
+	switch pk := pk.(type) {
+	case *rsa.PrivateKey, ed25519.PrivateKey:
+		return true
+	case *ecdsa.PrivateKey:
+		switch pk.Curve {
+		case elliptic.P256(), elliptic.P384(), elliptic.P521():
+			return true
+		}
+	}
+	return false
+}
+
+// generateAccountPrivateKey generates a new ACME account private key using
+// the given configuration. If the configuration is nil, a RSA key of the
+// minimum allowed size is generated.
+func generateAccountPrivateKey(cfg *cmacme.ACMEAccountPrivateKey) (crypto.Signer, error) {
+	if cfg == nil {
+		return pki.GenerateRSAPrivateKey(pki.MinRSAKeySize)
+	}
+
+	switch cfg.Algorithm {
+	case cmacme.RSAACMEAccountKeyAlgorithm, "":
+		keySize := pki.MinRSAKeySize
+		if cfg.Size > 0 {
+			keySize = cfg.Size
+		}
+		return pki.GenerateRSAPrivateKey(keySize)
+	case cmacme.ECDSAACMEAccountKeyAlgorithm:
+		keySize := pki.ECCurve256
+		if cfg.Size > 0 {
+			keySize = cfg.Size
+		}
+		return pki.GenerateECPrivateKey(keySize)
+	case cmacme.Ed25519ACMEAccountKeyAlgorithm:
+		return pki.GenerateEd25519PrivateKey()
+	default:
+		return nil, fmt.Errorf("unsupported ACME account private key algorithm %q", cfg.Algorithm)
+	}
+}
+
+// encodeAccountPrivateKey PEM encodes an ACME account private key.
+// RSA keys are PKCS#1 encoded, all other key types are PKCS#8 encoded.
+func encodeAccountPrivateKey(pk crypto.Signer) ([]byte, error) {
+	if rsaKey, ok := pk.(*rsa.PrivateKey); ok {
+		return pki.EncodePKCS1PrivateKey(rsaKey), nil
+	}
+	return pki.EncodePKCS8PrivateKey(pk)
+}
+
+// createAccountPrivateKey will generate a new private key, and create it
 // as a secret resource in the apiserver.
-func (a *Acme) createAccountPrivateKey(ctx context.Context, sel cmmeta.SecretKeySelector, ns string) (*rsa.PrivateKey, error) {
+func (a *Acme) createAccountPrivateKey(ctx context.Context, sel cmmeta.SecretKeySelector, cfg *cmacme.ACMEAccountPrivateKey, ns string) (crypto.Signer, error) {
 	sel = acme.PrivateKeySelector(sel)
-	accountPrivKey, err := pki.GenerateRSAPrivateKey(pki.MinRSAKeySize)
+	accountPrivKey, err := generateAccountPrivateKey(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	keyData, err := encodeAccountPrivateKey(accountPrivKey)
 	if err != nil {
 		return nil, err
 	}
@@ -435,7 +571,7 @@ func (a *Acme) createAccountPrivateKey(ctx context.Context, sel cmmeta.SecretKey
 			Namespace: ns,
 		},
 		Data: map[string][]byte{
-			sel.Key: pki.EncodePKCS1PrivateKey(accountPrivKey),
+			sel.Key: keyData,
 		},
 	}, metav1.CreateOptions{})
 
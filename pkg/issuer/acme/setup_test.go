@@ -19,6 +19,10 @@ package acme
 import (
 	"context"
 	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/rsa"
 	"fmt"
 	"net/http"
@@ -28,7 +32,6 @@ import (
 	"testing"
 	"time"
 
-	acmeapi "golang.org/x/crypto/acme"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -46,6 +49,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 	"github.com/cert-manager/cert-manager/test/unit/coreclients"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
 )
 
 func TestAcme_Setup(t *testing.T) {
@@ -67,8 +71,11 @@ func TestAcme_Setup(t *testing.T) {
 			gen.SetIssuerConditionLastTransitionTime(&nowMetaTime))
 		issuerSecretKeyName = "test"
 
-		ecdsaPrivKey = mustGenerateEDCSAKey(t)
-		rsaPrivKey   = mustGenerateRSAKey(t)
+		ecdsaPrivKey   = mustGenerateEDCSAKey(t)
+		ed25519PrivKey = mustGenerateEd25519Key(t)
+		rsaPrivKey     = mustGenerateRSAKey(t)
+		// P-224 keys cannot be used to sign ACME requests.
+		p224PrivKey = mustGenerateP224Key(t)
 
 		notFoundErr    = apierrors.NewNotFound(corev1.Resource("test"), "test")
 		invalidDataErr = errors.NewInvalidData("test")
@@ -82,6 +89,9 @@ func TestAcme_Setup(t *testing.T) {
 		invalidURLMessage        = fmt.Sprintf(messageTemplateFailedToParseURL, invalidURL, invalidURLErr)
 		invalidAccountURLMessage = fmt.Sprintf(messageTemplateFailedToParseAccountURL, invalidURL, invalidURLErr)
 
+		unsupportedProfileMessage = messageProfileValidationFailed +
+			`profile "tlsserver" is not offered by the ACME server, supported profiles are: classic, shortlived`
+
 		someEmail    = "test@test.com"
 		someEmailURL = fmt.Sprintf("mailto:%s", someEmail)
 
@@ -125,6 +135,11 @@ func TestAcme_Setup(t *testing.T) {
 		// Error return by cl.UpdateRegistration
 		updateRegError error
 
+		// Profiles advertised in the directory returned by cl.Discover
+		directoryProfiles map[string]string
+		// Error returned by cl.Discover
+		discoverErr error
+
 		// Error returned when creating ACME account key.
 		acmePrivKeySecretCreateErr error
 		// ACME account key created by createAccountPrivateKey.
@@ -192,6 +207,27 @@ func TestAcme_Setup(t *testing.T) {
 			addClientShouldBeCalled:    true,
 			expectedRegisteredAcc:      &acmeapi.Account{},
 		},
+		"ACME private key secret does not exist, account key generation is enabled, ECDSA key creation succeeds": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEAccountPrivateKey(cmacme.ECDSAACMEAccountKeyAlgorithm, 384)),
+			kfsErr: notFoundErr,
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyTrueCondition)},
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+			expectedRegisteredAcc:      &acmeapi.Account{},
+		},
+		"ACME private key secret does not exist, account key generation is enabled, invalid key size": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEAccountPrivateKey(cmacme.ECDSAACMEAccountKeyAlgorithm, 123)),
+			kfsErr: notFoundErr,
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyFalseCondition,
+					gen.SetIssuerConditionReason(errorAccountRegistrationFailed),
+					gen.SetIssuerConditionMessage(messageAccountRegistrationFailed+"unsupported ecdsa key size specified: 123")),
+			},
+			wantsErr: true,
+		},
 		"ACME private key secret exists, but contains invalid private key": {
 			issuer: gen.IssuerFrom(baseIssuer),
 			kfsErr: invalidDataErr,
@@ -211,16 +247,34 @@ func TestAcme_Setup(t *testing.T) {
 			},
 			wantsErr: true,
 		},
-		"ACME account's key is not an RSA key": {
+		"ACME account's key is not a supported key": {
 			issuer: gen.IssuerFrom(baseIssuer,
 				gen.SetIssuerACMEPrivKeyRef(issuerSecretKeyName)),
-			kfsKey: ecdsaPrivKey,
+			kfsKey: p224PrivKey,
 			expectedConditions: []cmapi.IssuerCondition{
 				*gen.IssuerConditionFrom(readyFalseCondition,
 					gen.SetIssuerConditionReason(errorAccountVerificationFailed),
-					gen.SetIssuerConditionMessage(fmt.Sprintf(messageTemplateNotRSA, issuerSecretKeyName))),
+					gen.SetIssuerConditionMessage(fmt.Sprintf(messageTemplateUnsupportedKey, issuerSecretKeyName))),
 			},
 		},
+		"ACME account's key is an ECDSA key": {
+			issuer:                     gen.IssuerFrom(baseIssuer),
+			kfsKey:                     ecdsaPrivKey,
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+			expectedRegisteredAcc:      &acmeapi.Account{},
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyTrueCondition)},
+		},
+		"ACME account's key is an Ed25519 key": {
+			issuer:                     gen.IssuerFrom(baseIssuer),
+			kfsKey:                     ed25519PrivKey,
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+			expectedRegisteredAcc:      &acmeapi.Account{},
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyTrueCondition)},
+		},
 		"ACME server URL is an invalid URL": {
 			issuer: gen.IssuerFrom(baseIssuer,
 				gen.SetIssuerACMEURL(invalidURL)),
@@ -267,6 +321,76 @@ func TestAcme_Setup(t *testing.T) {
 			removeClientShouldBeCalled: true,
 			addClientShouldBeCalled:    true,
 		},
+		"ACME profile is offered by the ACME server": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEProfile("shortlived"),
+				gen.SetIssuerACMEAccountURL(acmev2Prod),
+				gen.SetIssuerACMEEmail(someEmail),
+				gen.SetIssuerACMELastRegisteredEmail(someEmail),
+				gen.SetIssuerACMELastPrivateKeyHash(someString),
+				gen.AddIssuerCondition(*gen.IssuerConditionFrom(readyTrueCondition))),
+			directoryProfiles: map[string]string{"classic": someString, "shortlived": someString},
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyTrueCondition),
+			},
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+		},
+		"ACME profile is not offered by the ACME server": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEProfile("tlsserver")),
+			directoryProfiles:          map[string]string{"classic": someString, "shortlived": someString},
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyFalseCondition,
+					gen.SetIssuerConditionReason(errorInvalidConfig),
+					gen.SetIssuerConditionMessage(unsupportedProfileMessage)),
+			},
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, errorInvalidConfig, unsupportedProfileMessage),
+			},
+		},
+		"ACME profile is validated if the issuer has changed since it became ready": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEProfile("tlsserver"),
+				gen.SetIssuerACMEAccountURL(acmev2Prod),
+				gen.SetIssuerACMEEmail(someEmail),
+				gen.SetIssuerACMELastRegisteredEmail(someEmail),
+				gen.SetIssuerACMELastPrivateKeyHash(someString),
+				withGeneration(2),
+				gen.AddIssuerCondition(*gen.IssuerConditionFrom(readyTrueCondition, withObservedGeneration(1)))),
+			directoryProfiles:          map[string]string{"classic": someString, "shortlived": someString},
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyFalseCondition,
+					gen.SetIssuerConditionReason(errorInvalidConfig),
+					gen.SetIssuerConditionMessage(unsupportedProfileMessage),
+					withObservedGeneration(2)),
+			},
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, errorInvalidConfig, unsupportedProfileMessage),
+			},
+		},
+		"ACME profile is not validated if the ACME directory cannot be retrieved": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEProfile("shortlived"),
+				gen.SetIssuerACMEAccountURL(acmev2Prod),
+				gen.SetIssuerACMEEmail(someEmail),
+				gen.SetIssuerACMELastRegisteredEmail(someEmail),
+				gen.SetIssuerACMELastPrivateKeyHash(someString),
+				withGeneration(2),
+				gen.AddIssuerCondition(*gen.IssuerConditionFrom(readyTrueCondition, withObservedGeneration(1)))),
+			discoverErr: someErr,
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyTrueCondition, withObservedGeneration(2)),
+			},
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+		},
 		"EAB for issuer specified, but the corresponding secret is not found": {
 			issuer: gen.IssuerFrom(baseIssuer,
 				gen.SetIssuerACMEEAB(someString, someString)),
@@ -536,10 +660,10 @@ func TestAcme_Setup(t *testing.T) {
 				RemoveClientFunc: func(string) {
 					removeClientWasCalled = true
 				},
-				AddClientFunc: func(string, cmacme.ACMEIssuer, *rsa.PrivateKey, string) {
+				AddClientFunc: func(string, cmacme.ACMEIssuer, crypto.Signer, string) {
 					addClientWasCalled = true
 				},
-				IsKeyCheckSumCachedFunc: func(lastPrivateKeyHash string, privateKey *rsa.PrivateKey) bool {
+				IsKeyCheckSumCachedFunc: func(lastPrivateKeyHash string, privateKey crypto.Signer) bool {
 					return true
 				},
 			}
@@ -557,6 +681,9 @@ func TestAcme_Setup(t *testing.T) {
 				FakeUpdateReg: func(ctx context.Context, a *acmeapi.Account) (*acmeapi.Account, error) {
 					return a, test.updateRegError
 				},
+				FakeDiscover: func(context.Context) (acmeapi.Directory, error) {
+					return acmeapi.Directory{Profiles: test.directoryProfiles}, test.discoverErr
+				},
 			}
 
 			// Mock events recorder.
@@ -620,6 +747,18 @@ func TestAcme_Setup(t *testing.T) {
 	}
 }
 
+func withGeneration(generation int64) gen.IssuerModifier {
+	return func(iss cmapi.GenericIssuer) {
+		iss.GetObjectMeta().Generation = generation
+	}
+}
+
+func withObservedGeneration(generation int64) gen.IssuerConditionModifier {
+	return func(cond *cmapi.IssuerCondition) {
+		cond.ObservedGeneration = generation
+	}
+}
+
 // keyFromSecretMockBuilder returns a mock implementation of keyFromSecretFunc.
 func keyFromSecretMockBuilder(wasCalled *bool, key crypto.Signer, err error) keyFromSecretFunc {
 	return func(context.Context, string, string, string) (crypto.Signer, error) {
@@ -629,7 +768,7 @@ func keyFromSecretMockBuilder(wasCalled *bool, key crypto.Signer, err error) key
 }
 
 func clientBuilderMock(cl acmecl.Interface) accounts.NewClientFunc {
-	return func(*http.Client, cmacme.ACMEIssuer, *rsa.PrivateKey, string) acmecl.Interface {
+	return func(*http.Client, cmacme.ACMEIssuer, crypto.Signer, string) acmecl.Interface {
 		return cl
 	}
 }
@@ -656,3 +795,92 @@ func mustGenerateRSAKey(t *testing.T) crypto.Signer {
 	}
 	return key
 }
+
+func mustGenerateEd25519Key(t *testing.T) crypto.Signer {
+	t.Helper()
+	key, err := pki.GenerateEd25519PrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func mustGenerateP224Key(t *testing.T) crypto.Signer {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P224(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestGenerateAccountPrivateKey(t *testing.T) {
+	tests := map[string]struct {
+		cfg *cmacme.ACMEAccountPrivateKey
+
+		check   func(t *testing.T, pk crypto.Signer)
+		wantErr bool
+	}{
+		"no configuration generates a 2048 bit RSA key": {
+			check: func(t *testing.T, pk crypto.Signer) {
+				if rsaKey, ok := pk.(*rsa.PrivateKey); !ok || rsaKey.N.BitLen() != 2048 {
+					t.Errorf("expected 2048 bit RSA key, got %T", pk)
+				}
+			},
+		},
+		"RSA with size": {
+			cfg: &cmacme.ACMEAccountPrivateKey{Algorithm: cmacme.RSAACMEAccountKeyAlgorithm, Size: 3072},
+			check: func(t *testing.T, pk crypto.Signer) {
+				if rsaKey, ok := pk.(*rsa.PrivateKey); !ok || rsaKey.N.BitLen() != 3072 {
+					t.Errorf("expected 3072 bit RSA key, got %T", pk)
+				}
+			},
+		},
+		"ECDSA defaults to P-256": {
+			cfg: &cmacme.ACMEAccountPrivateKey{Algorithm: cmacme.ECDSAACMEAccountKeyAlgorithm},
+			check: func(t *testing.T, pk crypto.Signer) {
+				if ecKey, ok := pk.(*ecdsa.PrivateKey); !ok || ecKey.Curve != elliptic.P256() {
+					t.Errorf("expected P-256 ECDSA key, got %T", pk)
+				}
+			},
+		},
+		"ECDSA P-384": {
+			cfg: &cmacme.ACMEAccountPrivateKey{Algorithm: cmacme.ECDSAACMEAccountKeyAlgorithm, Size: 384},
+			check: func(t *testing.T, pk crypto.Signer) {
+				if ecKey, ok := pk.(*ecdsa.PrivateKey); !ok || ecKey.Curve != elliptic.P384() {
+					t.Errorf("expected P-384 ECDSA key, got %T", pk)
+				}
+			},
+		},
+		"Ed25519": {
+			cfg: &cmacme.ACMEAccountPrivateKey{Algorithm: cmacme.Ed25519ACMEAccountKeyAlgorithm},
+			check: func(t *testing.T, pk crypto.Signer) {
+				if _, ok := pk.(ed25519.PrivateKey); !ok {
+					t.Errorf("expected Ed25519 key, got %T", pk)
+				}
+			},
+		},
+		"unknown algorithm": {
+			cfg:     &cmacme.ACMEAccountPrivateKey{Algorithm: "DSA"},
+			wantErr: true,
+		},
+		"RSA key too small": {
+			cfg:     &cmacme.ACMEAccountPrivateKey{Algorithm: cmacme.RSAACMEAccountKeyAlgorithm, Size: 1024},
+			wantErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			pk, err := generateAccountPrivateKey(test.cfg)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error: %v, got: %v", test.wantErr, err)
+			}
+			if test.check != nil {
+				test.check(t, pk)
+			}
+			if pk != nil && !isSupportedAccountKey(pk) {
+				t.Errorf("generated key of type %T is not a supported account key", pk)
+			}
+		})
+	}
+}
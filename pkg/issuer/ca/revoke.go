@@ -0,0 +1,155 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	"github.com/cert-manager/cert-manager/pkg/util/kube"
+)
+
+const (
+	// crlKey is the key of the CRL Secret in which the PEM encoded CRL is
+	// stored.
+	crlKey = "ca.crl"
+
+	// crlValidity is the time until the next update of a CRL. The CRL is
+	// signed again once more than half of its validity has passed.
+	crlValidity = 7 * 24 * time.Hour
+)
+
+var _ issuer.Revoker = &CA{}
+
+// Revoke adds the given certificate to the Certificate Revocation List that
+// is maintained in the Secret named by `spec.ca.crlSecretName`.
+func (c *CA) Revoke(ctx context.Context, cert *x509.Certificate, reason v1.CertificateRevocationReason) error {
+	if c.issuer.GetSpec().CA.CRLSecretName == "" {
+		return fmt.Errorf("%w: spec.ca.crlSecretName is not set", issuer.ErrRevocationUnsupported)
+	}
+
+	return c.updateCRL(ctx, func(caCert *x509.Certificate, entries []x509.RevocationListEntry) ([]x509.RevocationListEntry, error) {
+		if err := cert.CheckSignatureFrom(caCert); err != nil {
+			return nil, fmt.Errorf("certificate was not issued by this CA: %w", err)
+		}
+		for _, entry := range entries {
+			if entry.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+				// certificate has already been revoked
+				return entries, nil
+			}
+		}
+		return append(entries, x509.RevocationListEntry{
+			SerialNumber:   cert.SerialNumber,
+			RevocationTime: c.Clock.Now(),
+			ReasonCode:     issuer.RevocationReasonCode(reason),
+		}), nil
+	}, true)
+}
+
+// refreshCRL ensures that the Certificate Revocation List exists, and signs
+// it again if more than half of its validity has passed.
+func (c *CA) refreshCRL(ctx context.Context) error {
+	return c.updateCRL(ctx, func(_ *x509.Certificate, entries []x509.RevocationListEntry) ([]x509.RevocationListEntry, error) {
+		return entries, nil
+	}, false)
+}
+
+// updateCRL reads the Certificate Revocation List from the CRL Secret,
+// passes its entries to mutate and stores the CRL, signed by the CA, with
+// the returned entries. If force is false, the CRL is only updated if it
+// does not exist, was signed by a different CA or is about to expire.
+func (c *CA) updateCRL(ctx context.Context, mutate func(*x509.Certificate, []x509.RevocationListEntry) ([]x509.RevocationListEntry, error), force bool) error {
+	spec := c.issuer.GetSpec().CA
+
+	caCerts, caKey, err := kube.SecretTLSKeyPair(ctx, c.secretsLister, c.resourceNamespace, spec.SecretName)
+	if err != nil {
+		return fmt.Errorf("error getting signing CA key pair: %w", err)
+	}
+	caCert := caCerts[0]
+
+	// Read the Secret using the client rather than the lister to make sure
+	// that revocations recorded in quick succession are not lost.
+	secret, err := c.Client.CoreV1().Secrets(c.resourceNamespace).Get(ctx, spec.CRLSecretName, metav1.GetOptions{})
+	exists := true
+	if apierrors.IsNotFound(err) {
+		exists = false
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      spec.CRLSecretName,
+				Namespace: c.resourceNamespace,
+			},
+		}
+	} else if err != nil {
+		return err
+	}
+
+	now := c.Clock.Now()
+	number := big.NewInt(1)
+	var entries []x509.RevocationListEntry
+	upToDate := false
+	if block, _ := pem.Decode(secret.Data[crlKey]); block != nil {
+		crl, err := x509.ParseRevocationList(block.Bytes)
+		// a CRL signed by a previous CA is replaced, as the certificates
+		// it lists cannot have been issued by the current CA
+		if err == nil && crl.CheckSignatureFrom(caCert) == nil {
+			entries = crl.RevokedCertificateEntries
+			number.Add(crl.Number, big.NewInt(1))
+			upToDate = now.Before(crl.NextUpdate.Add(-crlValidity / 2))
+		}
+	}
+	if upToDate && !force {
+		return nil
+	}
+
+	entries, err = mutate(caCert, entries)
+	if err != nil {
+		return err
+	}
+
+	crlDER, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:                    number,
+		ThisUpdate:                now,
+		NextUpdate:                now.Add(crlValidity),
+		RevokedCertificateEntries: entries,
+	}, caCert, caKey)
+	if err != nil {
+		return fmt.Errorf("error signing certificate revocation list: %w", err)
+	}
+
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
+	secret.Data[crlKey] = pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crlDER})
+
+	if exists {
+		_, err = c.Client.CoreV1().Secrets(c.resourceNamespace).Update(ctx, secret, metav1.UpdateOptions{})
+	} else {
+		_, err = c.Client.CoreV1().Secrets(c.resourceNamespace).Create(ctx, secret, metav1.CreateOptions{})
+	}
+	return err
+}
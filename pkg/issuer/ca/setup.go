@@ -31,10 +31,12 @@ import (
 const (
 	errorGetKeyPair     = "ErrGetKeyPair"
 	errorInvalidKeyPair = "ErrInvalidKeyPair"
+	errorUpdateCRL      = "ErrUpdateCRL"
 
 	successKeyPairVerified = "KeyPairVerified"
 
 	messageErrorGetKeyPair = "Error getting keypair for CA issuer: "
+	messageErrorUpdateCRL  = "Error updating certificate revocation list: "
 
 	messageKeyPairVerified = "Signing CA verified"
 )
@@ -71,6 +73,15 @@ func (c *CA) Setup(ctx context.Context) error {
 		return nil
 	}
 
+	if c.issuer.GetSpec().CA.CRLSecretName != "" {
+		// Failing to update the CRL does not prevent the issuer from signing
+		// certificates, so the issuer is still marked as ready.
+		if err := c.refreshCRL(ctx); err != nil {
+			log.Error(err, "error updating certificate revocation list")
+			c.Recorder.Event(c.issuer, corev1.EventTypeWarning, errorUpdateCRL, messageErrorUpdateCRL+err.Error())
+		}
+	}
+
 	log.V(logf.DebugLevel).Info("signing CA verified")
 	c.Recorder.Event(c.issuer, corev1.EventTypeNormal, successKeyPairVerified, messageKeyPairVerified)
 	apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionTrue, successKeyPairVerified, messageKeyPairVerified)
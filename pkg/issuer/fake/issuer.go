@@ -18,17 +18,24 @@ package fake
 
 import (
 	"context"
+	"crypto/x509"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/issuer"
 )
 
 type Issuer struct {
-	SetupFunc func(context.Context) error
-	IssueFunc func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error)
+	SetupFunc  func(context.Context) error
+	IssueFunc  func(context.Context, *cmapi.Certificate) (*issuer.IssueResponse, error)
+	RevokeFunc func(context.Context, *x509.Certificate, cmapi.CertificateRevocationReason) error
+
+	NeedsFinalizerFunc func() bool
+	FinalizeFunc       func(context.Context) error
 }
 
 var _ issuer.Interface = &Issuer{}
+var _ issuer.Revoker = &Issuer{}
+var _ issuer.Finalizer = &Issuer{}
 
 // Setup initialises the issuer. This may include registering accounts with
 // a service, creating a CA and storing it somewhere, or verifying
@@ -42,3 +49,29 @@ func (i *Issuer) Setup(ctx context.Context) error {
 func (i *Issuer) Issue(ctx context.Context, crt *cmapi.Certificate) (*issuer.IssueResponse, error) {
 	return i.IssueFunc(ctx, crt)
 }
+
+// Revoke attempts to revoke the given certificate. If RevokeFunc is not set,
+// the issuer does not support revocation.
+func (i *Issuer) Revoke(ctx context.Context, cert *x509.Certificate, reason cmapi.CertificateRevocationReason) error {
+	if i.RevokeFunc == nil {
+		return issuer.ErrRevocationUnsupported
+	}
+	return i.RevokeFunc(ctx, cert, reason)
+}
+
+// NeedsFinalizer returns true if the issuer resource should hold the
+// IssuerCleanupFinalizer. If NeedsFinalizerFunc is not set, it returns false.
+func (i *Issuer) NeedsFinalizer() bool {
+	if i.NeedsFinalizerFunc == nil {
+		return false
+	}
+	return i.NeedsFinalizerFunc()
+}
+
+// Finalize cleans up the external state of the issuer.
+func (i *Issuer) Finalize(ctx context.Context) error {
+	if i.FinalizeFunc == nil {
+		return nil
+	}
+	return i.FinalizeFunc(ctx)
+}
@@ -18,6 +18,10 @@ package issuer
 
 import (
 	"context"
+	"crypto/x509"
+	"errors"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 )
 
 type Interface interface {
@@ -27,6 +31,49 @@ type Interface interface {
 	Setup(ctx context.Context) error
 }
 
+// ErrRevocationUnsupported is returned by issuers that are unable to revoke
+// certificates, either because the issuer type does not support revocation
+// or because the issuer has not been configured for it.
+var ErrRevocationUnsupported = errors.New("issuer does not support revocation")
+
+// Revoker is implemented by issuers that support revoking the certificates
+// they have issued.
+type Revoker interface {
+	// Revoke revokes the given certificate, which must have been issued by
+	// this issuer.
+	Revoke(ctx context.Context, cert *x509.Certificate, reason v1.CertificateRevocationReason) error
+}
+
+// Finalizer is implemented by issuers that may need to clean up external
+// state when their Issuer or ClusterIssuer resource is deleted.
+type Finalizer interface {
+	// NeedsFinalizer returns true if the issuer resource should hold the
+	// IssuerCleanupFinalizer, given its current configuration.
+	NeedsFinalizer() bool
+
+	// Finalize cleans up the external state of the issuer. It is called
+	// once the issuer resource is being deleted, before the finalizer is
+	// removed.
+	Finalize(ctx context.Context) error
+}
+
+// RevocationReasonCode returns the CRL reason code defined in RFC 5280
+// section 5.3.1 for the given revocation reason.
+func RevocationReasonCode(reason v1.CertificateRevocationReason) int {
+	switch reason {
+	case v1.RevocationReasonKeyCompromise:
+		return 1
+	case v1.RevocationReasonAffiliationChanged:
+		return 3
+	case v1.RevocationReasonSuperseded:
+		return 4
+	case v1.RevocationReasonCessationOfOperation:
+		return 5
+	default:
+		return 0
+	}
+}
+
 type IssueResponse struct {
 	// Certificate is the certificate resource that should be stored in the
 	// target secret.
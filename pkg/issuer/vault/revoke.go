@@ -0,0 +1,40 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"context"
+	"crypto/x509"
+
+	vaultinternal "github.com/cert-manager/cert-manager/internal/vault"
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+)
+
+var _ issuer.Revoker = &Vault{}
+
+// Revoke revokes the given certificate using the `revoke` endpoint of the
+// Vault PKI secrets engine. Vault does not record a revocation reason, so
+// the given reason is ignored.
+func (v *Vault) Revoke(ctx context.Context, cert *x509.Certificate, _ v1.CertificateRevocationReason) error {
+	client, err := vaultinternal.New(ctx, v.resourceNamespace, v.createTokenFn, v.secretsLister, v.issuer)
+	if err != nil {
+		return err
+	}
+
+	return client.Revoke(cert.SerialNumber)
+}
@@ -29,3 +29,41 @@ func (m *Metrics) ObserveACMERequestDuration(duration time.Duration, labels ...s
 func (m *Metrics) IncrementACMERequestCount(labels ...string) {
 	m.acmeClientRequestCount.WithLabelValues(labels...).Inc()
 }
+
+// UpdateACMERateLimitResetTime sets the time at which the rate limit reported
+// by an ACME server for the given account and registered domain is reset.
+func (m *Metrics) UpdateACMERateLimitResetTime(reset time.Time, host, account, registeredDomain string) {
+	m.acmeClientRateLimitResetTime.WithLabelValues(host, account, registeredDomain).Set(float64(reset.Unix()))
+}
+
+// RemoveACMERateLimitResetTime removes the rate limit reset time for the given
+// account and registered domain, once the rate limit has been reset.
+func (m *Metrics) RemoveACMERateLimitResetTime(host, account, registeredDomain string) {
+	m.acmeClientRateLimitResetTime.DeleteLabelValues(host, account, registeredDomain)
+}
+
+// IncrementACMEOrderAuthorizationCount increases the counter of ACME
+// authorizations of Orders with the given outcome.
+func (m *Metrics) IncrementACMEOrderAuthorizationCount(outcome string) {
+	m.acmeOrderAuthorizationCount.WithLabelValues(outcome).Inc()
+}
+
+// UpdateACMEChallengeSchedulerQueue replaces the challenge scheduler queue
+// metrics with the given queue lengths and positions of the next Challenge,
+// both keyed by namespace.
+func (m *Metrics) UpdateACMEChallengeSchedulerQueue(lengths, positions map[string]int) {
+	m.acmeChallengeSchedulerQueueLength.Reset()
+	m.acmeChallengeSchedulerQueuePos.Reset()
+	for ns, length := range lengths {
+		m.acmeChallengeSchedulerQueueLength.WithLabelValues(ns).Set(float64(length))
+	}
+	for ns, pos := range positions {
+		m.acmeChallengeSchedulerQueuePos.WithLabelValues(ns).Set(float64(pos))
+	}
+}
+
+// ObserveACMEChallengeSchedulerWaitDuration records the time a Challenge in
+// the given namespace waited to be scheduled for processing.
+func (m *Metrics) ObserveACMEChallengeSchedulerWaitDuration(namespace string, duration time.Duration) {
+	m.acmeChallengeSchedulerWaitDuration.WithLabelValues(namespace).Observe(duration.Seconds())
+}
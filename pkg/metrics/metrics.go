@@ -21,6 +21,11 @@ limitations under the License.
 // certificate_ready_status{name, namespace, condition, issuer_name, issuer_kind, issuer_group}
 // acme_client_request_count{"scheme", "host", "path", "method", "status"}
 // acme_client_request_duration_seconds{"scheme", "host", "path", "method", "status"}
+// acme_client_rate_limit_reset_timestamp_seconds{"host", "account", "registered_domain"}
+// acme_order_authorizations_total{"outcome"}
+// acme_challenge_scheduler_queue_length{"namespace"}
+// acme_challenge_scheduler_queue_position{"namespace"}
+// acme_challenge_scheduler_wait_duration_seconds{"namespace"}
 // venafi_client_request_duration_seconds{"scheme", "host", "path", "method", "status"}
 // controller_sync_call_count{"controller"}
 package metrics
@@ -60,6 +65,11 @@ type Metrics struct {
 	certificateReadyStatus             *prometheus.GaugeVec
 	acmeClientRequestDurationSeconds   *prometheus.SummaryVec
 	acmeClientRequestCount             *prometheus.CounterVec
+	acmeClientRateLimitResetTime       *prometheus.GaugeVec
+	acmeOrderAuthorizationCount        *prometheus.CounterVec
+	acmeChallengeSchedulerQueueLength  *prometheus.GaugeVec
+	acmeChallengeSchedulerQueuePos     *prometheus.GaugeVec
+	acmeChallengeSchedulerWaitDuration *prometheus.SummaryVec
 	venafiClientRequestDurationSeconds *prometheus.SummaryVec
 	controllerSyncCallCount            *prometheus.CounterVec
 	controllerSyncErrorCount           *prometheus.CounterVec
@@ -153,6 +163,65 @@ func New(log logr.Logger, c clock.Clock) *Metrics {
 			[]string{"scheme", "host", "path", "method", "status"},
 		)
 
+		// acmeClientRateLimitResetTime is a Prometheus gauge to expose the time
+		// at which rate limits reported by ACME servers are reset.
+		acmeClientRateLimitResetTime = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "acme_client_rate_limit_reset_timestamp_seconds",
+				Help:      "The time at which a rate limit reported by the ACME server for an ACME account is reset. Expressed as a Unix Epoch Time. An empty registered_domain means that the rate limit applies to the whole account.",
+			},
+			[]string{"host", "account", "registered_domain"},
+		)
+
+		// acmeOrderAuthorizationCount is a Prometheus counter to collect the
+		// number of ACME authorizations of Orders, by whether a Challenge had
+		// to be solved for them.
+		acmeOrderAuthorizationCount = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "acme_order_authorizations_total",
+				Help:      "The number of ACME authorizations of Orders. The outcome is 'challenge' if a Challenge had to be solved for the authorization, 'reused' if the ACME server reported that the authorization was already valid, and 'cached' if the authorization was known to be valid from the authorization cache.",
+			},
+			[]string{"outcome"},
+		)
+
+		// acmeChallengeSchedulerQueueLength is a Prometheus gauge to expose
+		// the number of Challenges of each namespace that are waiting to be
+		// scheduled for processing.
+		acmeChallengeSchedulerQueueLength = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "acme_challenge_scheduler_queue_length",
+				Help:      "The number of Challenges that are waiting to be scheduled for processing.",
+			},
+			[]string{"namespace"},
+		)
+
+		// acmeChallengeSchedulerQueuePos is a Prometheus gauge to expose the
+		// position in the challenge scheduler's queue of the next Challenge
+		// of each namespace.
+		acmeChallengeSchedulerQueuePos = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "acme_challenge_scheduler_queue_position",
+				Help:      "The position in the challenge scheduler's queue of the next Challenge of the namespace that is waiting to be scheduled for processing. A position of 0 means that the Challenge is the next one to be scheduled.",
+			},
+			[]string{"namespace"},
+		)
+
+		// acmeChallengeSchedulerWaitDuration is a Prometheus summary to
+		// collect the time Challenges waited to be scheduled for processing.
+		acmeChallengeSchedulerWaitDuration = prometheus.NewSummaryVec(
+			prometheus.SummaryOpts{
+				Namespace:  namespace,
+				Name:       "acme_challenge_scheduler_wait_duration_seconds",
+				Help:       "The time between the creation of a Challenge and it being scheduled for processing.",
+				Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+			},
+			[]string{"namespace"},
+		)
+
 		// venafiClientRequestDurationSeconds is a Prometheus summary to
 		// collect api call latencies for the Venafi client. This
 		// metric is in alpha since cert-manager 1.9. Move it to GA once
@@ -205,6 +274,11 @@ func New(log logr.Logger, c clock.Clock) *Metrics {
 		certificateReadyStatus:             certificateReadyStatus,
 		acmeClientRequestCount:             acmeClientRequestCount,
 		acmeClientRequestDurationSeconds:   acmeClientRequestDurationSeconds,
+		acmeClientRateLimitResetTime:       acmeClientRateLimitResetTime,
+		acmeOrderAuthorizationCount:        acmeOrderAuthorizationCount,
+		acmeChallengeSchedulerQueueLength:  acmeChallengeSchedulerQueueLength,
+		acmeChallengeSchedulerQueuePos:     acmeChallengeSchedulerQueuePos,
+		acmeChallengeSchedulerWaitDuration: acmeChallengeSchedulerWaitDuration,
 		venafiClientRequestDurationSeconds: venafiClientRequestDurationSeconds,
 		controllerSyncCallCount:            controllerSyncCallCount,
 		controllerSyncErrorCount:           controllerSyncErrorCount,
@@ -223,6 +297,11 @@ func (m *Metrics) NewServer(ln net.Listener) *http.Server {
 	m.registry.MustRegister(m.acmeClientRequestDurationSeconds)
 	m.registry.MustRegister(m.venafiClientRequestDurationSeconds)
 	m.registry.MustRegister(m.acmeClientRequestCount)
+	m.registry.MustRegister(m.acmeClientRateLimitResetTime)
+	m.registry.MustRegister(m.acmeOrderAuthorizationCount)
+	m.registry.MustRegister(m.acmeChallengeSchedulerQueueLength)
+	m.registry.MustRegister(m.acmeChallengeSchedulerQueuePos)
+	m.registry.MustRegister(m.acmeChallengeSchedulerWaitDuration)
 	m.registry.MustRegister(m.controllerSyncCallCount)
 	m.registry.MustRegister(m.controllerSyncErrorCount)
 
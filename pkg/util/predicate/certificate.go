@@ -19,6 +19,7 @@ package predicate
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 )
 
@@ -44,3 +45,23 @@ func CertificateNextPrivateKeySecretName(name string) Func {
 		return *crt.Status.NextPrivateKeySecretName == name
 	}
 }
+
+// CertificateIssuer is an ExtractorFunc which returns a predicate that used to
+// filter Certificates to only those referencing the given Issuer or
+// ClusterIssuer in 'spec.issuerRef'.
+func CertificateIssuer(iss runtime.Object) Func {
+	_, isClusterIssuer := iss.(*cmapi.ClusterIssuer)
+	issMeta := iss.(cmapi.GenericIssuer).GetObjectMeta()
+	return func(obj runtime.Object) bool {
+		crt := obj.(*cmapi.Certificate)
+		ref := crt.Spec.IssuerRef
+		if ref.Group != "" && ref.Group != certmanager.GroupName {
+			return false
+		}
+		if isClusterIssuer {
+			return ref.Kind == cmapi.ClusterIssuerKind && ref.Name == issMeta.Name
+		}
+		return (ref.Kind == "" || ref.Kind == cmapi.IssuerKind) &&
+			crt.Namespace == issMeta.Namespace && ref.Name == issMeta.Name
+	}
+}
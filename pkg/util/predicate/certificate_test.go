@@ -19,9 +19,11 @@ package predicate
 import (
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 )
 
 func TestCertificateSecretName(t *testing.T) {
@@ -92,3 +94,63 @@ func TestCertificateNextPrivateKeySecretName(t *testing.T) {
 		})
 	}
 }
+
+func TestCertificateIssuer(t *testing.T) {
+	certWithIssuerRef := func(namespace string, ref cmmeta.ObjectReference) *cmapi.Certificate {
+		return &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace},
+			Spec:       cmapi.CertificateSpec{IssuerRef: ref},
+		}
+	}
+	issuer := &cmapi.Issuer{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "abc"}}
+	clusterIssuer := &cmapi.ClusterIssuer{ObjectMeta: metav1.ObjectMeta{Name: "abc"}}
+	tests := map[string]struct {
+		issuer   cmapi.GenericIssuer
+		cert     *cmapi.Certificate
+		expected bool
+	}{
+		"returns true if the Issuer is referenced without a kind": {
+			issuer:   issuer,
+			cert:     certWithIssuerRef("ns", cmmeta.ObjectReference{Name: "abc"}),
+			expected: true,
+		},
+		"returns true if the Issuer is referenced": {
+			issuer:   issuer,
+			cert:     certWithIssuerRef("ns", cmmeta.ObjectReference{Name: "abc", Kind: cmapi.IssuerKind, Group: "cert-manager.io"}),
+			expected: true,
+		},
+		"returns false if an Issuer in another namespace is referenced": {
+			issuer:   issuer,
+			cert:     certWithIssuerRef("other", cmmeta.ObjectReference{Name: "abc"}),
+			expected: false,
+		},
+		"returns false if a ClusterIssuer with the same name is referenced": {
+			issuer:   issuer,
+			cert:     certWithIssuerRef("ns", cmmeta.ObjectReference{Name: "abc", Kind: cmapi.ClusterIssuerKind}),
+			expected: false,
+		},
+		"returns false if an external issuer is referenced": {
+			issuer:   issuer,
+			cert:     certWithIssuerRef("ns", cmmeta.ObjectReference{Name: "abc", Kind: cmapi.IssuerKind, Group: "example.com"}),
+			expected: false,
+		},
+		"returns true if the ClusterIssuer is referenced": {
+			issuer:   clusterIssuer,
+			cert:     certWithIssuerRef("ns", cmmeta.ObjectReference{Name: "abc", Kind: cmapi.ClusterIssuerKind}),
+			expected: true,
+		},
+		"returns false if an Issuer with the same name as the ClusterIssuer is referenced": {
+			issuer:   clusterIssuer,
+			cert:     certWithIssuerRef("ns", cmmeta.ObjectReference{Name: "abc"}),
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := CertificateIssuer(test.issuer)(test.cert)
+			if got != test.expected {
+				t.Errorf("unexpected response: got=%t, exp=%t", got, test.expected)
+			}
+		})
+	}
+}
@@ -370,7 +370,7 @@ func runAllControllers(t *testing.T, config *rest.Config) framework.StopFunc {
 	}
 	keyManager := controllerpkg.NewController("keymanager_controller", metrics, keyCtrl.ProcessItem, keyMustSync, nil, keyQueue)
 
-	triggerCtrl, triggerQueue, triggerMustSync, err := trigger.NewController(log, &controllerContext, policies.NewTriggerPolicyChain(clock).Evaluate)
+	triggerCtrl, triggerQueue, triggerMustSync, err := trigger.NewController(log, &controllerContext, policies.NewTriggerPolicyChain(clock, 0).Evaluate)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -75,6 +75,14 @@ func SetFakeSecretsGetterGet(s *corev1.Secret, err error) FakeSecretsGetterModif
 	}
 }
 
+// SetFakeSecretsGetterPatchFn is a function that can be used to inject code
+// when the FakeSecretsGetter is Patched.
+func SetFakeSecretsGetterPatchFn(fn PatchFn) FakeSecretsGetterModifier {
+	return func(f *FakeSecretsGetter) {
+		f.c.PatchFn = fn
+	}
+}
+
 // SetFakeSecretsGetterApplyFn is a function that can be used to inject code
 // when the FakeSecretsGetter is Applied.
 func SetFakeSecretsGetterApplyFn(fn ApplyFn) FakeSecretsGetterModifier {
@@ -89,6 +97,8 @@ func (f *FakeSecretsGetter) Secrets(string) typedcorev1.SecretInterface {
 
 type ApplyFn func(context.Context, *applyconfigurationscorev1.SecretApplyConfiguration, metav1.ApplyOptions) (*corev1.Secret, error)
 
+type PatchFn func(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*corev1.Secret, error)
+
 type fakeSecretClient struct {
 	CreateFn           func() (*corev1.Secret, error)
 	UpdateFn           func() (*corev1.Secret, error)
@@ -97,7 +107,7 @@ type fakeSecretClient struct {
 	GetFn              func() (*corev1.Secret, error)
 	ListFn             func() (*corev1.SecretList, error)
 	WatchFn            func() (watch.Interface, error)
-	PatchFn            func() (*corev1.Secret, error)
+	PatchFn            PatchFn
 	ApplyFn            ApplyFn
 	// Currently there is no need to mock this interface
 	typedcorev1.SecretExpansion
@@ -131,8 +141,8 @@ func (f *fakeSecretClient) Watch(context.Context, metav1.ListOptions) (watch.Int
 	return f.WatchFn()
 }
 
-func (f *fakeSecretClient) Patch(context.Context, string, types.PatchType, []byte, metav1.PatchOptions, ...string) (*corev1.Secret, error) {
-	return f.PatchFn()
+func (f *fakeSecretClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*corev1.Secret, error) {
+	return f.PatchFn(ctx, name, pt, data, opts, subresources...)
 }
 
 func (f *fakeSecretClient) Apply(ctx context.Context, cnf *applyconfigurationscorev1.SecretApplyConfiguration, opts metav1.ApplyOptions) (*corev1.Secret, error) {
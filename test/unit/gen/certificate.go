@@ -114,6 +114,12 @@ func SetCertificateKeyEncoding(keyEncoding v1.PrivateKeyEncoding) CertificateMod
 	}
 }
 
+func SetCertificateKeyRotationMaxAge(maxAge *metav1.Duration) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.PrivateKey.RotationMaxAge = maxAge
+	}
+}
+
 func SetCertificateSecretName(secretName string) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Spec.SecretName = secretName
@@ -142,6 +148,12 @@ func SetCertificateRenewBefore(renewBefore *metav1.Duration) CertificateModifier
 	}
 }
 
+func SetCertificateRenewalWindow(window v1.CertificateRenewalWindow) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.RenewalWindow = &window
+	}
+}
+
 func SetCertificateNextPrivateKeySecretName(name string) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Status.NextPrivateKeySecretName = &name
@@ -187,6 +199,12 @@ func SetCertificateNotBefore(p metav1.Time) CertificateModifier {
 	}
 }
 
+func SetCertificatePrivateKeyCreationTime(p metav1.Time) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Status.PrivateKeyCreationTime = &p
+	}
+}
+
 func SetCertificateRenewalTime(p metav1.Time) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Status.RenewalTime = &p
@@ -291,3 +309,15 @@ func SetCertificateAdditionalOutputFormats(additionalOutputFormats ...v1.Certifi
 		crt.Spec.AdditionalOutputFormats = additionalOutputFormats
 	}
 }
+
+func SetCertificateHistory(history ...v1.CertificateIssuance) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Status.History = history
+	}
+}
+
+func SetCertificateRetainPrevious(gracePeriod metav1.Duration) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.RetainPrevious = &v1.CertificateRetainPrevious{GracePeriod: gracePeriod}
+	}
+}
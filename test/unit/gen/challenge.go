@@ -86,6 +86,18 @@ func SetChallengePresented(p bool) ChallengeModifier {
 	}
 }
 
+func SetChallengePresentedTime(t metav1.Time) ChallengeModifier {
+	return func(ch *cmacme.Challenge) {
+		ch.Status.PresentedTime = &t
+	}
+}
+
+func SetChallengeAccepted(a bool) ChallengeModifier {
+	return func(ch *cmacme.Challenge) {
+		ch.Status.Accepted = a
+	}
+}
+
 func SetChallengeWildcard(p bool) ChallengeModifier {
 	return func(ch *cmacme.Challenge) {
 		ch.Spec.Wildcard = p
@@ -138,6 +138,20 @@ func SetIssuerACMEPrivKeyRef(privateKeyName string) IssuerModifier {
 		}
 	}
 }
+
+func SetIssuerACMENextPrivKeyRef(privateKeyName string) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		spec := iss.GetSpec()
+		if spec.ACME == nil {
+			spec.ACME = &cmacme.ACMEIssuer{}
+		}
+		spec.ACME.NextPrivateKey = &cmmeta.SecretKeySelector{
+			LocalObjectReference: cmmeta.LocalObjectReference{
+				Name: privateKeyName,
+			},
+		}
+	}
+}
 func SetIssuerACMESolvers(solvers []cmacme.ACMEChallengeSolver) IssuerModifier {
 	return func(iss v1.GenericIssuer) {
 		spec := iss.GetSpec()
@@ -148,6 +162,16 @@ func SetIssuerACMESolvers(solvers []cmacme.ACMEChallengeSolver) IssuerModifier {
 	}
 }
 
+func SetIssuerACMEProfile(profile string) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		spec := iss.GetSpec()
+		if spec.ACME == nil {
+			spec.ACME = &cmacme.ACMEIssuer{}
+		}
+		spec.ACME.Profile = profile
+	}
+}
+
 func SetIssuerACMEDuration(enabled bool) IssuerModifier {
 	return func(iss v1.GenericIssuer) {
 		spec := iss.GetSpec()
@@ -178,6 +202,29 @@ func SetIssuerACMEDisableAccountKeyGeneration(disabled bool) IssuerModifier {
 	}
 }
 
+func SetIssuerACMEAccountPrivateKey(algorithm cmacme.ACMEAccountKeyAlgorithm, size int) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		spec := iss.GetSpec()
+		if spec.ACME == nil {
+			spec.ACME = &cmacme.ACMEIssuer{}
+		}
+		spec.ACME.AccountPrivateKey = &cmacme.ACMEAccountPrivateKey{
+			Algorithm: algorithm,
+			Size:      size,
+		}
+	}
+}
+
+func SetIssuerACMEAccountDeletionPolicy(policy cmacme.ACMEAccountDeletionPolicy) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		spec := iss.GetSpec()
+		if spec.ACME == nil {
+			spec.ACME = &cmacme.ACMEIssuer{}
+		}
+		spec.ACME.AccountDeletionPolicy = policy
+	}
+}
+
 func SetIssuerACMEEAB(keyID, secretName string) IssuerModifier {
 	return func(iss v1.GenericIssuer) {
 		spec := iss.GetSpec()
@@ -450,3 +497,9 @@ func SetIssuerNamespace(namespace string) IssuerModifier {
 		iss.GetObjectMeta().Namespace = namespace
 	}
 }
+
+func SetIssuerRenewalWindow(window v1.CertificateRenewalWindow) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().RenewalWindow = &window
+	}
+}
@@ -118,6 +118,12 @@ func SetOrderDuration(duration time.Duration) OrderModifier {
 	}
 }
 
+func SetOrderProfile(profile string) OrderModifier {
+	return func(order *cmacme.Order) {
+		order.Spec.Profile = profile
+	}
+}
+
 func SetOrderAnnotations(annotations map[string]string) OrderModifier {
 	return func(order *cmacme.Order) {
 		order.Annotations = annotations
@@ -0,0 +1,119 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package acme
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrRenewalInfoUnsupported is returned by GetRenewalInfo when the CA does
+// not advertise a renewalInfo endpoint in its directory.
+var ErrRenewalInfoUnsupported = errors.New("acme: server does not support ACME Renewal Information (RFC 9773)")
+
+// RenewalInfo is the response of an ACME Renewal Information (ARI) request,
+// as defined in RFC 9773 section 4.2.
+type RenewalInfo struct {
+	// SuggestedWindow is the time window within which the CA recommends
+	// that the certificate is renewed.
+	SuggestedWindow RenewalInfoWindow
+
+	// ExplanationURL optionally points to a page explaining why the
+	// suggested window is what it is, e.g. an incident report in case
+	// of a mass revocation event.
+	ExplanationURL string
+
+	// RetryAfter is the time after which the client should poll the
+	// renewalInfo endpoint again, as indicated by the Retry-After header.
+	// It is zero if the server did not send a Retry-After header.
+	RetryAfter time.Time
+}
+
+// RenewalInfoWindow is a suggested renewal window.
+type RenewalInfoWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+// ARICertID computes the unique identifier of cert as used by the ACME
+// Renewal Information extension (RFC 9773 section 4.1).
+// The identifier is the base64url encoded Authority Key Identifier of the
+// certificate, followed by a dot and the base64url encoded DER serial number.
+func ARICertID(cert *x509.Certificate) (string, error) {
+	if len(cert.AuthorityKeyId) == 0 {
+		return "", errors.New("acme: certificate has no authority key identifier")
+	}
+	if cert.SerialNumber == nil || cert.SerialNumber.Sign() <= 0 {
+		return "", errors.New("acme: certificate has no valid serial number")
+	}
+
+	// The serial number must be encoded as the DER INTEGER value bytes,
+	// which means a leading zero byte is needed if the most significant
+	// bit is set.
+	serial := cert.SerialNumber.Bytes()
+	if serial[0]&0x80 != 0 {
+		serial = append([]byte{0}, serial...)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(cert.AuthorityKeyId) + "." +
+		base64.RawURLEncoding.EncodeToString(serial), nil
+}
+
+// GetRenewalInfo retrieves the ACME Renewal Information for the certificate
+// identified by certID, as computed by ARICertID.
+//
+// If the CA does not support ARI, ErrRenewalInfoUnsupported is returned.
+func (c *Client) GetRenewalInfo(ctx context.Context, certID string) (*RenewalInfo, error) {
+	dir, err := c.Discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if dir.RenewalInfoURL == "" {
+		return nil, ErrRenewalInfoUnsupported
+	}
+
+	url := strings.TrimSuffix(dir.RenewalInfoURL, "/") + "/" + certID
+	res, err := c.get(ctx, url, wantStatus(http.StatusOK))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	var v struct {
+		SuggestedWindow struct {
+			Start time.Time `json:"start"`
+			End   time.Time `json:"end"`
+		} `json:"suggestedWindow"`
+		ExplanationURL string `json:"explanationURL"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&v); err != nil {
+		return nil, fmt.Errorf("acme: error reading renewal info: %v", err)
+	}
+	if v.SuggestedWindow.Start.IsZero() || v.SuggestedWindow.End.IsZero() {
+		return nil, errors.New("acme: renewal info is missing a suggested window")
+	}
+	if !v.SuggestedWindow.End.After(v.SuggestedWindow.Start) {
+		return nil, errors.New("acme: renewal info suggested window end is not after its start")
+	}
+
+	info := &RenewalInfo{
+		SuggestedWindow: RenewalInfoWindow{
+			Start: v.SuggestedWindow.Start,
+			End:   v.SuggestedWindow.End,
+		},
+		ExplanationURL: v.ExplanationURL,
+	}
+	if d := retryAfter(res.Header.Get("Retry-After")); d > 0 {
+		info.RetryAfter = timeNow().Add(d)
+	}
+	return info, nil
+}
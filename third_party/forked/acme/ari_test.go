@@ -0,0 +1,129 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package acme
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestARICertID(t *testing.T) {
+	// Example taken from RFC 9773 section 4.1.
+	cert := &x509.Certificate{
+		AuthorityKeyId: []byte{
+			0x69, 0x88, 0x5b, 0x6b, 0x87, 0x46, 0x40, 0x41, 0xe1, 0xb3,
+			0x7b, 0x84, 0x7b, 0xa0, 0xae, 0x2c, 0xde, 0x01, 0xc8, 0xd4,
+		},
+		SerialNumber: big.NewInt(0x87654321),
+	}
+	id, err := ARICertID(cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE"; id != want {
+		t.Errorf("ARICertID = %q; want %q", id, want)
+	}
+
+	if _, err := ARICertID(&x509.Certificate{SerialNumber: big.NewInt(1)}); err == nil {
+		t.Error("ARICertID: expected an error for a certificate without an authority key identifier")
+	}
+}
+
+func TestRFC_GetRenewalInfo(t *testing.T) {
+	s := newACMEServer()
+	s.handle("/acme/renewal-info/aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("r.Method = %q; want GET", r.Method)
+		}
+		w.Header().Set("Retry-After", "21600")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"suggestedWindow": {
+				"start": "2025-01-02T04:00:00Z",
+				"end": "2025-01-03T04:00:00Z"
+			},
+			"explanationURL": "https://acme.example.com/docs/ari"
+		}`))
+	})
+	s.start()
+	defer s.close()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	defer func(old func() time.Time) { timeNow = old }(timeNow)
+	timeNow = func() time.Time { return now }
+
+	cl := &Client{Key: testKeyEC, DirectoryURL: s.url("/")}
+	info, err := cl.GetRenewalInfo(context.Background(), "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &RenewalInfo{
+		SuggestedWindow: RenewalInfoWindow{
+			Start: time.Date(2025, 1, 2, 4, 0, 0, 0, time.UTC),
+			End:   time.Date(2025, 1, 3, 4, 0, 0, 0, time.UTC),
+		},
+		ExplanationURL: "https://acme.example.com/docs/ari",
+		RetryAfter:     now.Add(6 * time.Hour),
+	}
+	if !info.SuggestedWindow.Start.Equal(want.SuggestedWindow.Start) ||
+		!info.SuggestedWindow.End.Equal(want.SuggestedWindow.End) ||
+		info.ExplanationURL != want.ExplanationURL ||
+		!info.RetryAfter.Equal(want.RetryAfter) {
+		t.Errorf("GetRenewalInfo = %+v; want %+v", info, want)
+	}
+}
+
+func TestRFC_GetRenewalInfoUnsupported(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"newOrder": "https://example.com/acme/new-order"}`)
+	}))
+	defer ts.Close()
+
+	cl := &Client{Key: testKeyEC, DirectoryURL: ts.URL}
+	_, err := cl.GetRenewalInfo(context.Background(), "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE")
+	if !errors.Is(err, ErrRenewalInfoUnsupported) {
+		t.Errorf("GetRenewalInfo err = %v; want %v", err, ErrRenewalInfoUnsupported)
+	}
+}
+
+func TestRFC_AuthorizeOrderReplaces(t *testing.T) {
+	s := newACMEServer()
+	s.handle("/acme/new-account", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", s.url("/accounts/1"))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status": "valid"}`))
+	})
+	s.handle("/acme/new-order", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Replaces string `json:"replaces"`
+		}
+		decodeJWSRequest(t, &req, r.Body)
+		if want := "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE"; req.Replaces != want {
+			t.Errorf("req.Replaces = %q; want %q", req.Replaces, want)
+		}
+		w.Header().Set("Location", s.url("/orders/1"))
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"status": "pending", "authorizations": [%q]}`, s.url("/authz/1"))
+	})
+	s.start()
+	defer s.close()
+
+	cl := &Client{Key: testKeyEC, DirectoryURL: s.url("/")}
+	_, err := cl.AuthorizeOrder(context.Background(), DomainIDs("example.org"),
+		WithOrderReplaces("aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}